@@ -3,11 +3,14 @@ package archive
 import (
 	"bytes"
 	"context"
-	"fmt"
+	"errors"
 	"io"
 	"io/ioutil"
 	"math"
 	"os"
+	"path"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/brimsec/zq/pkg/iosrc"
@@ -17,7 +20,10 @@ import (
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/detector"
 	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -109,6 +115,47 @@ func TestOpenOptions(t *testing.T) {
 	require.Equal(t, test.Trim(exp), out)
 }
 
+func TestRangeAndPrefixFind(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, "../tests/suite/data/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	indexArchiveSpace(t, datapath, ":int64")
+	indexArchiveSpace(t, datapath, "s")
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+
+	query, err := ParseRangeQuery("", []string{":int64"}, []string{"336"}, []string{"338"})
+	require.NoError(t, err)
+	exp := `
+#zfile=string
+#0:record[key:int64,count:uint64,_log:zfile]
+0:[336;1;20200422/1587518620.0622373.zng;]
+0:[337;1;20200422/1587513890.06193968.zng;]
+0:[336;1;20200421/1587509469.06883172.zng;]
+`
+	out := indexQuery(t, ark, query, AddPath(DefaultAddPathField, false))
+	require.Equal(t, test.Trim(exp), out)
+
+	query, err = ParsePrefixQuery("", []string{"s"}, "Am")
+	require.NoError(t, err)
+	exp = `
+#zfile=string
+#0:record[key:string,count:uint64,_log:zfile]
+0:[Amazonism-intentness;1;20200422/1587518620.0622373.zng;]
+0:[Amelanchier-packet;1;20200422/1587518620.0622373.zng;]
+`
+	out = indexQuery(t, ark, query, AddPath(DefaultAddPathField, false))
+	require.Equal(t, test.Trim(exp), out)
+
+	_, err = ParseRangeQuery("", []string{":int64=336"}, []string{"336"}, nil)
+	require.Error(t, err)
+}
+
 func TestImportWhileOpen(t *testing.T) {
 	// Create an archive with initial data
 	datapath, err := ioutil.TempDir("", "")
@@ -129,14 +176,11 @@ func TestImportWhileOpen(t *testing.T) {
 
 	importTestFile(t, ark1, "testdata/td1.zng")
 
-	// Ensure UpdateCheck has incremented.
+	// Ensure the import's commit incremented the update count and that
+	// UpdateCheck does not count it again.
 	update2, err := ark1.UpdateCheck()
 	require.NoError(t, err)
-	if !assert.Equal(t, 3, update2) {
-		if fi, err := iosrc.Stat(ark1.mdURI()); err == nil {
-			fmt.Fprintf(os.Stderr, "metadata mtime: %v, mdModTime %v", fi.ModTime(), ark1.mdModTime)
-		}
-	}
+	assert.Equal(t, 2, update2)
 
 	// Verify data & that a span walk now does not increment the update counter.
 	var initialSpans []SpanInfo
@@ -145,7 +189,7 @@ func TestImportWhileOpen(t *testing.T) {
 		return nil
 	})
 	require.NoError(t, err)
-	assert.Equal(t, 3, ark1.mdUpdateCount)
+	assert.Equal(t, 2, ark1.mdUpdateCount)
 	exp := []SpanInfo{SpanInfo{
 		Span:        nano.Span{Ts: 1587509776063858170, Dur: 4287004687211},
 		LogID:       "20200422/1587514063.06854538.zng",
@@ -178,11 +222,7 @@ func TestImportWhileOpen(t *testing.T) {
 	}}
 	assert.Equal(t, exp, postSpans)
 
-	if !assert.Equal(t, 4, ark1.mdUpdateCount) {
-		if fi, err := iosrc.Stat(ark1.mdURI()); err == nil {
-			fmt.Fprintf(os.Stderr, "metadata mtime: %v, ark1.mdModTime %v, ark2.mdModTime %v", fi.ModTime(), ark1.mdModTime, ark2.mdModTime)
-		}
-	}
+	assert.Equal(t, 3, ark1.mdUpdateCount)
 }
 
 func TestRemoteSourceImport(t *testing.T) {
@@ -193,6 +233,7 @@ func TestRemoteSourceImport(t *testing.T) {
 	defer ctrl.Finish()
 	src := iosrcmock.NewMockSource(ctrl)
 	var recvuri iosrc.URI
+	src.EXPECT().Exists(gomock.Any()).Return(false, nil)
 	src.EXPECT().NewWriter(gomock.Any()).
 		DoAndReturn(func(uri iosrc.URI) (io.WriteCloser, error) {
 			recvuri = uri
@@ -214,3 +255,474 @@ type nopWriteCloser struct{}
 
 func (*nopWriteCloser) Close() error                { return nil }
 func (*nopWriteCloser) Write(b []byte) (int, error) { return len(b), nil }
+
+func TestImportMerge(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(5000)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	indexArchiveSpace(t, datapath, ":int64")
+	var first []SpanInfo
+	require.NoError(t, SpanWalk(ark, func(si SpanInfo, _ iosrc.URI) error {
+		first = append(first, si)
+		return nil
+	}))
+	require.True(t, len(first) > 1)
+
+	// Importing the same data again overlaps every chunk.
+	importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	var spans []SpanInfo
+	var count int
+	require.NoError(t, SpanWalk(ark, func(si SpanInfo, _ iosrc.URI) error {
+		spans = append(spans, si)
+		count += si.RecordCount
+		return nil
+	}))
+	assert.Equal(t, 2000, count)
+	for i := 1; i < len(spans); i++ {
+		prev, cur := spans[i-1].Span, spans[i].Span
+		assert.False(t, prev.Overlaps(cur), "%s overlaps %s", prev, cur)
+		assert.True(t, prev.Ts > cur.Ts)
+	}
+	for _, si := range spans {
+		r, err := iosrc.NewReader(si.LogID.Path(ark))
+		require.NoError(t, err)
+		var n int
+		zr := zngio.NewReader(r, resolver.NewContext())
+		for {
+			rec, err := zr.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				break
+			}
+			assert.True(t, si.Span.Contains(rec.Ts()))
+			n++
+		}
+		r.Close()
+		assert.Equal(t, si.RecordCount, n)
+		// The index was rebuilt for the rewritten chunk.
+		ok, err := iosrc.Exists(LogToZarDir(si.LogID.Path(ark)).AppendPath(typeMicroIndexName(zng.TypeInt64)))
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+	// The chunks and indexes from the first import are gone.
+	for _, si := range first {
+		ok, err := iosrc.Exists(si.LogID.Path(ark))
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = iosrc.Exists(LogToZarDir(si.LogID.Path(ark)))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+}
+
+func archiveSpans(t *testing.T, ark *Archive) []SpanInfo {
+	var spans []SpanInfo
+	require.NoError(t, SpanWalk(ark, func(si SpanInfo, _ iosrc.URI) error {
+		spans = append(spans, si)
+		return nil
+	}))
+	return spans
+}
+
+func TestCompact(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{}, nil)
+	require.NoError(t, err)
+	for _, f := range []string{"td3.zng", "td1.zng"} {
+		importTestFile(t, ark, "testdata/"+f)
+	}
+	indexArchiveSpace(t, datapath, "v")
+	rule, err := NewZqlRule("count() by s | sort s", "custom", []string{"s"}, 32*1024)
+	require.NoError(t, err)
+	require.NoError(t, IndexDirTree(context.Background(), ark, []Rule{*rule}, "_", nil))
+	old := archiveSpans(t, ark)
+	require.Len(t, old, 2)
+
+	require.NoError(t, Compact(context.Background(), ark, nil))
+
+	// Reopen to check that the metadata was written.
+	ark, err = OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	spans := archiveSpans(t, ark)
+	require.Len(t, spans, 1)
+	assert.Equal(t, 547, spans[0].RecordCount)
+	assert.Equal(t, nano.NewSpanTs(1587508830068523240, 1587514063068545381), spans[0].Span)
+	for _, si := range old {
+		ok, err := iosrc.Exists(si.LogID.Path(ark))
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = iosrc.Exists(LogToZarDir(si.LogID.Path(ark)))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+	// The indexes were rebuilt for the new chunk.
+	zardir := LogToZarDir(spans[0].LogID.Path(ark))
+	for _, name := range []string{fieldMicroIndexName("v"), "custom"} {
+		ok, err := iosrc.Exists(zardir.AppendPath(name))
+		require.NoError(t, err)
+		assert.True(t, ok, name)
+	}
+
+	// A second compaction has nothing to do.
+	require.NoError(t, Compact(context.Background(), ark, nil))
+	assert.Equal(t, spans, archiveSpans(t, ark))
+}
+
+func TestRetain(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(5000)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	spans := archiveSpans(t, ark)
+	require.True(t, len(spans) > 2)
+
+	// Spans are in reverse time order, so the cutoff at the end of the
+	// second oldest chunk drops the two oldest chunks.
+	cutoff := spans[len(spans)-2].Span.End()
+	removed, err := Retain(ark, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, spans[len(spans)-2:], removed)
+
+	ark, err = OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, spans[:len(spans)-2], archiveSpans(t, ark))
+	for _, si := range removed {
+		ok, err := iosrc.Exists(si.LogID.Path(ark))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	removed, err = Retain(ark, cutoff)
+	require.NoError(t, err)
+	assert.Len(t, removed, 0)
+}
+
+func TestConcurrentWriters(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(math.MaxInt64)
+	_, err = CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	files := []string{"td1.zng", "td2.zng", "td3.zng"}
+	arks := make([]*Archive, len(files))
+	for i := range files {
+		arks[i], err = OpenArchive(datapath, nil)
+		require.NoError(t, err)
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(files))
+	for i := range files {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			zctx := resolver.NewContext()
+			reader, err := detector.OpenFile(zctx, "testdata/"+files[i], detector.OpenConfig{})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			defer reader.Close()
+			errs[i] = Import(context.Background(), arks[i], zctx, reader)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// No writer lost another's spans.
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	spans := archiveSpans(t, ark)
+	require.Len(t, spans, 3)
+	assert.Equal(t, 3, ark.commit)
+	for _, a := range arks {
+		assert.Equal(t, spans, archiveSpans(t, a))
+	}
+
+	// A writer with a stale view cannot remove a chunk that another
+	// writer has already removed.
+	stale, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	removed, err := Retain(ark, spans[1].Span.End())
+	require.NoError(t, err)
+	require.Len(t, removed, 2)
+	err = stale.replaceSpans(removed[:1], nil)
+	assert.True(t, errors.Is(err, zqe.E(zqe.Conflict)))
+	assert.Equal(t, spans[:1], archiveSpans(t, stale))
+}
+
+// hookReader calls hook before its first read.
+type hookReader struct {
+	zbuf.Reader
+	hook func()
+}
+
+func (h *hookReader) Read() (*zng.Record, error) {
+	if h.hook != nil {
+		h.hook()
+		h.hook = nil
+	}
+	return h.Reader.Read()
+}
+
+func TestImportMergeStale(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(5000)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	stale, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+
+	// Another writer commits overlapping chunks while the import through
+	// stale is reading its input, and the import still merges with them.
+	zctx := resolver.NewContext()
+	reader, err := detector.OpenFile(zctx, "../tests/suite/data/babble.tzng", detector.OpenConfig{})
+	require.NoError(t, err)
+	defer reader.Close()
+	r := &hookReader{Reader: reader, hook: func() {
+		importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	}}
+	require.NoError(t, Import(context.Background(), stale, zctx, r))
+	checkMerged(t, datapath, stale, 2000)
+}
+
+// checkMerged checks that the chunks of ark do not overlap and hold count
+// records and that the data path holds no other chunk files.
+func checkMerged(t *testing.T, datapath string, ark *Archive, count int) {
+	spans := archiveSpans(t, ark)
+	var n int
+	logs := make(map[string]struct{})
+	for i, si := range spans {
+		n += si.RecordCount
+		logs[si.LogID.Path(ark).Filepath()] = struct{}{}
+		if i > 0 {
+			prev, cur := spans[i-1].Span, si.Span
+			assert.False(t, prev.Overlaps(cur), "%s overlaps %s", prev, cur)
+		}
+	}
+	assert.Equal(t, count, n)
+
+	var files []string
+	require.NoError(t, filepath.Walk(datapath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && filepath.Ext(p) == zarExt {
+			return filepath.SkipDir
+		}
+		if filepath.Ext(p) == ".zng" {
+			files = append(files, p)
+		}
+		return nil
+	}))
+	assert.Len(t, files, len(logs))
+	for _, f := range files {
+		assert.Contains(t, logs, f)
+	}
+}
+
+// hookSource is an iosrc.Source that calls hook once, when the chunk
+// files are first opened for reading and then for writing if onWrite is
+// true, or just for reading otherwise.
+type hookSource struct {
+	iosrc.Source
+	hook    func()
+	onWrite bool
+	read    bool
+}
+
+func (h *hookSource) fire() {
+	if h.hook != nil {
+		h.hook()
+		h.hook = nil
+	}
+}
+
+func (h *hookSource) NewReader(uri iosrc.URI) (iosrc.Reader, error) {
+	h.read = true
+	if !h.onWrite {
+		h.fire()
+	}
+	return h.Source.NewReader(uri)
+}
+
+func (h *hookSource) NewWriter(uri iosrc.URI) (io.WriteCloser, error) {
+	if h.read && h.onWrite {
+		h.fire()
+	}
+	return h.Source.NewWriter(uri)
+}
+
+func (h *hookSource) MkdirAll(uri iosrc.URI, perm os.FileMode) error {
+	return h.Source.(iosrc.DirMaker).MkdirAll(uri, perm)
+}
+
+func TestImportMergeRetry(t *testing.T) {
+	const babble = "../tests/suite/data/babble.tzng"
+	test := func(t *testing.T, onWrite bool) {
+		datapath, err := ioutil.TempDir("", "")
+		require.NoError(t, err)
+		defer os.RemoveAll(datapath)
+
+		thresh := int64(5000)
+		ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+			LogSizeThreshold: &thresh,
+		}, nil)
+		require.NoError(t, err)
+		importTestFile(t, ark, babble)
+
+		// Another writer rewrites the chunks overlapping the import
+		// while it merges with them, so the merge is done again.
+		src, err := iosrc.GetSource(ark.DataPath)
+		require.NoError(t, err)
+		hs := &hookSource{Source: src, onWrite: onWrite, hook: func() {
+			importTestFile(t, ark, babble)
+		}}
+		other, err := OpenArchive(datapath, &OpenOptions{DataSource: hs})
+		require.NoError(t, err)
+		importTestFile(t, other, babble)
+		assert.Nil(t, hs.hook)
+		checkMerged(t, datapath, other, 3000)
+	}
+	t.Run("ChunkRemoved", func(t *testing.T) {
+		test(t, false)
+	})
+	t.Run("GroupsChanged", func(t *testing.T) {
+		test(t, true)
+	})
+}
+
+// breakCheckpoint replaces the metadata file of the archive at datapath
+// with a directory so that writing the checkpoint fails.
+func breakCheckpoint(t *testing.T, datapath string) {
+	mdpath := filepath.Join(datapath, metadataFilename)
+	require.NoError(t, os.Remove(mdpath))
+	require.NoError(t, os.Mkdir(mdpath, 0700))
+}
+
+// requireChunks checks that the chunk file of each span exists.
+func requireChunks(t *testing.T, ark *Archive, spans []SpanInfo) {
+	for _, si := range spans {
+		ok, err := iosrc.Exists(si.LogID.Path(ark))
+		require.NoError(t, err)
+		require.True(t, ok, "missing chunk %s", si.LogID)
+	}
+}
+
+func TestImportCheckpointFailure(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(5000)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	breakCheckpoint(t, datapath)
+
+	// The second import, which rewrites every chunk, is committed by its
+	// journal entry, so its chunks are kept.
+	importTestFile(t, ark, "../tests/suite/data/babble.tzng")
+	spans := archiveSpans(t, ark)
+	requireChunks(t, ark, spans)
+	var count int
+	for _, si := range spans {
+		count += si.RecordCount
+	}
+	assert.Equal(t, 2000, count)
+}
+
+func TestCompactCheckpointFailure(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{}, nil)
+	require.NoError(t, err)
+	for _, f := range []string{"td3.zng", "td1.zng"} {
+		importTestFile(t, ark, "testdata/"+f)
+	}
+	require.Len(t, archiveSpans(t, ark), 2)
+	breakCheckpoint(t, datapath)
+
+	require.NoError(t, Compact(context.Background(), ark, nil))
+	spans := archiveSpans(t, ark)
+	require.Len(t, spans, 1)
+	requireChunks(t, ark, spans)
+}
+
+func TestImported(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(math.MaxInt64)
+	ark, err := CreateOrOpenArchive(datapath, &CreateOptions{
+		LogSizeThreshold: &thresh,
+	}, nil)
+	require.NoError(t, err)
+	importTestFile(t, ark, "testdata/td1.zng")
+	_, commit, err := CommittedSpans(ark)
+	require.NoError(t, err)
+
+	// The records of td1.zng are merged with the identical chunk and those
+	// of td2.zng, which overlaps nothing, form a chunk of their own.
+	importTestFile(t, ark, "testdata/td1.zng")
+	importTestFile(t, ark, "testdata/td2.zng")
+	uris, last, err := Imported(ark, commit)
+	require.NoError(t, err)
+	assert.Equal(t, commit+2, last)
+	require.Len(t, uris, 2)
+	assert.Equal(t, importedFilename, path.Base(uris[0].Path))
+	var counts []int
+	for _, u := range uris {
+		f, err := iosrc.NewReader(u)
+		require.NoError(t, err)
+		zr := zngio.NewReader(f, resolver.NewContext())
+		var n int
+		for {
+			rec, err := zr.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				break
+			}
+			n++
+		}
+		require.NoError(t, f.Close())
+		counts = append(counts, n)
+	}
+	assert.Equal(t, []int{460, 453}, counts)
+
+	uris, last, err = Imported(ark, last)
+	require.NoError(t, err)
+	assert.Equal(t, commit+2, last)
+	assert.Len(t, uris, 0)
+}
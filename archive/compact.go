@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brimsec/zq/pkg/iosrc"
+)
+
+// Compact rewrites each run of adjacent chunks smaller than the archive's
+// LogSizeThreshold, such as those left by many small imports, as chunks of
+// up to the threshold in size.  The archive's indexes are rebuilt for the
+// new chunks before the metadata is updated to replace the old chunks,
+// which are then removed along with their zar directories.  Zql indexes
+// created before their definitions were recorded in the metadata cannot
+// be rebuilt and are skipped.
+func Compact(ctx context.Context, ark *Archive, progress chan<- string) error {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return err
+	}
+	rules, err := compactRules(ark, progress)
+	if err != nil {
+		return err
+	}
+	groups, err := compactGroups(ark)
+	if err != nil {
+		return err
+	}
+	w := newChunkWriter(ark)
+	var add, remove []SpanInfo
+	// Remove the new chunks unless the journal entry replacing the old
+	// ones with them is written.
+	var committed bool
+	defer func() {
+		if !committed {
+			w.abort()
+			removeChunks(ark, add)
+		}
+	}()
+	for _, group := range groups {
+		w.spans = nil
+		err := mergeChunks(ctx, ark, w, group)
+		add = append(add, w.spans...)
+		if err != nil {
+			return err
+		}
+		for _, s := range w.spans {
+			if err := indexChunk(ctx, ark, rules, s, progress); err != nil {
+				return err
+			}
+		}
+		if progress != nil {
+			progress <- fmt.Sprintf("compacted %d chunks into %d", len(group), len(w.spans))
+		}
+		remove = append(remove, group...)
+	}
+	if len(remove) == 0 {
+		return nil
+	}
+	// replaceSpans fails only if the journal entry is not written.
+	if err := ark.replaceSpans(remove, add); err != nil {
+		return err
+	}
+	committed = true
+	return removeChunks(ark, remove)
+}
+
+func compactRules(ark *Archive, progress chan<- string) ([]Rule, error) {
+	ark.mu.RLock()
+	defer ark.mu.RUnlock()
+	return indexRules(ark.indexes, progress)
+}
+
+// indexRules returns the rules that rebuild the indexes described by
+// indexes.
+func indexRules(indexes map[string]IndexInfo, progress chan<- string) ([]Rule, error) {
+	var rules []Rule
+	for _, info := range indexes {
+		if info.Type == "zql" && info.Zql == "" {
+			if progress != nil {
+				progress <- fmt.Sprintf("index %s cannot be rebuilt and will be skipped", info.Path)
+			}
+			continue
+		}
+		rule, err := ruleFromInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+// indexChunk builds the indexes for rules in the zar directory of the
+// chunk s.
+func indexChunk(ctx context.Context, ark *Archive, rules []Rule, s SpanInfo, progress chan<- string) error {
+	logPath := s.LogID.Path(ark)
+	zardir := LogToZarDir(logPath)
+	if dirmkr, ok := ark.dataSrc.(iosrc.DirMaker); ok {
+		if err := dirmkr.MkdirAll(zardir, 0700); err != nil {
+			return err
+		}
+	}
+	return run(ctx, zardir, rules, logPath, progress)
+}
+
+// compactGroups returns the runs of two or more adjacent chunks, in the
+// archive's sort order, that are each smaller than the LogSizeThreshold.
+func compactGroups(ark *Archive) ([][]SpanInfo, error) {
+	ark.mu.RLock()
+	spans := append([]SpanInfo(nil), ark.spans...)
+	ark.mu.RUnlock()
+
+	var groups [][]SpanInfo
+	var group []SpanInfo
+	flush := func() {
+		if len(group) > 1 {
+			groups = append(groups, group)
+		}
+		group = nil
+	}
+	for _, s := range spans {
+		info, err := ark.dataSrc.Stat(s.LogID.Path(ark))
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() >= ark.LogSizeThreshold {
+			flush()
+			continue
+		}
+		group = append(group, s)
+	}
+	flush()
+	return groups, nil
+}
+
+// removeChunks removes the chunk files of spans and their zar directories.
+func removeChunks(ark *Archive, spans []SpanInfo) error {
+	for _, s := range spans {
+		if err := ark.dataSrc.Remove(s.LogID.Path(ark)); err != nil {
+			return err
+		}
+		if err := ark.dataSrc.RemoveAll(LogToZarDir(s.LogID.Path(ark))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
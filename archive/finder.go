@@ -101,7 +101,7 @@ func Find(ctx context.Context, zctx *resolver.Context, ark *Archive, query Index
 		var searchErr error
 		go func() {
 			defer close(searchHits)
-			searchErr = search(ctx, opt.zctx, searchHits, zardir.AppendPath(indexInfo.Path), query.patterns)
+			searchErr = search(ctx, opt.zctx, searchHits, zardir.AppendPath(indexInfo.Path), query)
 			if searchErr != nil && os.IsNotExist(searchErr) && opt.skipMissing {
 				// No index for this rule.  Skip it if the skip boolean
 				// says it's ok.  Otherwise, we return ErrNotExist since
@@ -125,22 +125,50 @@ func Find(ctx context.Context, zctx *resolver.Context, ark *Archive, query Index
 	})
 }
 
-func search(ctx context.Context, zctx *resolver.Context, hits chan<- *zng.Record, uri iosrc.URI, patterns []string) error {
+func search(ctx context.Context, zctx *resolver.Context, hits chan<- *zng.Record, uri iosrc.URI, query IndexQuery) error {
 	finder := microindex.NewFinder(zctx, uri)
 	if err := finder.Open(); err != nil {
 		return fmt.Errorf("%s: %w", finder.Path(), err)
 	}
 	defer finder.Close()
-	keys, err := finder.ParseKeys(patterns)
-	if err != nil {
-		return fmt.Errorf("%s: %w", finder.Path(), err)
-	}
-	if err := finder.LookupAll(ctx, hits, keys); err != nil {
+	if err := lookup(ctx, finder, hits, query); err != nil {
 		return fmt.Errorf("%s: %w", finder.Path(), err)
 	}
 	return nil
 }
 
+func lookup(ctx context.Context, finder *microindex.Finder, hits chan<- *zng.Record, query IndexQuery) error {
+	switch {
+	case query.isPrefix:
+		return finder.LookupPrefix(ctx, hits, query.prefix)
+	case query.isRange:
+		var lo, hi *zng.Record
+		if len(query.lo) > 0 {
+			rec, err := finder.ParseKeys(query.lo)
+			if err != nil {
+				return err
+			}
+			if rec != nil {
+				// ParseKeys reuses its record, so keep lo.
+				lo = rec.Keep()
+			}
+		}
+		if len(query.hi) > 0 {
+			var err error
+			if hi, err = finder.ParseKeys(query.hi); err != nil {
+				return err
+			}
+		}
+		return finder.LookupRange(ctx, hits, lo, hi)
+	default:
+		keys, err := finder.ParseKeys(query.patterns)
+		if err != nil {
+			return err
+		}
+		return finder.LookupAll(ctx, hits, keys)
+	}
+}
+
 type findReadCloser struct {
 	ctx    context.Context
 	cancel context.CancelFunc
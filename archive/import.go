@@ -2,8 +2,10 @@ package archive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path"
+	"sort"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/bufwriter"
@@ -15,93 +17,162 @@ import (
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
 	"github.com/brimsec/zq/zql"
 )
 
+// importStreamSize is the number of records in each zng stream of a chunk
+// file, which bounds the memory needed to read a chunk in reverse.
+const importStreamSize = 5000
+
 func tsDir(ts nano.Ts) string {
 	return ts.Time().Format("20060102")
 }
 
-type importDriver struct {
+// chunkWriter writes records, which must arrive in the archive's sort order,
+// to a sequence of chunk files, starting a new chunk once the current one
+// reaches the archive's LogSizeThreshold.  A chunk is never split between
+// records with the same timestamp so the spans of the chunks do not overlap.
+type chunkWriter struct {
 	ark *Archive
 	bw  *bufwriter.Writer
 	zw  *zngio.Writer
 
+	// taken holds the LogIDs in use so that a new chunk never replaces
+	// an existing one.  Chunks of other writers that are not yet in the
+	// metadata are found by checking the data source.
+	taken  map[LogID]struct{}
 	span   nano.Span
 	logID  LogID
 	spans  []SpanInfo
 	rcount int
+	full   bool
+	lastTs nano.Ts
 }
 
-func (d *importDriver) writeOne(rec *zng.Record) error {
-	recspan := nano.Span{rec.Ts(), 1}
-	if d.zw == nil {
-		dname := tsDir(rec.Ts())
-		fname := rec.Ts().StringFloat() + ".zng"
-		d.span = recspan
-		d.rcount = 0
+func newChunkWriter(ark *Archive) *chunkWriter {
+	taken := make(map[LogID]struct{})
+	ark.mu.RLock()
+	for _, s := range ark.spans {
+		taken[s.LogID] = struct{}{}
+	}
+	ark.mu.RUnlock()
+	return &chunkWriter{ark: ark, taken: taken}
+}
+
+// newLogID returns an unused LogID for a chunk whose first record has
+// timestamp ts.
+func (w *chunkWriter) newLogID(ts nano.Ts) (LogID, error) {
+	dname := tsDir(ts)
+	base := ts.StringFloat()
+	for i := 0; ; i++ {
+		fname := base + ".zng"
+		if i > 0 {
+			fname = fmt.Sprintf("%s-%d.zng", base, i)
+		}
 		// Create LogID with path.Join so that it always uses forward
 		// slashes (dir1/foo.zng), regardless of platform.
-		d.logID = LogID(path.Join(dname, fname))
+		id := LogID(path.Join(dname, fname))
+		if _, ok := w.taken[id]; ok {
+			continue
+		}
+		w.taken[id] = struct{}{}
+		exists, err := w.ark.dataSrc.Exists(id.Path(w.ark))
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return id, nil
+		}
+	}
+}
 
-		dpath := d.ark.DataPath.AppendPath(dname)
-		if dirmkr, ok := d.ark.dataSrc.(iosrc.DirMaker); ok {
-			if err := dirmkr.MkdirAll(dpath, 0755); err != nil {
+func (w *chunkWriter) write(rec *zng.Record) error {
+	if w.full && rec.Ts() != w.lastTs {
+		if err := w.close(); err != nil {
+			return err
+		}
+	}
+	recspan := nano.Span{rec.Ts(), 1}
+	if w.zw == nil {
+		w.span = recspan
+		w.rcount = 0
+		logID, err := w.newLogID(rec.Ts())
+		if err != nil {
+			return err
+		}
+		w.logID = logID
+		fpath := w.logID.Path(w.ark)
+		if dirmkr, ok := w.ark.dataSrc.(iosrc.DirMaker); ok {
+			if err := dirmkr.MkdirAll(w.ark.DataPath.AppendPath(tsDir(rec.Ts())), 0755); err != nil {
 				return err
 			}
 		}
-
-		//XXX for now just truncate any existing file.
-		// a future PR will do a split/merge.
-		fpath := dpath.AppendPath(fname)
-		out, err := d.ark.dataSrc.NewWriter(fpath)
+		out, err := w.ark.dataSrc.NewWriter(fpath)
 		if err != nil {
 			return err
 		}
-		d.bw = bufwriter.New(out)
-		d.zw = zngio.NewWriter(d.bw, zio.WriterFlags{ZngLZ4BlockSize: zio.DefaultZngLZ4BlockSize})
+		w.bw = bufwriter.New(out)
+		w.zw = zngio.NewWriter(w.bw, zio.WriterFlags{
+			StreamRecordsMax: importStreamSize,
+			ZngLZ4BlockSize:  zio.DefaultZngLZ4BlockSize,
+		})
 	} else {
-		d.span = d.span.Union(recspan)
+		w.span = w.span.Union(recspan)
 	}
-	if err := d.zw.Write(rec); err != nil {
+	if err := w.zw.Write(rec); err != nil {
 		return err
 	}
-	d.rcount++
-	if d.zw.Position() >= d.ark.LogSizeThreshold {
-		if err := d.close(); err != nil {
-			return err
-		}
-	}
+	w.rcount++
+	w.lastTs = rec.Ts()
+	w.full = w.zw.Position() >= w.ark.LogSizeThreshold
 	return nil
 }
 
-func (d *importDriver) close() error {
-	if d.zw != nil {
-		if err := d.zw.Flush(); err != nil {
+// abort removes the chunk being written, if any, without adding it to
+// w.spans.
+func (w *chunkWriter) abort() {
+	if w.bw != nil {
+		w.bw.Close()
+		w.ark.dataSrc.Remove(w.logID.Path(w.ark))
+		w.bw = nil
+	}
+	w.zw = nil
+	w.full = false
+}
+
+func (w *chunkWriter) close() error {
+	if w.zw != nil {
+		if err := w.zw.Flush(); err != nil {
 			return err
 		}
 	}
-	if d.bw != nil {
-		if err := d.bw.Close(); err != nil {
+	if w.bw != nil {
+		if err := w.bw.Close(); err != nil {
 			return err
 		}
-		d.spans = append(d.spans, SpanInfo{
-			Span:        d.span,
-			LogID:       d.logID,
-			RecordCount: d.rcount,
+		w.spans = append(w.spans, SpanInfo{
+			Span:        w.span,
+			LogID:       w.logID,
+			RecordCount: w.rcount,
 		})
-		d.bw = nil
+		w.bw = nil
 	}
-	d.zw = nil
+	w.zw = nil
+	w.full = false
 	return nil
 }
 
+type importDriver struct {
+	*chunkWriter
+}
+
 func (d *importDriver) Write(cid int, batch zbuf.Batch) error {
 	if cid != 0 {
 		panic("importDriver write to non-zero channel")
 	}
 	for i := 0; i < batch.Length(); i++ {
-		if err := d.writeOne(batch.Index(i)); err != nil {
+		if err := d.write(batch.Index(i)); err != nil {
 			return err
 		}
 	}
@@ -126,16 +197,296 @@ func importProc(ark *Archive) string {
 	return "sort -r ts"
 }
 
+// Import writes the records of r to new chunks in the archive.  Any
+// existing chunks whose spans overlap the imported data are merged with
+// it and rewritten, so that the spans of the archive's chunks never
+// overlap.  The archive's indexes are rebuilt for the rewritten chunks as
+// they are by Compact.  The journal entry of the import records the files
+// holding the imported records, which are found with Imported: the new
+// chunks holding only imported records and, for each group of rewritten
+// chunks, a file of its imported records in the zar directory of the first
+// of its new chunks.
 func Import(ctx context.Context, ark *Archive, zctx *resolver.Context, r zbuf.Reader) error {
+	if ark.LogsFiltered {
+		return errors.New("cannot add spans to log filtered archive")
+	}
 	proc, err := zql.ParseProc(importProc(ark))
 	if err != nil {
 		return err
 	}
+	if _, err := ark.UpdateCheck(); err != nil {
+		return err
+	}
 
-	id := &importDriver{ark: ark}
+	id := &importDriver{newChunkWriter(ark)}
 	if err := driver.Run(ctx, id, proc, zctx, r, driver.Config{}); err != nil {
+		id.abort()
+		removeChunks(ark, id.spans)
 		return fmt.Errorf("archive.Import: run failed: %w", err)
 	}
 
-	return ark.AppendSpans(id.spans)
+	return merge(ctx, ark, id.chunkWriter)
+}
+
+// errOverlapsChanged is returned by the update in mergeOverlaps when the
+// chunks overlapping the imported chunks have changed since they were
+// merged.
+var errOverlapsChanged = errors.New("overlapping chunks changed")
+
+// merge adds the chunks written by w to the archive, first rewriting each
+// group of overlapping chunks as a sequence of non-overlapping chunks.  If
+// another writer changes the chunks that overlap the import before the
+// merge is committed, the groups are found and merged again.  The imported
+// chunks are removed if they are not committed.
+func merge(ctx context.Context, ark *Archive, w *chunkWriter) error {
+	imported := w.spans
+	for {
+		drop, err := mergeOverlaps(ctx, ark, w, imported)
+		if err == errOverlapsChanged {
+			continue
+		}
+		if err != nil {
+			removeChunks(ark, imported)
+			return err
+		}
+		return removeChunks(ark, drop)
+	}
+}
+
+// mergeOverlaps merges the groups of overlapping chunks among the imported
+// chunks and the archive's current chunks, rebuilds the indexes of the new
+// chunks from the archive's IndexInfo, and commits the result, returning
+// the chunks that were rewritten.  The chunks are merged and indexed
+// without holding ark.mu, so the update only checks that the groups are
+// unchanged, failing with errOverlapsChanged if not.  The new chunks are
+// removed if they are not committed.
+func mergeOverlaps(ctx context.Context, ark *Archive, w *chunkWriter, imported []SpanInfo) ([]SpanInfo, error) {
+	existing, err := Spans(ark)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := compactRules(ark, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range existing {
+		w.taken[s.LogID] = struct{}{}
+	}
+	isImported := make(map[LogID]struct{})
+	for _, s := range imported {
+		isImported[s.LogID] = struct{}{}
+	}
+	groups := overlapGroups(existing, imported)
+	var merged, add, drop []SpanInfo
+	var paths []string
+	var committed bool
+	defer func() {
+		if !committed {
+			w.abort()
+			removeChunks(ark, merged)
+		}
+	}()
+	for _, group := range groups {
+		if len(group) == 1 {
+			// A group of one is an imported chunk.
+			add = append(add, group...)
+			paths = append(paths, string(group[0].LogID))
+			continue
+		}
+		w.spans = nil
+		err := mergeChunks(ctx, ark, w, group)
+		merged = append(merged, w.spans...)
+		if errors.Is(err, zqe.E(zqe.NotFound)) {
+			// Another writer may have rewritten a chunk of the group.
+			return nil, checkOverlaps(ark, groups, imported, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range w.spans {
+			if err := indexChunk(ctx, ark, rules, s, nil); err != nil {
+				return nil, err
+			}
+		}
+		p, err := writeImported(ctx, ark, group, isImported, w.spans[0])
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+		add = append(add, w.spans...)
+		drop = append(drop, group...)
+	}
+	err = ark.update(func() (*journalEntry, error) {
+		if !sameGroups(groups, overlapGroups(ark.spans, imported)) {
+			return nil, errOverlapsChanged
+		}
+		current := make(map[LogID]struct{})
+		for _, s := range ark.spans {
+			current[s.LogID] = struct{}{}
+		}
+		var remove []LogID
+		for _, s := range drop {
+			if _, ok := current[s.LogID]; ok {
+				remove = append(remove, s.LogID)
+			}
+		}
+		return &journalEntry{AddSpans: add, RemoveSpans: remove, Imported: paths}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	committed = true
+	return drop, nil
+}
+
+// checkOverlaps returns errOverlapsChanged if the groups of chunks that
+// overlap imported are no longer those in groups and err otherwise.
+func checkOverlaps(ark *Archive, groups [][]SpanInfo, imported []SpanInfo, err error) error {
+	existing, serr := Spans(ark)
+	if serr != nil {
+		return serr
+	}
+	if !sameGroups(groups, overlapGroups(existing, imported)) {
+		return errOverlapsChanged
+	}
+	return err
+}
+
+// sameGroups returns true if a and b hold the same chunks in the same
+// groups.
+func sameGroups(a, b [][]SpanInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j].LogID != b[i][j].LogID {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// overlapGroups returns the added spans partitioned into groups of
+// transitively overlapping spans together with the existing spans that
+// overlap them.
+func overlapGroups(existing, added []SpanInfo) [][]SpanInfo {
+	type entry struct {
+		SpanInfo
+		added bool
+	}
+	var entries []entry
+	for _, s := range existing {
+		entries = append(entries, entry{s, false})
+	}
+	for _, s := range added {
+		entries = append(entries, entry{s, true})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Span.Ts < entries[j].Span.Ts
+	})
+	var groups [][]SpanInfo
+	var group []SpanInfo
+	var hasAdded bool
+	var end nano.Ts
+	flush := func() {
+		if hasAdded {
+			groups = append(groups, group)
+		}
+		group, hasAdded = nil, false
+	}
+	for _, e := range entries {
+		if len(group) > 0 && e.Span.Ts >= end {
+			flush()
+		}
+		if len(group) == 0 || e.Span.End() > end {
+			end = e.Span.End()
+		}
+		group = append(group, e.SpanInfo)
+		hasAdded = hasAdded || e.added
+	}
+	flush()
+	return groups
+}
+
+// writeImported writes the records of the imported chunks of group to the
+// importedFilename file in the zar directory of the chunk s, returning the
+// file's path relative to the data path.
+func writeImported(ctx context.Context, ark *Archive, group []SpanInfo, imported map[LogID]struct{}, s SpanInfo) (string, error) {
+	zctx := resolver.NewContext()
+	var readers []zbuf.Reader
+	for _, g := range group {
+		if _, ok := imported[g.LogID]; !ok {
+			continue
+		}
+		f, err := ark.dataSrc.NewReader(g.LogID.Path(ark))
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		readers = append(readers, zngio.NewReader(f, zctx))
+	}
+	zardir := LogToZarDir(s.LogID.Path(ark))
+	if dirmkr, ok := ark.dataSrc.(iosrc.DirMaker); ok {
+		if err := dirmkr.MkdirAll(zardir, 0700); err != nil {
+			return "", err
+		}
+	}
+	out, err := ark.dataSrc.NewWriter(zardir.AppendPath(importedFilename))
+	if err != nil {
+		return "", err
+	}
+	bw := bufwriter.New(out)
+	zw := zngio.NewWriter(bw, zio.WriterFlags{
+		StreamRecordsMax: importStreamSize,
+		ZngLZ4BlockSize:  zio.DefaultZngLZ4BlockSize,
+	})
+	r := zbuf.NewCombiner(readers, zbuf.RecordCompare(ark.DataSortDirection))
+	if err := zbuf.CopyWithContext(ctx, zw, r); err != nil {
+		bw.Close()
+		return "", err
+	}
+	if err := zw.Flush(); err != nil {
+		bw.Close()
+		return "", err
+	}
+	if err := bw.Close(); err != nil {
+		return "", err
+	}
+	return path.Join(string(s.LogID)+zarExt, importedFilename), nil
+}
+
+// mergeChunks writes the records of the chunks in group to new chunks
+// with w.
+func mergeChunks(ctx context.Context, ark *Archive, w *chunkWriter, group []SpanInfo) error {
+	zctx := resolver.NewContext()
+	var readers []zbuf.Reader
+	for _, s := range group {
+		f, err := ark.dataSrc.NewReader(s.LogID.Path(ark))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		readers = append(readers, zngio.NewReader(f, zctx))
+	}
+	r := zbuf.NewCombiner(readers, zbuf.RecordCompare(ark.DataSortDirection))
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := r.Read()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			return w.close()
+		}
+		if err := w.write(rec); err != nil {
+			return err
+		}
+	}
 }
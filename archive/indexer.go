@@ -38,12 +38,15 @@ func IndexDirTree(ctx context.Context, ark *Archive, rules []Rule, path string,
 	}
 	var infos []IndexInfo
 	for _, r := range rules {
-		infos = append(infos, IndexInfo{r.typ, r.path})
+		infos = append(infos, r.info())
 	}
 	return ark.AddIndexes(infos)
 }
 
 func runOne(ctx context.Context, zardir iosrc.URI, rule Rule, inputPath iosrc.URI, progress chan<- string) error {
+	if rule.typ == "skip" {
+		return runSkip(ctx, zardir, rule, inputPath, progress)
+	}
 	rc, err := iosrc.NewReader(inputPath)
 	if err != nil {
 		return err
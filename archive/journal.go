@@ -0,0 +1,176 @@
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zqe"
+)
+
+// journalDirname is the directory under an archive's root holding its
+// journal.  Each change to the archive's metadata is recorded as a journal
+// entry in a file named for its commit number, starting from 1.  Entries
+// are created with an exclusive write, so a writer commits a change only
+// if no other writer has committed an entry with the same number, which
+// makes the commit number a compare-and-swap version of the metadata.
+// The metadata file is a checkpoint that saves readers from replaying the
+// journal from its start.
+const journalDirname = "zar.journal"
+
+// importedFilename is the name of the file in the zar directory of a chunk
+// rewritten by an import that holds the records the import added to it.
+const importedFilename = "imported.zng"
+
+type journalEntry struct {
+	Commit      int         `json:"commit"`
+	AddSpans    []SpanInfo  `json:"add_spans,omitempty"`
+	RemoveSpans []LogID     `json:"remove_spans,omitempty"`
+	AddIndexes  []IndexInfo `json:"add_indexes,omitempty"`
+	// Imported holds the paths, relative to the data path, of the zng
+	// files holding the records added to the archive by an import.
+	Imported []string `json:"imported,omitempty"`
+}
+
+func (ark *Archive) journalURI(commit int) iosrc.URI {
+	return ark.Root.AppendPath(journalDirname, strconv.Itoa(commit)+".json")
+}
+
+// readEntry returns the journal entry with the given commit number or nil
+// if it has not been committed.
+func (ark *Archive) readEntry(commit int) (*journalEntry, error) {
+	b, err := iosrc.ReadFile(ark.journalURI(commit))
+	if err != nil {
+		if errors.Is(err, zqe.E(zqe.NotFound)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var e journalEntry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// replay applies the journal entries committed since ark.commit, returning
+// true if there were any.  The caller must hold ark.mu for writing.
+func (ark *Archive) replay() (bool, error) {
+	var updated bool
+	for {
+		e, err := ark.readEntry(ark.commit + 1)
+		if e == nil || err != nil {
+			return updated, err
+		}
+		ark.apply(e)
+		updated = true
+	}
+}
+
+// Imported returns the URIs of the zng files holding the records added to
+// the archive by the imports committed after commit, in commit order, and
+// the number of the last commit.  A file is removed along with its chunk,
+// so the files of an import may be gone once a later change, such as a
+// Compact, has rewritten its chunks.
+func Imported(ark *Archive, commit int) ([]iosrc.URI, int, error) {
+	var uris []iosrc.URI
+	for {
+		e, err := ark.readEntry(commit + 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		if e == nil {
+			return uris, commit, nil
+		}
+		for _, p := range e.Imported {
+			uris = append(uris, ark.DataPath.AppendPath(p))
+		}
+		commit++
+	}
+}
+
+func (ark *Archive) apply(e *journalEntry) {
+	if len(e.RemoveSpans) > 0 {
+		removed := make(map[LogID]struct{})
+		for _, id := range e.RemoveSpans {
+			removed[id] = struct{}{}
+		}
+		var spans []SpanInfo
+		for _, s := range ark.spans {
+			if _, ok := removed[s.LogID]; !ok {
+				spans = append(spans, s)
+			}
+		}
+		ark.spans = spans
+	}
+	ark.spans = append(ark.spans, e.AddSpans...)
+	sort.Slice(ark.spans, func(i, j int) bool {
+		if ark.DataSortDirection == zbuf.DirTimeForward {
+			return ark.spans[i].Span.Ts < ark.spans[j].Span.Ts
+		}
+		return ark.spans[j].Span.Ts < ark.spans[i].Span.Ts
+	})
+	for _, ind := range e.AddIndexes {
+		ark.indexes[ind.Path] = ind
+	}
+	ark.commit++
+}
+
+// update commits a change to the archive's metadata.  The change is
+// computed by fn, which is called with ark.mu held after the archive has
+// been brought up to date with the journal.  If another writer commits an
+// entry first, the archive is brought up to date again and fn is called
+// again, so fn must validate the change against the current state.  The
+// change is committed once its journal entry is written, so update then
+// returns nil even if writing the metadata checkpoint fails, and callers
+// may roll back on any error without touching committed data.
+func (ark *Archive) update(fn func() (*journalEntry, error)) error {
+	src, err := iosrc.GetSource(ark.Root)
+	if err != nil {
+		return err
+	}
+	ew, ok := src.(iosrc.ExclusiveWriter)
+	if !ok {
+		return zqe.E("scheme does not support metadata updates: %s", ark.Root)
+	}
+	if dm, ok := src.(iosrc.DirMaker); ok {
+		if err := dm.MkdirAll(ark.Root.AppendPath(journalDirname), 0700); err != nil {
+			return err
+		}
+	}
+
+	ark.mu.Lock()
+	defer ark.mu.Unlock()
+	for {
+		if _, err := ark.replay(); err != nil {
+			return err
+		}
+		e, err := fn()
+		if err != nil {
+			return err
+		}
+		e.Commit = ark.commit + 1
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		err = ew.WriteFileExclusive(b, ark.journalURI(e.Commit))
+		if errors.Is(err, zqe.E(zqe.Exists)) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		ark.apply(e)
+		break
+	}
+	ark.mdUpdateCount++
+	// Concurrent writers may write their checkpoints out of order, which
+	// only means that readers replay more of the journal.  A failed
+	// checkpoint means the same thing, so it is not an error.
+	ark.metaWrite()
+	return nil
+}
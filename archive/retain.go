@@ -0,0 +1,32 @@
+package archive
+
+import (
+	"github.com/brimsec/zq/pkg/nano"
+)
+
+// Retain drops the chunks of the archive whose data is entirely older
+// than cutoff, returning the dropped chunks' spans.  A chunk holding data
+// on both sides of cutoff is kept whole.  The removal of the chunks is
+// committed with a single journal entry before the chunk files and their
+// zar directories are removed, so a failure part way through can leave
+// unreferenced files behind but never a reference to a missing chunk.
+func Retain(ark *Archive, cutoff nano.Ts) ([]SpanInfo, error) {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return nil, err
+	}
+	var drop []SpanInfo
+	ark.mu.RLock()
+	for _, s := range ark.spans {
+		if s.Span.End() <= cutoff {
+			drop = append(drop, s)
+		}
+	}
+	ark.mu.RUnlock()
+	if len(drop) == 0 {
+		return nil, nil
+	}
+	if err := ark.replaceSpans(drop, nil); err != nil {
+		return nil, err
+	}
+	return drop, removeChunks(ark, drop)
+}
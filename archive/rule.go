@@ -11,9 +11,18 @@ import (
 	"github.com/brimsec/zq/zql"
 )
 
+// IndexQuery is a search of an index.  By default, it is an exact-match
+// search for the keys given by patterns.  If isRange is true, it searches
+// for keys in the range [lo, hi), and if isPrefix is true, it searches for
+// keys whose first key begins with prefix.
 type IndexQuery struct {
 	indexName string
 	patterns  []string
+	isRange   bool
+	lo        []string
+	hi        []string
+	isPrefix  bool
+	prefix    string
 }
 
 func ParseIndexQuery(indexName string, patterns []string) (IndexQuery, error) {
@@ -35,16 +44,9 @@ func ParseIndexQuery(indexName string, patterns []string) (IndexQuery, error) {
 	if len(v) != 2 {
 		return IndexQuery{}, zqe.E(zqe.Invalid, "malformed standard index query")
 	}
-	fieldOrType := v[0]
-	var path string
-	if fieldOrType[0] == ':' {
-		typ, err := resolver.NewContext().LookupByName(fieldOrType[1:])
-		if err != nil {
-			return IndexQuery{}, err
-		}
-		path = typeMicroIndexName(typ)
-	} else {
-		path = fieldMicroIndexName(fieldOrType)
+	path, err := standardIndexName(v[0])
+	if err != nil {
+		return IndexQuery{}, err
 	}
 	return IndexQuery{
 		indexName: path,
@@ -52,6 +54,68 @@ func ParseIndexQuery(indexName string, patterns []string) (IndexQuery, error) {
 	}, nil
 }
 
+// ParseRangeQuery returns a query for the keys k of an index with
+// lo <= k < hi, where either of lo or hi may be empty to leave the range
+// unbounded on that side.  For a standard index, patterns holds a single
+// field name or a ":" followed by a type name; for a custom index named
+// by indexName, patterns must be empty.
+func ParseRangeQuery(indexName string, patterns, lo, hi []string) (IndexQuery, error) {
+	path, err := queryIndexName(indexName, patterns)
+	if err != nil {
+		return IndexQuery{}, err
+	}
+	return IndexQuery{
+		indexName: path,
+		isRange:   true,
+		lo:        lo,
+		hi:        hi,
+	}, nil
+}
+
+// ParsePrefixQuery returns a query for the keys of an index whose first
+// key is a string beginning with prefix.  The index is given as for
+// ParseRangeQuery.
+func ParsePrefixQuery(indexName string, patterns []string, prefix string) (IndexQuery, error) {
+	path, err := queryIndexName(indexName, patterns)
+	if err != nil {
+		return IndexQuery{}, err
+	}
+	return IndexQuery{
+		indexName: path,
+		isPrefix:  true,
+		prefix:    prefix,
+	}, nil
+}
+
+func queryIndexName(indexName string, patterns []string) (string, error) {
+	if indexName != "" {
+		if len(patterns) != 0 {
+			return "", zqe.E(zqe.Invalid, "custom index range and prefix queries take no search patterns")
+		}
+		return indexName, nil
+	}
+	if len(patterns) != 1 {
+		return "", zqe.E(zqe.Invalid, "standard index range and prefix queries require exactly one field or type")
+	}
+	return standardIndexName(patterns[0])
+}
+
+// standardIndexName returns the name of the standard index for a field
+// name or a ":" followed by a type name.
+func standardIndexName(fieldOrType string) (string, error) {
+	if fieldOrType == "" || strings.Contains(fieldOrType, "=") {
+		return "", zqe.E(zqe.Invalid, "malformed standard index query")
+	}
+	if fieldOrType[0] == ':' {
+		typ, err := resolver.NewContext().LookupByName(fieldOrType[1:])
+		if err != nil {
+			return "", err
+		}
+		return typeMicroIndexName(typ), nil
+	}
+	return fieldMicroIndexName(fieldOrType), nil
+}
+
 func NewRule(pattern string) (*Rule, error) {
 	if pattern[0] == ':' {
 		return NewTypeRule(pattern[1:])
@@ -123,6 +187,8 @@ type Rule struct {
 	path      string
 	framesize int
 	keys      []string
+	// zql is the source of a zql rule.
+	zql string
 }
 
 func newRuleAST(typ string, proc ast.Proc, path string, keys []string, framesize int) (*Rule, error) {
@@ -143,7 +209,45 @@ func NewZqlRule(s, path string, keys []string, framesize int) (*Rule, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newRuleAST("zql", proc, path, keys, framesize)
+	rule, err := newRuleAST("zql", proc, path, keys, framesize)
+	if err != nil {
+		return nil, err
+	}
+	rule.zql = s
+	return rule, nil
+}
+
+func (f *Rule) info() IndexInfo {
+	info := IndexInfo{Type: f.typ, Path: f.path}
+	switch f.typ {
+	case "zql":
+		info.Zql = f.zql
+		info.Keys = f.keys
+		info.Framesize = f.framesize
+	case "skip":
+		info.Keys = f.keys
+	}
+	return info
+}
+
+// ruleFromInfo returns the rule that builds the index described by info.
+func ruleFromInfo(info IndexInfo) (*Rule, error) {
+	switch info.Type {
+	case "type":
+		name := strings.TrimSuffix(strings.TrimPrefix(info.Path, "microindex-type-"), ".zng")
+		return NewTypeRule(name)
+	case "field":
+		name := strings.TrimSuffix(strings.TrimPrefix(info.Path, "microindex-field-"), ".zng")
+		return NewFieldRule(name)
+	case "zql":
+		if info.Zql == "" {
+			return nil, fmt.Errorf("index %s: zql source not recorded", info.Path)
+		}
+		return NewZqlRule(info.Zql, info.Path, info.Keys, info.Framesize)
+	case "skip":
+		return NewSkipRule(info.Keys), nil
+	}
+	return nil, fmt.Errorf("index %s: unknown type %q", info.Path, info.Type)
 }
 
 func (f *Rule) Path(dir iosrc.URI) iosrc.URI {
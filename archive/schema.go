@@ -3,10 +3,7 @@ package archive
 import (
 	"encoding/json"
 	"errors"
-	"os"
-	"sort"
 	"sync"
-	"time"
 
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
@@ -16,8 +13,12 @@ import (
 
 const metadataFilename = "zar.json"
 
+// Metadata is the content of an archive's metadata file.  The metadata
+// file is a checkpoint of the archive's journal, holding the state of the
+// archive as of the journal entry numbered Commit.
 type Metadata struct {
 	Version           int                  `json:"version"`
+	Commit            int                  `json:"commit"`
 	DataPath          string               `json:"data_path"`
 	LogSizeThreshold  int64                `json:"log_size_threshold"`
 	DataSortDirection zbuf.Direction       `json:"data_sort_direction"`
@@ -42,59 +43,54 @@ type SpanInfo struct {
 	RecordCount int       `json:"record_count"`
 }
 
+// IndexInfo describes an index built for each chunk of an archive.  The
+// Zql, Keys, and Framesize fields are set for zql indexes so that they can
+// be rebuilt when chunks are rewritten.  For skip indexes, Keys holds the
+// names of the fields with Bloom filters.
 type IndexInfo struct {
-	Type string `json:"type"`
-	Path string `json:"path"`
+	Type      string   `json:"type"`
+	Path      string   `json:"path"`
+	Zql       string   `json:"zql,omitempty"`
+	Keys      []string `json:"keys,omitempty"`
+	Framesize int      `json:"framesize,omitempty"`
 }
 
+// Write atomically replaces the metadata file at uri.  Sources that are not
+// ReplacerAble, such as S3, must replace objects atomically on write.
 func (c *Metadata) Write(uri iosrc.URI) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
 	src, err := iosrc.GetSource(uri)
 	if err != nil {
 		return err
 	}
 	rep, ok := src.(iosrc.ReplacerAble)
 	if !ok {
-		return zqe.E("scheme does not support metadata updates: %s", uri)
+		return src.WriteFile(b, uri)
 	}
 	wc, err := rep.NewReplacer(uri)
 	if err != nil {
 		return err
 	}
-	if err := json.NewEncoder(wc).Encode(c); err != nil {
+	if _, err := wc.Write(b); err != nil {
 		wc.Close()
 		return err
 	}
-	if err := wc.Close(); err != nil {
-		return err
-	}
-	if uri.Scheme == "file" {
-		// Ensure the mtime is updated on the file after the close. This Chtimes
-		// call was required due to failures seen in CI, when an mtime change
-		// wasn't observed after some writes.
-		// See https://github.com/brimsec/brim/issues/883.
-		now := time.Now()
-		return os.Chtimes(uri.Filepath(), now, now)
-	}
-	return nil
+	return wc.Close()
 }
 
-func MetadataRead(uri iosrc.URI) (*Metadata, time.Time, error) {
-	// Read the mtime before the read so that the returned time
-	// represents a time at or before the content of the metadata file.
-	info, err := iosrc.Stat(uri)
+func MetadataRead(uri iosrc.URI) (*Metadata, error) {
+	b, err := iosrc.ReadFile(uri)
 	if err != nil {
-		return nil, time.Time{}, err
-	}
-	rc, err := iosrc.NewReader(uri)
-	if err != nil {
-		return nil, time.Time{}, err
+		return nil, err
 	}
-	defer rc.Close()
 	var md Metadata
-	if err := json.NewDecoder(rc).Decode(&md); err != nil {
-		return nil, time.Time{}, err
+	if err := json.Unmarshal(b, &md); err != nil {
+		return nil, err
 	}
-	return &md, info.ModTime(), nil
+	return &md, nil
 }
 
 const (
@@ -139,57 +135,53 @@ type Archive struct {
 	mu      sync.RWMutex
 	indexes map[string]IndexInfo // map key is index path
 	spans   []SpanInfo
-	// mdModTime is the mtime of the metadata at or before its contents
-	// were last read.
-	mdModTime time.Time
+	// commit is the number of the last journal entry reflected in
+	// spans and indexes.
+	commit int
 	// mdUpdateCount is incremented every time the metadata for the
-	// archive is potentially updated due to writing new logs, or
-	// on re-reading the metadata file.
+	// archive is updated due to writing new logs, or on reading new
+	// journal entries.
 	mdUpdateCount int
 }
 
 func (ark *Archive) AppendSpans(spans []SpanInfo) error {
+	return ark.replaceSpans(nil, spans)
+}
+
+// replaceSpans removes the spans in remove, identified by LogID, and adds
+// the spans in add with a single journal entry.  An error of kind
+// zqe.Conflict is returned if a span in remove has already been removed by
+// another writer.
+func (ark *Archive) replaceSpans(remove, add []SpanInfo) error {
 	if ark.LogsFiltered {
 		return errors.New("cannot add spans to log filtered archive")
 	}
-
-	ark.mu.Lock()
-	defer ark.mu.Unlock()
-
-	ark.spans = append(ark.spans, spans...)
-
-	sort.Slice(ark.spans, func(i, j int) bool {
-		if ark.DataSortDirection == zbuf.DirTimeForward {
-			return ark.spans[i].Span.Ts < ark.spans[j].Span.Ts
+	return ark.update(func() (*journalEntry, error) {
+		current := make(map[LogID]struct{})
+		for _, s := range ark.spans {
+			current[s.LogID] = struct{}{}
 		}
-		return ark.spans[j].Span.Ts < ark.spans[i].Span.Ts
+		var ids []LogID
+		for _, s := range remove {
+			if _, ok := current[s.LogID]; !ok {
+				return nil, zqe.E(zqe.Conflict, "log %s was removed by another writer", s.LogID)
+			}
+			ids = append(ids, s.LogID)
+		}
+		return &journalEntry{AddSpans: add, RemoveSpans: ids}, nil
 	})
-
-	if err := ark.metaWrite(); err != nil {
-		return err
-	}
-
-	ark.mdUpdateCount++
-	return nil
 }
 
 func (ark *Archive) AddIndexes(indexes []IndexInfo) error {
-	ark.mu.Lock()
-	defer ark.mu.Unlock()
-	for _, ind := range indexes {
-		ark.indexes[ind.Path] = ind
-	}
-	if err := ark.metaWrite(); err != nil {
-		return err
-	}
-
-	ark.mdUpdateCount++
-	return nil
+	return ark.update(func() (*journalEntry, error) {
+		return &journalEntry{AddIndexes: indexes}, nil
+	})
 }
 
 func (ark *Archive) metaWrite() error {
 	m := &Metadata{
 		Version:           0,
+		Commit:            ark.commit,
 		LogSizeThreshold:  ark.LogSizeThreshold,
 		DataSortDirection: ark.DataSortDirection,
 		DataPath:          ark.DataPath.String(),
@@ -203,11 +195,12 @@ func (ark *Archive) mdURI() iosrc.URI {
 	return ark.Root.AppendPath(metadataFilename)
 }
 
-// UpdateCheck looks at the archive's metadata file to see if it
-// has been written to since last read; if so, it is read and the
+// UpdateCheck looks at the archive's journal to see if it has been
+// written to since last read; if so, the new entries are read and the
 // available spans are updated. A counter is returned, starting
-// from 1, which is incremented every time this Archive has re-read
-// the metadata file, and possibly updated the available spans.
+// from 1, which is incremented every time this Archive has read new
+// journal entries, or written its own, and possibly updated the
+// available spans.
 func (ark *Archive) UpdateCheck() (int, error) {
 	if ark.LogsFiltered {
 		// If a logfilter was specified at open, there's no need to
@@ -215,35 +208,16 @@ func (ark *Archive) UpdateCheck() (int, error) {
 		return ark.mdUpdateCount, nil
 	}
 
-	fi, err := iosrc.Stat(ark.mdURI())
-	if err != nil {
-		return 0, err
-	}
-
-	ark.mu.RLock()
-	if fi.ModTime().Equal(ark.mdModTime) {
-		cnt := ark.mdUpdateCount
-		ark.mu.RUnlock()
-		return cnt, nil
-	}
-	ark.mu.RUnlock()
-
 	ark.mu.Lock()
 	defer ark.mu.Unlock()
 
-	if fi.ModTime().Equal(ark.mdModTime) {
-		return ark.mdUpdateCount, nil
-	}
-
-	md, mtime, err := MetadataRead(ark.mdURI())
+	updated, err := ark.replay()
 	if err != nil {
 		return 0, err
 	}
-
-	ark.spans = md.Spans
-	ark.indexes = md.Indexes
-	ark.mdModTime = mtime
-	ark.mdUpdateCount++
+	if updated {
+		ark.mdUpdateCount++
+	}
 	return ark.mdUpdateCount, nil
 }
 
@@ -261,7 +235,7 @@ func OpenArchive(rpath string, oo *OpenOptions) (*Archive, error) {
 }
 
 func openArchive(root iosrc.URI, oo *OpenOptions) (*Archive, error) {
-	m, mtime, err := MetadataRead(root.AppendPath(metadataFilename))
+	m, err := MetadataRead(root.AppendPath(metadataFilename))
 	if err != nil {
 		return nil, err
 	}
@@ -279,9 +253,16 @@ func openArchive(root iosrc.URI, oo *OpenOptions) (*Archive, error) {
 		LogSizeThreshold:  m.LogSizeThreshold,
 		DataPath:          dpuri,
 		indexes:           m.Indexes,
-		mdModTime:         mtime,
+		spans:             m.Spans,
+		commit:            m.Commit,
 		mdUpdateCount:     1,
 	}
+	if ark.indexes == nil {
+		ark.indexes = make(map[string]IndexInfo)
+	}
+	if _, err := ark.replay(); err != nil {
+		return nil, err
+	}
 
 	if oo != nil && oo.DataSource != nil {
 		ark.dataSrc = oo.DataSource
@@ -297,16 +278,16 @@ func openArchive(root iosrc.URI, oo *OpenOptions) (*Archive, error) {
 			lmap[LogID(l)] = struct{}{}
 		}
 
-		for _, s := range m.Spans {
+		var spans []SpanInfo
+		for _, s := range ark.spans {
 			if _, ok := lmap[s.LogID]; ok {
-				ark.spans = append(ark.spans, s)
+				spans = append(spans, s)
 			}
 		}
-		if len(ark.spans) == 0 {
+		if len(spans) == 0 {
 			return nil, zqe.E(zqe.Invalid, "OpenArchive: no logs left after filter")
 		}
-	} else {
-		ark.spans = m.Spans
+		ark.spans = spans
 	}
 
 	return ark, nil
@@ -0,0 +1,446 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/bloom"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqe"
+)
+
+// A skip index summarizes the field values of a chunk so that a search can
+// pass over the chunk when its filter cannot match any of the chunk's
+// records.  For each numeric, time, or duration field whose type is the
+// same throughout the chunk, the skip index records the field's minimum
+// and maximum values, and for each of the rule's bloom fields, it records
+// a Bloom filter of the field's values.  The skip index is stored in the
+// zar dir as a zng file of records of the form
+//
+//	record[field:string,min:<type>,max:<type>]
+//	record[field:string,k:uint64,bloom:bytes]
+//
+// where k is the number of hash functions of the Bloom filter.
+
+const skipIndexName = "skip.zng"
+
+const bloomFalsePositiveRate = 0.01
+
+// NewSkipRule creates an indexing rule that writes a skip index for each
+// chunk with Bloom filters for the fields in bloomFields.
+func NewSkipRule(bloomFields []string) *Rule {
+	return &Rule{
+		typ:  "skip",
+		path: skipIndexName,
+		keys: bloomFields,
+	}
+}
+
+func runSkip(ctx context.Context, zardir iosrc.URI, rule Rule, inputPath iosrc.URI, progress chan<- string) error {
+	rc, err := iosrc.NewReader(inputPath)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if progress != nil {
+		progress <- fmt.Sprintf("%s: creating index %s", inputPath, rule.Path(zardir))
+	}
+	b := newSkipBuilder(rule.keys)
+	r := zngio.NewReader(rc, resolver.NewContext())
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := r.Read()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			break
+		}
+		if err := b.walk("", rec.Type, rec.Raw); err != nil {
+			return err
+		}
+	}
+	return b.write(rule.Path(zardir))
+}
+
+type valueRange struct {
+	typ      zng.Type
+	min, max zcode.Bytes
+	// mixed is true if the field has values of more than one type or of
+	// a type that is not summarized by a range.
+	mixed bool
+}
+
+type bloomSet struct {
+	keys map[string]struct{}
+	// unbloomable is true if the field has values of a type whose
+	// comparisons are not summarized by a Bloom filter.
+	unbloomable bool
+}
+
+type skipBuilder struct {
+	compare expr.ValueCompareFn
+	ranges  map[string]*valueRange
+	names   []string
+	blooms  map[string]*bloomSet
+}
+
+func newSkipBuilder(bloomFields []string) *skipBuilder {
+	blooms := make(map[string]*bloomSet)
+	for _, f := range bloomFields {
+		blooms[f] = &bloomSet{keys: make(map[string]struct{})}
+	}
+	return &skipBuilder{
+		compare: expr.NewValueCompareFn(false),
+		ranges:  make(map[string]*valueRange),
+		blooms:  blooms,
+	}
+}
+
+func (b *skipBuilder) walk(prefix string, typ *zng.TypeRecord, zv zcode.Bytes) error {
+	it := zv.Iter()
+	for _, col := range typ.Columns {
+		if it.Done() {
+			return errors.New("record has too few values")
+		}
+		body, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		name := col.Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		coltyp := zng.AliasedType(col.Type)
+		if recType, ok := coltyp.(*zng.TypeRecord); ok {
+			if body != nil {
+				if err := b.walk(name, recType, body); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		b.add(name, zng.Value{Type: coltyp, Bytes: body})
+	}
+	return nil
+}
+
+func (b *skipBuilder) add(name string, v zng.Value) {
+	if bs, ok := b.blooms[name]; ok && !bs.unbloomable {
+		key, ok := bloomKey(v)
+		if !ok {
+			bs.unbloomable = true
+		} else if key != nil {
+			bs.keys[string(key)] = struct{}{}
+		}
+	}
+	r, ok := b.ranges[name]
+	if !ok {
+		r = &valueRange{typ: v.Type, mixed: !isRangeType(v.Type)}
+		b.ranges[name] = r
+		b.names = append(b.names, name)
+	}
+	if r.mixed {
+		return
+	}
+	if v.Type.ID() != r.typ.ID() {
+		r.mixed = true
+		return
+	}
+	if v.Bytes == nil {
+		// Unset values fail all of the comparisons summarized by the
+		// range.
+		return
+	}
+	if r.min == nil || b.compare(v, zng.Value{Type: r.typ, Bytes: r.min}) < 0 {
+		r.min = append(zcode.Bytes{}, v.Bytes...)
+	}
+	if r.max == nil || b.compare(v, zng.Value{Type: r.typ, Bytes: r.max}) > 0 {
+		r.max = append(zcode.Bytes{}, v.Bytes...)
+	}
+}
+
+func (b *skipBuilder) write(uri iosrc.URI) error {
+	out, err := iosrc.NewWriter(uri)
+	if err != nil {
+		return err
+	}
+	zctx := resolver.NewContext()
+	w := zngio.NewWriter(out, zio.WriterFlags{})
+	for _, name := range b.names {
+		r := b.ranges[name]
+		if r.mixed || r.min == nil {
+			continue
+		}
+		typ, err := zctx.LookupTypeRecord([]zng.Column{
+			zng.NewColumn("field", zng.TypeString),
+			zng.NewColumn("min", r.typ),
+			zng.NewColumn("max", r.typ),
+		})
+		if err != nil {
+			out.Close()
+			return err
+		}
+		rec := zng.NewBuilder(typ).Build(zng.EncodeString(name), r.min, r.max)
+		if err := w.Write(rec); err != nil {
+			out.Close()
+			return err
+		}
+	}
+	bloomType := zctx.MustLookupTypeRecord([]zng.Column{
+		zng.NewColumn("field", zng.TypeString),
+		zng.NewColumn("k", zng.TypeUint64),
+		zng.NewColumn("bloom", zng.TypeBytes),
+	})
+	var names []string
+	for name := range b.blooms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		bs := b.blooms[name]
+		if bs.unbloomable {
+			continue
+		}
+		f := bloom.New(len(bs.keys), bloomFalsePositiveRate)
+		for key := range bs.keys {
+			f.Add([]byte(key))
+		}
+		rec := zng.NewBuilder(bloomType).Build(
+			zng.EncodeString(name),
+			zng.EncodeUint(uint64(f.K())),
+			zng.EncodeBytes(f.Bytes()),
+		)
+		if err := w.Write(rec); err != nil {
+			out.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func isRangeType(typ zng.Type) bool {
+	switch typ.ID() {
+	case zng.IdByte, zng.IdInt16, zng.IdUint16, zng.IdInt32, zng.IdUint32,
+		zng.IdInt64, zng.IdUint64, zng.IdFloat64, zng.IdPort, zng.IdTime,
+		zng.IdDuration:
+		return true
+	}
+	return false
+}
+
+// bloomKey returns the key under which a value is added to a Bloom filter
+// such that an equality comparison between the value and a literal can
+// succeed only if the value's key is that of the literal as returned by
+// literalBloomKey.  The key is nil for values that cannot compare equal to
+// any literal.  The second return value is false if the value's type is
+// not summarized by a Bloom filter.
+func bloomKey(v zng.Value) ([]byte, bool) {
+	switch id := v.Type.ID(); {
+	case zng.IsStringy(id) || id == zng.IdBytes:
+		return append([]byte{'s'}, v.Bytes...), true
+	case id == zng.IdIP:
+		ip, err := zng.DecodeIP(v.Bytes)
+		if err != nil {
+			return nil, true
+		}
+		return append([]byte{'a'}, ip.To16()...), true
+	case id == zng.IdByte:
+		b, err := zng.DecodeByte(v.Bytes)
+		if err != nil {
+			return nil, true
+		}
+		return intKey(int64(b)), true
+	case id == zng.IdInt16 || id == zng.IdInt32 || id == zng.IdInt64:
+		i, err := zng.DecodeInt(v.Bytes)
+		if err != nil {
+			return nil, true
+		}
+		return intKey(i), true
+	case id == zng.IdUint16 || id == zng.IdUint32 || id == zng.IdUint64:
+		u, err := zng.DecodeUint(v.Bytes)
+		if err != nil || u > math.MaxInt64 {
+			// Integer literals never equal such values.
+			return nil, true
+		}
+		return intKey(int64(u)), true
+	case id == zng.IdPort:
+		p, err := zng.DecodePort(v.Bytes)
+		if err != nil {
+			return nil, true
+		}
+		return intKey(int64(p)), true
+	}
+	return nil, false
+}
+
+// literalBloomKey returns the Bloom filter key of a literal as parsed by
+// zng.ParseLiteral.  The second return value is false if a Bloom filter
+// cannot rule out an equality comparison with the literal.
+func literalBloomKey(v interface{}) ([]byte, bool) {
+	switch v := v.(type) {
+	case zng.Bstring:
+		return append([]byte{'s'}, v...), true
+	case net.IP:
+		return append([]byte{'a'}, v.To16()...), true
+	case int64:
+		return intKey(v), true
+	case zng.Port:
+		return intKey(int64(v)), true
+	}
+	return nil, false
+}
+
+func intKey(i int64) []byte {
+	return strconv.AppendInt([]byte{'i'}, i, 10)
+}
+
+type bounds struct {
+	min, max zng.Value
+}
+
+type skipIndex struct {
+	ranges map[string]bounds
+	blooms map[string]*bloom.Filter
+}
+
+// readSkipIndex reads the skip index in zardir.  It returns nil if there
+// is no skip index.
+func readSkipIndex(zardir iosrc.URI) (*skipIndex, error) {
+	rc, err := iosrc.NewReader(zardir.AppendPath(skipIndexName))
+	if err != nil {
+		if errors.Is(err, zqe.E(zqe.NotFound)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer rc.Close()
+	s := &skipIndex{
+		ranges: make(map[string]bounds),
+		blooms: make(map[string]*bloom.Filter),
+	}
+	r := zngio.NewReader(rc, resolver.NewContext())
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			return s, nil
+		}
+		rec = rec.Keep()
+		field, err := rec.AccessString("field")
+		if err != nil {
+			return nil, err
+		}
+		if bits, err := rec.Access("bloom"); err == nil {
+			k, err := rec.AccessInt("k")
+			if err != nil {
+				return nil, err
+			}
+			s.blooms[field] = bloom.FromBytes(bits.Bytes, int(k))
+			continue
+		}
+		min, err := rec.Access("min")
+		if err != nil {
+			return nil, err
+		}
+		max, err := rec.Access("max")
+		if err != nil {
+			return nil, err
+		}
+		s.ranges[field] = bounds{min, max}
+	}
+}
+
+// canMatch returns false if no record of the chunk summarized by the skip
+// index can satisfy the filter expression e.
+func (s *skipIndex) canMatch(e ast.BooleanExpr) bool {
+	switch e := e.(type) {
+	case *ast.LogicalAnd:
+		return s.canMatch(e.Left) && s.canMatch(e.Right)
+	case *ast.LogicalOr:
+		return s.canMatch(e.Left) || s.canMatch(e.Right)
+	case *ast.CompareField:
+		return s.canMatchCompare(e)
+	}
+	return true
+}
+
+func (s *skipIndex) canMatchCompare(e *ast.CompareField) bool {
+	field, ok := fieldName(e.Field)
+	if !ok || e.Value.Type == "regexp" {
+		return true
+	}
+	lit, err := zng.ParseLiteral(e.Value)
+	if err != nil || lit == nil {
+		return true
+	}
+	if e.Comparator == "=" {
+		if f, ok := s.blooms[field]; ok {
+			if key, ok := literalBloomKey(lit); ok && !f.Test(key) {
+				return false
+			}
+		}
+	}
+	r, ok := s.ranges[field]
+	if !ok {
+		return true
+	}
+	switch e.Comparator {
+	case "=":
+		return compareLiteral("<=", e.Value, r.min) && compareLiteral(">=", e.Value, r.max)
+	case "<", "<=":
+		return compareLiteral(e.Comparator, e.Value, r.min)
+	case ">", ">=":
+		return compareLiteral(e.Comparator, e.Value, r.max)
+	}
+	return true
+}
+
+func compareLiteral(op string, literal ast.Literal, v zng.Value) bool {
+	pred, err := filter.Comparison(op, literal)
+	if err != nil {
+		return true
+	}
+	return pred(v)
+}
+
+// fieldName returns the name of the field read by e, with the names of
+// nested records separated by dots.  The second return value is false if
+// e reads something other than a field.
+func fieldName(e ast.FieldExpr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.FieldRead:
+		return e.Field, true
+	case *ast.FieldCall:
+		if e.Fn != "RecordFieldRead" {
+			return "", false
+		}
+		prefix, ok := fieldName(e.Field)
+		if !ok {
+			return "", false
+		}
+		return prefix + "." + e.Param, true
+	}
+	return "", false
+}
@@ -0,0 +1,165 @@
+package archive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseFilter(t *testing.T, s string) ast.BooleanExpr {
+	proc, err := zql.ParseProc(s)
+	require.NoError(t, err)
+	fp, ok := proc.(*ast.FilterProc)
+	require.True(t, ok, s)
+	return fp.Filter
+}
+
+// chunkMatches returns true if any record of the chunk for zardir
+// satisfies e.
+func chunkMatches(t *testing.T, zardir iosrc.URI, e ast.BooleanExpr) bool {
+	f, err := filter.Compile(e)
+	require.NoError(t, err)
+	rc, err := iosrc.NewReader(ZarDirToLog(zardir))
+	require.NoError(t, err)
+	defer rc.Close()
+	r := zngio.NewReader(rc, resolver.NewContext())
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			return false
+		}
+		if f(rec) {
+			return true
+		}
+	}
+}
+
+func TestSkipIndex(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, "../tests/suite/data/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	rule := NewSkipRule([]string{"s"})
+	require.NoError(t, IndexDirTree(context.Background(), ark, []Rule{*rule}, "_", nil))
+
+	cases := []struct {
+		filter  string
+		skipped bool
+	}{
+		{"v > 498", true},
+		{"v <= 2", true},
+		{"v = 1000", true},
+		{"v = 100", false},
+		{"v >= 0", false},
+		{"v != 100", false},
+		{"s=Amazonism-intentness", true},
+		{"s=no-such-value", true},
+		{"s=Amazonism-intentness or v > 498", true},
+		{"s=Amazonism-intentness and v > 0", true},
+		{"not v > 498", false},
+		{"ts > 0", false},
+		{"s=~Am*", false},
+	}
+	for _, c := range cases {
+		e := parseFilter(t, c.filter)
+		var skipped int
+		require.NoError(t, Walk(ark, func(zardir iosrc.URI) error {
+			skip, err := readSkipIndex(zardir)
+			require.NoError(t, err)
+			require.NotNil(t, skip)
+			if !skip.canMatch(e) {
+				// A skipped chunk must not have any matches.
+				assert.False(t, chunkMatches(t, zardir, e), "%s: %s", c.filter, zardir)
+				skipped++
+			}
+			return nil
+		}))
+		assert.Equal(t, c.skipped, skipped > 0, c.filter)
+	}
+}
+
+func TestSkipIndexMissing(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	createArchiveSpace(t, datapath, "../tests/suite/data/babble.tzng", &CreateOptions{})
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	require.NoError(t, Walk(ark, func(zardir iosrc.URI) error {
+		skip, err := readSkipIndex(zardir)
+		require.NoError(t, err)
+		assert.Nil(t, skip)
+		return nil
+	}))
+}
+
+// countSources returns the number of chunks that msrc sends for a search
+// with the filter expression e, which may be nil.
+func countSources(t *testing.T, msrc driver.MultiSource, e ast.BooleanExpr) int {
+	sf := driver.SourceFilter{FilterExpr: e, Span: nano.MaxSpan}
+	if e != nil {
+		f, err := filter.Compile(e)
+		require.NoError(t, err)
+		sf.Filter = f
+	}
+	srcChan := make(chan driver.SourceOpener)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- msrc.SendSources(context.Background(), resolver.NewContext(), sf, srcChan)
+		close(srcChan)
+	}()
+	var n int
+	for range srcChan {
+		n++
+	}
+	require.NoError(t, <-errCh)
+	return n
+}
+
+func TestSkipIndexSources(t *testing.T) {
+	datapath, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(datapath)
+
+	thresh := int64(1000)
+	createArchiveSpace(t, datapath, "../tests/suite/data/babble.tzng", &CreateOptions{
+		LogSizeThreshold: &thresh,
+	})
+	ark, err := OpenArchive(datapath, nil)
+	require.NoError(t, err)
+	msrc := NewDirectionalMultiSource(ark, zbuf.DirTimeReverse)
+	chunks := countSources(t, msrc, nil)
+	require.Greater(t, chunks, 1)
+
+	// Without skip indexes, every chunk is opened.
+	e := parseFilter(t, "s=Amazonism-intentness")
+	assert.Equal(t, chunks, countSources(t, msrc, e))
+
+	rule := NewSkipRule([]string{"s"})
+	require.NoError(t, IndexDirTree(context.Background(), ark, []Rule{*rule}, "_", nil))
+	assert.Equal(t, chunks, countSources(t, msrc, nil))
+	assert.Equal(t, 1, countSources(t, msrc, e))
+	assert.Equal(t, 0, countSources(t, msrc, parseFilter(t, "v > 1000")))
+	assert.Equal(t, chunks, countSources(t, msrc, parseFilter(t, "v >= 0")))
+}
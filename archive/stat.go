@@ -144,7 +144,11 @@ func (s *statReadCloser) run() {
 	}
 	var indexPaths []string
 	s.ark.mu.RLock()
-	for k := range s.ark.indexes {
+	for k, info := range s.ark.indexes {
+		// Skip indexes are not microindexes.
+		if info.Type == "skip" {
+			continue
+		}
 		indexPaths = append(indexPaths, k)
 	}
 	s.ark.mu.RUnlock()
@@ -10,6 +10,7 @@ import (
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng/resolver"
 )
 
@@ -47,14 +48,29 @@ func Walk(ark *Archive, visit Visitor) error {
 type SpanVisitor func(si SpanInfo, zardir iosrc.URI) error
 
 func SpanWalk(ark *Archive, v SpanVisitor) error {
+	return spanWalk(ark, false, v)
+}
+
+// spanWalk is like SpanWalk but visits the spans in the opposite of the
+// archive's data sort direction if reverse is true.
+func spanWalk(ark *Archive, reverse bool, v SpanVisitor) error {
 	if _, err := ark.UpdateCheck(); err != nil {
 		return err
 	}
 
 	ark.mu.RLock()
 	defer ark.mu.RUnlock()
+	return walkSpans(ark, ark.spans, reverse, v)
+}
 
-	for _, s := range ark.spans {
+// walkSpans visits spans, which are in the archive's data sort direction,
+// in that order or in reverse.
+func walkSpans(ark *Archive, spans []SpanInfo, reverse bool, v SpanVisitor) error {
+	for i := range spans {
+		s := spans[i]
+		if reverse {
+			s = spans[len(spans)-1-i]
+		}
 		zardir := LogToZarDir(s.LogID.Path(ark))
 		if dirmkr, ok := ark.dataSrc.(iosrc.DirMaker); ok {
 			if err := dirmkr.MkdirAll(zardir, 0700); err != nil {
@@ -74,9 +90,32 @@ func RmDirs(ark *Archive) error {
 	return Walk(ark, ark.dataSrc.RemoveAll)
 }
 
+// Spans returns the spans of the archive's chunks, in its data sort
+// direction, as of the latest update.
+func Spans(ark *Archive) ([]SpanInfo, error) {
+	spans, _, err := CommittedSpans(ark)
+	return spans, err
+}
+
+// CommittedSpans is like Spans but also returns the number of the commit
+// whose journal entry was the last applied to the spans, which may be
+// passed to Imported to find the records added since.
+func CommittedSpans(ark *Archive) ([]SpanInfo, int, error) {
+	if _, err := ark.UpdateCheck(); err != nil {
+		return nil, 0, err
+	}
+	ark.mu.RLock()
+	defer ark.mu.RUnlock()
+	return append([]SpanInfo(nil), ark.spans...), ark.commit, nil
+}
+
 type multiSource struct {
-	ark   *Archive
-	paths []string
+	ark     *Archive
+	paths   []string
+	reverse bool
+	// spans, if not nil, holds the chunks to visit in place of those of
+	// the archive when the sources are sent.
+	spans []SpanInfo
 }
 
 // NewMultiSource returns a driver.MultiSource for an Archive. If no paths are
@@ -94,9 +133,43 @@ func NewMultiSource(ark *Archive, paths []string) driver.MultiSource {
 	}
 }
 
+// NewDirectionalMultiSource returns a driver.MultiSource for an Archive
+// that sends a source for each chunk file, with the records of each chunk
+// and the chunks themselves ordered by time in the direction dir.  When
+// dir is opposite to the archive's data sort direction, the chunks are
+// visited in reverse and each chunk is read from its last stream to
+// its first.
+func NewDirectionalMultiSource(ark *Archive, dir zbuf.Direction) driver.MultiSource {
+	return &multiSource{
+		ark:     ark,
+		paths:   []string{"_"},
+		reverse: dir != ark.DataSortDirection,
+	}
+}
+
+// NewSpansMultiSource is like NewDirectionalMultiSource but sends a source
+// for each chunk in spans, as returned by Spans, rather than for each chunk
+// of the archive when the sources are sent.
+func NewSpansMultiSource(ark *Archive, spans []SpanInfo, dir zbuf.Direction) driver.MultiSource {
+	if spans == nil {
+		spans = []SpanInfo{}
+	}
+	return &multiSource{
+		ark:     ark,
+		paths:   []string{"_"},
+		reverse: dir != ark.DataSortDirection,
+		spans:   spans,
+	}
+}
+
+// isChunks returns true if the sources are the chunk files themselves.
+func (ams *multiSource) isChunks() bool {
+	return len(ams.paths) == 1 && ams.paths[0] == "_"
+}
+
 func (ams *multiSource) OrderInfo() (string, bool) {
-	if len(ams.paths) == 1 && ams.paths[0] == "_" {
-		return "ts", ams.ark.DataSortDirection == zbuf.DirTimeReverse
+	if ams.isChunks() {
+		return "ts", (ams.ark.DataSortDirection == zbuf.DirTimeReverse) != ams.reverse
 	}
 	return "", false
 }
@@ -107,11 +180,31 @@ type archiveSource struct {
 }
 
 func (ams *multiSource) SendSources(ctx context.Context, zctx *resolver.Context, sf driver.SourceFilter, srcChan chan driver.SourceOpener) error {
-	return SpanWalk(ams.ark, func(si SpanInfo, zardir iosrc.URI) error {
+	walk := func(v SpanVisitor) error {
+		return spanWalk(ams.ark, ams.reverse, v)
+	}
+	if ams.spans != nil {
+		walk = func(v SpanVisitor) error {
+			return walkSpans(ams.ark, ams.spans, ams.reverse, v)
+		}
+	}
+	return walk(func(si SpanInfo, zardir iosrc.URI) error {
 		if !sf.Span.Overlaps(si.Span) {
 			return nil
 		}
+		if sf.FilterExpr != nil && ams.isChunks() {
+			skip, err := readSkipIndex(zardir)
+			if err != nil {
+				return err
+			}
+			if skip != nil && !skip.canMatch(sf.FilterExpr) {
+				return nil
+			}
+		}
 		so := func() (driver.ScannerCloser, error) {
+			if ams.reverse {
+				return openReverse(ctx, zctx, ZarDirToLog(zardir), sf)
+			}
 			// In the future, we could determine if any microindex in
 			// this zardir would be useful as a filter by comparing the
 			// filter expression in sf.FilterExpr against the available
@@ -142,3 +235,27 @@ func (ams *multiSource) SendSources(ctx context.Context, zctx *resolver.Context,
 		}
 	})
 }
+
+// openReverse returns a driver.ScannerCloser for the chunk file at uri that
+// scans its records in the opposite of their order in the file.
+func openReverse(ctx context.Context, zctx *resolver.Context, uri iosrc.URI, sf driver.SourceFilter) (driver.ScannerCloser, error) {
+	info, err := iosrc.Stat(uri)
+	if err != nil {
+		return nil, err
+	}
+	r, err := iosrc.NewReader(uri)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zngio.NewReverseReader(r, info.Size(), zctx, sf.Span)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	sn, err := scanner.NewScanner(ctx, zr, sf.Filter, sf.FilterExpr, sf.Span)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &archiveSource{Scanner: sn, Closer: r}, nil
+}
@@ -122,7 +122,7 @@ type (
 	}
 
 	// A FieldCall is an operation performed on the value in some field,
-	// e.g., len(some_set) or some_array[1].
+	// e.g., len(some_set), some_array[1], or some_map["key"].
 	FieldCall struct {
 		Node
 		Fn    string    `json:"fn"`
@@ -262,8 +262,8 @@ type (
 	// results from each key as they complete so that large inputs
 	// are processed and streamed efficiently.
 	// The Limit field specifies the number of different groups that can be
-	// aggregated over. When absent, the runtime defaults to an
-	// appropriate value.
+	// held in memory, beyond which the proc spills to disk. When absent,
+	// the runtime defaults to an appropriate value.
 	// If EmitPart is true, the proc will produce decomposed
 	// output results, using the reducer.ResultPart()
 	// method. Likewise, if ConsumePart is true, the proc will
@@ -302,6 +302,22 @@ type (
 		Node
 		Fields []FieldAssignment `json:"fields"`
 	}
+
+	// A JoinProc node represents a proc that combines the records of
+	// its two parents, which are the branches of the ParallelProc that
+	// precedes it.  Each record from the left (first) parent is matched
+	// with every record from the right (second) parent whose RightKeys
+	// values equal its LeftKeys values, and the fields of the right
+	// record that are not already present in the left record are
+	// appended to it.  If Kind is "left", left records with no match
+	// are passed through unmodified; otherwise ("inner"), they are
+	// dropped.
+	JoinProc struct {
+		Node
+		Kind      string      `json:"kind"`
+		LeftKeys  []FieldExpr `json:"left_keys"`
+		RightKeys []FieldExpr `json:"right_keys"`
+	}
 )
 
 type ExpressionAssignment struct {
@@ -346,13 +362,17 @@ func (*GroupByProc) ProcNode()    {}
 func (*TopProc) ProcNode()        {}
 func (*PutProc) ProcNode()        {}
 func (*RenameProc) ProcNode()     {}
+func (*JoinProc) ProcNode()       {}
 
 // A Reducer is an AST node that represents a reducer function.  The Op
 // parameter indicates the specific reducer while the Field parameter indicates
 // which field of the incoming records should be operated upon by the reducer.
-// The result is given the field name specified by the Var parameter.
+// The result is given the field name specified by the Var parameter.  The
+// Param parameter holds the numeric argument of reducers that take one
+// (e.g., the percentile of a Percentile reducer).
 type Reducer struct {
 	Node
 	Var   string    `json:"var"`
 	Field FieldExpr `json:"field,omitempty"`
+	Param float64   `json:"param,omitempty"`
 }
@@ -107,6 +107,16 @@ func unpackProc(custom Unpacker, node joe.JSON) (Proc, error) {
 		return &TopProc{Fields: fields}, nil
 	case "PassProc":
 		return &PassProc{}, nil
+	case "JoinProc":
+		leftKeys, err := unpackFieldExprArray(node.Get("left_keys"))
+		if err != nil {
+			return nil, err
+		}
+		rightKeys, err := unpackFieldExprArray(node.Get("right_keys"))
+		if err != nil {
+			return nil, err
+		}
+		return &JoinProc{LeftKeys: leftKeys, RightKeys: rightKeys}, nil
 	default:
 		return nil, fmt.Errorf("unknown proc op: %s", op)
 	}
@@ -20,7 +20,7 @@ import (
 
 var Lookup = &charm.Spec{
 	Name:  "lookup",
-	Usage: "lookup -k key[,key...] index",
+	Usage: "lookup [-k key[,key...] | -lo key[,key...] -hi key[,key...] | -prefix string] index",
 	Short: "lookup a key in a microindex file and print value as zng record",
 	Long: `
 The lookup command locates the specified key(s) in the base layer of a
@@ -30,7 +30,14 @@ all the records that match the supplied keys.
 Each key argument specifies a value to look up in the table and must be parseable
 as the zng type of the key that was originally indexed where the keys refer to the leaf
 values in left-to-right order of the keys represented as a record, inclusive
-of any nested records.`,
+of any nested records.
+
+With -lo and -hi instead of -k, the lookup command displays all records
+whose keys are greater than or equal to the -lo keys and less than the -hi
+keys, in key order.  Either bound may be omitted to leave the range open on
+that side.  With -prefix, it displays all records whose first key, which
+must be a string, begins with the given string.  Both use the index to seek
+to the first match and then stream the matching records from the base layer.`,
 	New: newLookupCommand,
 }
 
@@ -41,6 +48,9 @@ func init() {
 type LookupCommand struct {
 	*root.Command
 	keys         string
+	lo           string
+	hi           string
+	prefix       string
 	outputFile   string
 	WriterFlags  zio.WriterFlags
 	closest      bool
@@ -52,6 +62,9 @@ func newLookupCommand(parent charm.Command, f *flag.FlagSet) (charm.Command, err
 	c := &LookupCommand{Command: parent.(*root.Command)}
 	f.StringVar(&c.keys, "k", "", "key(s) to search")
 	f.BoolVar(&c.closest, "c", false, "find closest insead of exact match")
+	f.StringVar(&c.lo, "lo", "", "lower bound (inclusive) of key(s) to search")
+	f.StringVar(&c.hi, "hi", "", "upper bound (exclusive) of key(s) to search")
+	f.StringVar(&c.prefix, "prefix", "", "string prefix of first key to search")
 	f.BoolVar(&c.textShortcut, "t", false, "use format tzng independent of -f option")
 	f.BoolVar(&c.forceBinary, "B", false, "allow binary zng be sent to a terminal output")
 	c.WriterFlags.SetFlags(f)
@@ -73,9 +86,22 @@ func (c *LookupCommand) Run(args []string) error {
 		return errors.New("writing binary zng data to terminal; override with -B or use -t for text.")
 	}
 	path := args[0]
-	if c.keys == "" {
+	isRange := c.lo != "" || c.hi != ""
+	var modes int
+	for _, set := range []bool{c.keys != "", isRange, c.prefix != ""} {
+		if set {
+			modes++
+		}
+	}
+	if modes == 0 {
 		return errors.New("must specify one or more comma-separated keys")
 	}
+	if modes > 1 {
+		return errors.New("only one of -k, -lo/-hi, and -prefix may be specified")
+	}
+	if c.closest && c.keys == "" {
+		return errors.New("-c requires -k")
+	}
 	uri, err := iosrc.ParseURI(path)
 	if err != nil {
 		return err
@@ -85,22 +111,47 @@ func (c *LookupCommand) Run(args []string) error {
 		return err
 	}
 	defer finder.Close()
-	keys, err := finder.ParseKeys(strings.Split(c.keys, ","))
-	if err != nil {
-		return err
+	var keys, lo, hi *zng.Record
+	if c.keys != "" {
+		keys, err = finder.ParseKeys(strings.Split(c.keys, ","))
+		if err != nil {
+			return err
+		}
+	}
+	if c.lo != "" {
+		lo, err = finder.ParseKeys(strings.Split(c.lo, ","))
+		if err != nil {
+			return err
+		}
+		if lo != nil {
+			// ParseKeys reuses its record, so keep lo when
+			// parsing hi.
+			lo = lo.Keep()
+		}
+	}
+	if c.hi != "" {
+		hi, err = finder.ParseKeys(strings.Split(c.hi, ","))
+		if err != nil {
+			return err
+		}
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	hits := make(chan *zng.Record)
 	var searchErr error
 	go func() {
-		if c.closest {
+		switch {
+		case c.closest:
 			var rec *zng.Record
 			rec, searchErr = finder.LookupClosest(keys)
 			if rec != nil {
 				hits <- rec
 			}
-		} else {
+		case isRange:
+			searchErr = finder.LookupRange(ctx, hits, lo, hi)
+		case c.prefix != "":
+			searchErr = finder.LookupPrefix(ctx, hits, c.prefix)
+		default:
 			searchErr = finder.LookupAll(ctx, hits, keys)
 		}
 		close(hits)
@@ -0,0 +1,90 @@
+package flows
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/brimsec/zq/cmd/pcap/root"
+	"github.com/brimsec/zq/emitter"
+	"github.com/brimsec/zq/pcap/flows"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/mccanne/charm"
+)
+
+var Flows = &charm.Spec{
+	Name:  "flows",
+	Usage: "flows [options]",
+	Short: "summarize the flows of a pcap as zng records",
+	Long: `
+The flows command reads a pcap and writes a conn-like record for each
+flow it contains, with the flow's 5-tuple, start time and duration,
+packet and byte counts in each direction, and, for TCP, the union of
+the TCP flags seen.  This provides basic visibility into a pcap when
+Zeek is not available.
+
+A flow is finished when it has been idle for the time given by -timeout,
+when its TCP connection is closed, or at the end of the pcap.  Records
+are written as flows finish, so they are not strictly ordered by time.
+`,
+	New: New,
+}
+
+func init() {
+	root.Pcap.Add(Flows)
+}
+
+type Command struct {
+	*root.Command
+	inputFile   string
+	outputFile  string
+	timeout     time.Duration
+	writerFlags zio.WriterFlags
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.inputFile, "r", "-", "input file to read from or stdin if -")
+	f.StringVar(&c.outputFile, "w", "-", "output file to create or stdout if -")
+	f.DurationVar(&c.timeout, "timeout", flows.DefaultTimeout, "idle time after which a flow is finished")
+	c.writerFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("pcap flows takes no arguments")
+	}
+	in := os.Stdin
+	if c.inputFile != "-" {
+		var err error
+		in, err = fs.Open(c.inputFile)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+	}
+	reader, err := pcapio.NewReader(in)
+	if err != nil {
+		return err
+	}
+	outputFile := c.outputFile
+	if outputFile == "-" {
+		outputFile = ""
+	}
+	writer, err := emitter.NewFile(outputFile, &c.writerFlags)
+	if err != nil {
+		return err
+	}
+	r := flows.NewReader(resolver.NewContext(), reader, c.timeout)
+	if err := zbuf.Copy(writer, r); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
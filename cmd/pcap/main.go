@@ -5,6 +5,7 @@ import (
 	"os"
 
 	_ "github.com/brimsec/zq/cmd/pcap/cut"
+	_ "github.com/brimsec/zq/cmd/pcap/flows"
 	_ "github.com/brimsec/zq/cmd/pcap/index"
 	"github.com/brimsec/zq/cmd/pcap/root"
 	_ "github.com/brimsec/zq/cmd/pcap/slice"
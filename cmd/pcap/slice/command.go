@@ -14,12 +14,16 @@ import (
 	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/fs"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/mccanne/charm"
 )
 
 var Slice = &charm.Spec{
 	Name:  "slice",
-	Usage: "slice [options] [ ip:port ip:port ]",
+	Usage: "slice [options] [ -f filter | ip:port ip:port ]",
 	Short: "extract a pcap using a time range and/or flow filter",
 	Long: `
 The slice command takes an (optional) index file,
@@ -46,6 +50,24 @@ If a flow filter is specified in the format "ip:port ip:port",
 along with a protocol ("tcp" or "udp" specified with -p), then only packets
 from that flow are matched.
 
+Alternatively, a tcpdump-style filter expression may be given with -f
+to match packets by host, network, port, and protocol, e.g.,
+
+	pcap slice -r in.pcap -f "net 10.1.2.0/24 and not port 53"
+
+The expression may combine the primitives "[src|dst] host ip",
+"[src|dst] net cidr", "[src|dst] port n", "[src|dst] portrange n-m",
+"ip", "ip6", "tcp", "udp", "sctp", "icmp", and "icmp6" with "and", "or",
+"not", and parentheses.  A flow filter may not be used together with -f.
+
+The -format option selects the output.  The default, "pcap", writes the
+matching packets.  "stream" writes the reassembled TCP payload sent by the
+originator of the matching connection, or by its responder if -stream is
+"resp", with retransmissions removed and out-of-order segments put in
+order.  It is an error for the packets to match more than one connection.
+"zng" writes the payload of each direction of every matching connection as
+ZNG records with a record for each contiguous chunk of data.
+
 The time format for -from and -to is currently float seconds since 1970-01-01.
 We will support more flexible time formats in the future.
 `,
@@ -63,6 +85,9 @@ type Command struct {
 	from       string
 	to         string
 	proto      string
+	filter     string
+	format     string
+	stream     string
 	*root.Command
 }
 
@@ -74,6 +99,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.from, "from", "", "beginning of time range")
 	f.StringVar(&c.to, "to", "", "end of time range")
 	f.StringVar(&c.proto, "p", "tcp", "transport protocol (tcp or udp)")
+	f.StringVar(&c.filter, "f", "", "packet filter expression")
+	f.StringVar(&c.format, "format", "pcap", "output format (pcap, stream, or zng)")
+	f.StringVar(&c.stream, "stream", "orig", "direction of stream output (orig or resp)")
 	return c, nil
 }
 
@@ -100,9 +128,22 @@ func (c *Command) Run(args []string) error {
 	if c.indexFile != "" && c.inputFile == "-" {
 		return errors.New("stdin cannot be used with an index file; use -r to specify the pcap file")
 	}
+	switch c.format {
+	case "pcap", "stream", "zng":
+	default:
+		return fmt.Errorf("pcap slice: unknown format: %s", c.format)
+	}
+	switch c.stream {
+	case "orig", "resp":
+	default:
+		return fmt.Errorf("pcap slice: unknown stream: %s", c.stream)
+	}
 	var flow pcap.Flow
 	filter := false
 	if len(args) == 2 {
+		if c.filter != "" {
+			return errors.New("pcap slice: flow filter cannot be used with -f")
+		}
 		var err error
 		flow, err = pcap.ParseFlow(args[0], args[1])
 		if err != nil {
@@ -116,6 +157,15 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	var search *pcap.Search
+	if c.filter != "" {
+		// Parse the expression before opening any files so that
+		// syntax errors are reported right away.
+		search, err = pcap.NewFilterSearch(span, c.filter)
+		if err != nil {
+			return err
+		}
+	}
 	in := os.Stdin
 	if c.inputFile != "-" {
 		in, err = fs.Open(c.inputFile)
@@ -153,7 +203,6 @@ func (c *Command) Run(args []string) error {
 		}()
 		out = w
 	}
-	var search *pcap.Search
 	if filter {
 		switch c.proto {
 		default:
@@ -165,8 +214,27 @@ func (c *Command) Run(args []string) error {
 		case "icmp":
 			search = pcap.NewICMPSearch(span, flow.S0.IP, flow.S1.IP)
 		}
-	} else {
+	} else if search == nil {
 		search = pcap.NewRangeSearch(span)
 	}
-	return search.Run(context.TODO(), out, pcapReader)
+	if c.format == "pcap" {
+		return search.Run(context.TODO(), out, pcapReader)
+	}
+	matches, err := search.Reader(context.TODO(), pcapReader)
+	if err != nil {
+		return err
+	}
+	matchReader, err := pcapio.NewReader(matches)
+	if err != nil {
+		return err
+	}
+	if c.format == "stream" {
+		_, err = io.Copy(out, pcap.NewStreamReader(matchReader, c.stream == "orig"))
+		return err
+	}
+	w := zngio.NewWriter(out, zio.WriterFlags{})
+	if err := zbuf.Copy(w, pcap.NewChunkReader(resolver.NewContext(), matchReader)); err != nil {
+		return err
+	}
+	return w.Flush()
 }
@@ -45,6 +45,7 @@ type Command struct {
 	stats      bool
 	warnings   bool
 	wire       bool
+	follow     bool
 	final      *api.SearchStats
 }
 
@@ -63,6 +64,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.ShowFields, "F", false, "display field names in text output")
 	f.BoolVar(&c.EpochDates, "E", false, "display epoch timestamps in text output")
 	f.BoolVar(&c.wire, "w", false, "dump what's on the wire")
+	f.BoolVar(&c.follow, "follow", false, "stream records added to the space until interrupted")
 	f.Var(&c.from, "from", "search from timestamp in RFC3339Nano format (e.g. 2006-01-02T15:04:05.999999999Z07:00)")
 	f.Var(&c.to, "to", "search to timestamp in RFC3339Nano format (e.g. 2006-01-02T15:04:05.999999999Z07:00)")
 	return c, nil
@@ -89,6 +91,7 @@ func (c *Command) Run(args []string) error {
 		return fmt.Errorf("parse error: %s", err)
 	}
 	req.Span = nano.NewSpanTs(nano.Ts(c.from), nano.Ts(c.to))
+	req.Follow = c.follow
 	params := map[string]string{"format": c.protocol}
 	r, err := client.SearchRaw(c.Context(), *req, params)
 	if err != nil {
@@ -21,7 +21,13 @@ var PostLog = &charm.Spec{
 	Name:  "post",
 	Usage: "post [options] path...",
 	Short: "post log file(s) to a space",
-	New:   NewLogPost,
+	Long: `
+The post command ingests log files into a space.  By default, the paths
+are sent to zqd, which opens the files on its own file system.  With -u,
+the contents of the local files are instead uploaded to zqd, so the files
+need not be accessible to zqd, as when it runs on another host.
+`,
+	New: NewLogPost,
 }
 
 func init() {
@@ -31,6 +37,7 @@ func init() {
 type LogCommand struct {
 	*cmd.Command
 	force      bool
+	upload     bool
 	bytesRead  int64
 	bytesTotal int64
 	start      time.Time
@@ -40,6 +47,7 @@ type LogCommand struct {
 func NewLogPost(parent charm.Command, flags *flag.FlagSet) (charm.Command, error) {
 	c := &LogCommand{Command: parent.(*cmd.Command)}
 	flags.BoolVar(&c.force, "f", false, "create space if specified space does not exist")
+	flags.BoolVar(&c.upload, "u", false, "upload the contents of the files to zqd")
 	return c, nil
 }
 
@@ -73,7 +81,22 @@ func (c *LogCommand) Run(args []string) (err error) {
 		return err
 	}
 	c.start = time.Now()
-	stream, err := client.LogPostStream(c.Context(), id, api.LogPostRequest{Paths: paths})
+	var stream *api.Stream
+	var uploadSize int64
+	if c.upload {
+		// The size zqd reports for an upload is that of the request
+		// body, which it may not know, so use the size of the files.
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			uploadSize += info.Size()
+		}
+		stream, err = client.LogPostFilesStream(c.Context(), id, api.LogPostRequest{Paths: paths})
+	} else {
+		stream, err = client.LogPostStream(c.Context(), id, api.LogPostRequest{Paths: paths})
+	}
 	if err != nil {
 		return err
 	}
@@ -94,7 +117,11 @@ loop:
 			break loop
 		case *api.LogPostStatus:
 			atomic.StoreInt64(&c.bytesRead, v.LogReadSize)
-			atomic.StoreInt64(&c.bytesTotal, v.LogTotalSize)
+			if c.upload {
+				atomic.StoreInt64(&c.bytesTotal, uploadSize)
+			} else {
+				atomic.StoreInt64(&c.bytesTotal, v.LogTotalSize)
+			}
 		}
 	}
 	if dp != nil {
@@ -0,0 +1,80 @@
+package ps
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/brimsec/zq/cmd/zapi/cmd"
+	"github.com/brimsec/zq/cmd/zapi/format"
+	"github.com/mccanne/charm"
+)
+
+var Ps = &charm.Spec{
+	Name:  "ps",
+	Usage: "ps",
+	Short: "list running searches",
+	Long: `The ps command lists the searches currently running on the zqd host along
+with their statistics.  The id of a search may be given to the kill command
+to cancel it.`,
+	New: func(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+		return &Command{Command: parent.(*cmd.Command)}, nil
+	},
+}
+
+var Kill = &charm.Spec{
+	Name:  "kill",
+	Usage: "kill id [id ...]",
+	Short: "cancel running searches",
+	Long: `The kill command cancels each running search whose id is given as an argument.
+The ids of running searches are listed by the ps command.`,
+	New: func(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+		return &KillCommand{Command: parent.(*cmd.Command)}, nil
+	},
+}
+
+func init() {
+	cmd.CLI.Add(Ps)
+	cmd.CLI.Add(Kill)
+}
+
+type Command struct {
+	*cmd.Command
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) > 0 {
+		return errors.New("too many arguments")
+	}
+	searches, err := c.Client().SearchList(c.Context())
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSPACE\tSTARTED\tREAD\tMATCHED")
+	for _, s := range searches {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", s.ID, s.Space,
+			s.StartTime.Time().Format("2006-01-02T15:04:05"),
+			format.Bytes(s.BytesRead), s.RecordsMatched)
+	}
+	return w.Flush()
+}
+
+type KillCommand struct {
+	*cmd.Command
+}
+
+func (c *KillCommand) Run(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no search id provided")
+	}
+	for _, id := range args {
+		if err := c.Client().SearchCancel(c.Context(), id); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		fmt.Printf("%s: search canceled\n", id)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/new"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/newsubspace"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/post"
+	_ "github.com/brimsec/zq/cmd/zapi/cmd/ps"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rename"
 	_ "github.com/brimsec/zq/cmd/zapi/cmd/rm"
 )
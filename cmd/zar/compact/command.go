@@ -0,0 +1,84 @@
+package compact
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/rlimit"
+	"github.com/brimsec/zq/pkg/signalctx"
+	"github.com/mccanne/charm"
+)
+
+var Compact = &charm.Spec{
+	Name:  "compact",
+	Usage: "compact [-R root] [-q]",
+	Short: "merge small chunk files in an archive",
+	Long: `
+"zar compact" rewrites each run of adjacent chunk files that are smaller
+than the archive's log size threshold, such as those created by many small
+imports, as chunk files of up to the threshold in size.  The archive's
+indexes are rebuilt for the new chunk files before the archive's metadata
+is updated, and the old chunk files and their zar directories are then
+removed.
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Compact)
+}
+
+type Command struct {
+	*root.Command
+	root  string
+	quiet bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
+	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zar compact: too many arguments")
+	}
+	if c.root == "" {
+		return errors.New("zar compact: a directory must be specified with -R or ZAR_ROOT")
+	}
+	if _, err := rlimit.RaiseOpenFilesLimit(); err != nil {
+		return err
+	}
+
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	var progress chan string
+	if !c.quiet {
+		wg.Add(1)
+		progress = make(chan string)
+		go func() {
+			for line := range progress {
+				fmt.Println(line)
+			}
+			wg.Done()
+		}()
+	}
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	err = archive.Compact(ctx, ark, progress)
+	if progress != nil {
+		close(progress)
+		wg.Wait()
+	}
+	return err
+}
@@ -2,9 +2,11 @@ package find
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/brimsec/zq/archive"
 	"github.com/brimsec/zq/cmd/zar/root"
@@ -46,6 +48,17 @@ could be queried using syntax like this
 
 	zar find -x custom 99 10.0.0.1 hello
 
+With -lo and -hi, "zar find" performs a range search instead, matching
+all keys greater than or equal to the -lo keys and less than the -hi keys,
+where either bound may be omitted.  With -prefix, it matches all keys
+beginning with the given string.  For these searches, the argument of a
+standard index is just the field name or ":" followed by the type name,
+and there is no argument for a custom index.  For example,
+
+	zar find -lo 1000 -hi 2000 :port
+	zar find -prefix www. query
+	zar find -x custom -lo 10,10.0.0.0 -hi 20
+
 The results of a search is either a list of the paths of each
 zng log that matches the pattern (the default), or a zng stream of the
 records of the base layer of the index file (-z)
@@ -62,6 +75,9 @@ type Command struct {
 	root          string
 	skipMissing   bool
 	indexFile     string
+	lo            string
+	hi            string
+	prefix        string
 	outputFile    string
 	pathField     string
 	relativePaths bool
@@ -74,6 +90,9 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
 	f.BoolVar(&c.skipMissing, "Q", false, "skip errors caused by missing index files ")
 	f.StringVar(&c.indexFile, "x", "", "name of microindex for custom index searches")
+	f.StringVar(&c.lo, "lo", "", "lower bound (inclusive) of range search key(s)")
+	f.StringVar(&c.hi, "hi", "", "upper bound (exclusive) of range search key(s)")
+	f.StringVar(&c.prefix, "prefix", "", "string prefix of first key to search")
 	f.StringVar(&c.outputFile, "o", "", "write data to output file")
 	f.StringVar(&c.pathField, "l", archive.DefaultAddPathField, "zng field name for path name of log file")
 	f.BoolVar(&c.relativePaths, "relative", false, "display paths relative to root")
@@ -91,7 +110,18 @@ func (c *Command) Run(args []string) error {
 		return err
 	}
 
-	query, err := archive.ParseIndexQuery(c.indexFile, args)
+	isRange := c.lo != "" || c.hi != ""
+	var query archive.IndexQuery
+	switch {
+	case isRange && c.prefix != "":
+		return errors.New("-lo/-hi and -prefix cannot be combined")
+	case isRange:
+		query, err = archive.ParseRangeQuery(c.indexFile, args, splitKeys(c.lo), splitKeys(c.hi))
+	case c.prefix != "":
+		query, err = archive.ParsePrefixQuery(c.indexFile, args, c.prefix)
+	default:
+		query, err = archive.ParseIndexQuery(c.indexFile, args)
+	}
 	if err != nil {
 		return err
 	}
@@ -143,3 +173,10 @@ func (c *Command) Run(args []string) error {
 	}
 	return searchErr
 }
+
+func splitKeys(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
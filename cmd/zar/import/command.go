@@ -29,6 +29,11 @@ The input data is sorted and partitioned by time into approximately equal
 sized ZNG files, called "chunks". The path of each chunk is a subdirectory in
 the specified root location (-R or ZAR_ROOT), where the subdirectory name is
 derived from the timestamp of the first zng record in that chunk.
+
+Importing into an existing archive merges the new data with any chunks
+whose time spans overlap it, rewriting those chunks so that no two
+chunks in the archive overlap in time.  The archive's indexes are rebuilt
+for the rewritten chunks, as they are by "zar compact".
 `,
 	New: New,
 }
@@ -17,7 +17,7 @@ import (
 
 var Index = &charm.Spec{
 	Name:  "index",
-	Usage: "index [-R root] [options] [-z zql] [ pattern [ pattern ...]]",
+	Usage: "index [-R root] [options] [-z zql] [-skip] [ pattern [ pattern ...]]",
 	Short: "create index files for zng files",
 	Long: `
 "zar index" creates index files in a zar archive using one or more indexing
@@ -35,6 +35,17 @@ For custom indexes, zql can be used instead of a pattern. This
 requires specifying the key and output file name. For example:
 
        zar index -k id.orig_h -o custom -z "count() by _path, id.orig_h | sort id.orig_h"
+
+The -skip option additionally creates a skip index for each log file,
+which records the minimum and maximum values of each numeric, time, and
+duration field and a Bloom filter of the values of each field given as a
+pattern.  Searches of the archive with "zar zq" and zqd consult skip indexes
+to pass over log files that cannot match the search filter.  For example,
+
+	zar index -R /path/to/logs -skip id.orig_h
+
+creates a microindex for id.orig_h and a skip index with a Bloom filter for
+id.orig_h.  With -skip, patterns are optional.
 `,
 	New: New,
 }
@@ -52,6 +63,7 @@ type Command struct {
 	framesize  int
 	keys       string
 	zql        string
+	skip       bool
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -63,11 +75,12 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.outputFile, "o", "index.zng", "name of microindex output file (for custom indexes)")
 	f.BoolVar(&c.quiet, "q", false, "don't print progress on stdout")
 	f.StringVar(&c.zql, "z", "", "zql for custom indexes")
+	f.BoolVar(&c.skip, "skip", false, "create skip indexes with Bloom filters for the field patterns")
 	return c, nil
 }
 
 func (c *Command) Run(args []string) error {
-	if len(args) == 0 && c.zql == "" {
+	if len(args) == 0 && c.zql == "" && !c.skip {
 		return errors.New("zar index: one or more indexing patterns must be specified")
 	}
 	if c.root == "" {
@@ -98,6 +111,15 @@ func (c *Command) Run(args []string) error {
 		}
 		rules = append(rules, *rule)
 	}
+	if c.skip {
+		var bloomFields []string
+		for _, pattern := range args {
+			if pattern[0] != ':' {
+				bloomFields = append(bloomFields, pattern)
+			}
+		}
+		rules = append(rules, *archive.NewSkipRule(bloomFields))
+	}
 	var wg sync.WaitGroup
 	var progress chan string
 	if !c.quiet {
@@ -0,0 +1,99 @@
+package retain
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/cmd/zar/root"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/mccanne/charm"
+)
+
+var Retain = &charm.Spec{
+	Name:  "retain",
+	Usage: "retain [-R root] [-q] -older-than duration",
+	Short: "remove old data from an archive",
+	Long: `
+"zar retain" removes the chunk files of an archive, along with their zar
+directories, whose data is entirely older than the duration given by
+-older-than.  The duration is a Go duration such as "36h" or a number of
+days such as "30d".  Chunk files holding data on both sides of the cutoff
+are kept.  The archive's metadata is updated atomically before any files
+are removed.
+`,
+	New: New,
+}
+
+func init() {
+	root.Zar.Add(Retain)
+}
+
+type Command struct {
+	*root.Command
+	root      string
+	quiet     bool
+	olderThan string
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*root.Command)}
+	f.StringVar(&c.root, "R", os.Getenv("ZAR_ROOT"), "root location of zar archive to walk")
+	f.BoolVar(&c.quiet, "q", false, "don't print removed chunks on stdout")
+	f.StringVar(&c.olderThan, "older-than", "", "remove data older than this duration (e.g., 30d or 12h)")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	if len(args) != 0 {
+		return errors.New("zar retain: too many arguments")
+	}
+	if c.root == "" {
+		return errors.New("zar retain: a directory must be specified with -R or ZAR_ROOT")
+	}
+	if c.olderThan == "" {
+		return errors.New("zar retain: a duration must be specified with -older-than")
+	}
+	age, err := parseAge(c.olderThan)
+	if err != nil {
+		return fmt.Errorf("zar retain: %w", err)
+	}
+	ark, err := archive.OpenArchive(c.root, nil)
+	if err != nil {
+		return err
+	}
+	cutoff := nano.Now().Add(-int64(age))
+	removed, err := archive.Retain(ark, cutoff)
+	if !c.quiet {
+		for _, s := range removed {
+			fmt.Printf("removed %s\n", s.LogID)
+		}
+	}
+	return err
+}
+
+// parseAge parses a Go duration or a whole number of days followed by "d".
+func parseAge(s string) (time.Duration, error) {
+	var d time.Duration
+	if days := strings.TrimSuffix(s, "d"); days != s {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, err
+		}
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration: %s", s)
+	}
+	return d, nil
+}
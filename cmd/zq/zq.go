@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -61,6 +62,12 @@ beginning of stream will determine the format.
 
 The output format is text zng by default, but can be overridden with -f.
 
+With -follow, zq follows each input file as it grows, like tail -f,
+processing records as they are appended to the files rather than stopping
+at the end of the input.  Records from different files are processed in
+the order they are read rather than merged by time.  Interrupting zq stops
+following the files and completes the query over the records read so far.
+
 After the options, the query may be specified as a
 single argument conforming with ZQL syntax; i.e., it should be quoted as
 a single string in the shell.
@@ -94,6 +101,7 @@ type Command struct {
 	quiet           bool
 	showVersion     bool
 	stopErr         bool
+	follow          bool
 	forceBinary     bool
 	sortMemMaxBytes int
 	textShortcut    bool
@@ -123,6 +131,7 @@ func New(f *flag.FlagSet) (charm.Command, error) {
 	f.BoolVar(&c.stats, "S", false, "display search stats on stderr")
 	f.BoolVar(&c.quiet, "q", false, "don't display zql warnings")
 	f.BoolVar(&c.stopErr, "e", true, "stop upon input errors")
+	f.BoolVar(&c.follow, "follow", false, "follow input files as they grow, like tail -f")
 	f.IntVar(&c.sortMemMaxBytes, "sortmem", sort.MemMaxBytes, "maximum memory used by sort, in bytes")
 	f.BoolVar(&c.showVersion, "version", false, "print version and exit")
 	f.BoolVar(&c.textShortcut, "t", false, "use format tzng independent of -f option")
@@ -184,8 +193,8 @@ func (c *Command) Run(args []string) error {
 	if c.textShortcut {
 		c.WriterFlags.Format = "tzng"
 	}
-	if c.outputFile == "" && c.WriterFlags.Format == "zng" && isTerminal(os.Stdout) && !c.forceBinary {
-		return errors.New("zq: writing binary zng data to terminal; override with -B or use -t for text.")
+	if c.outputFile == "" && (c.WriterFlags.Format == "zng" || c.WriterFlags.Format == "parquet") && isTerminal(os.Stdout) && !c.forceBinary {
+		return fmt.Errorf("zq: writing binary %s data to terminal; override with -B or use -t for text.", c.WriterFlags.Format)
 	}
 	if _, err := regexp.Compile(c.jsonPathRegexp); err != nil {
 		return err
@@ -232,7 +241,9 @@ func (c *Command) Run(args []string) error {
 		return err
 	}
 
-	readers, err := c.inputReaders(paths)
+	ctx, cancel := signalctx.New(os.Interrupt)
+	defer cancel()
+	readers, err := c.inputReaders(ctx, paths)
 	if err != nil {
 		return err
 	}
@@ -243,7 +254,15 @@ func (c *Command) Run(args []string) error {
 			readers[i] = zbuf.NewWarningReader(r, wch)
 		}
 	}
-	reader := zbuf.NewCombiner(readers, zbuf.CmpTimeForward)
+	var reader zbuf.ReadCloser
+	if c.follow {
+		reader = detector.Interleave(readers)
+		// An interrupt ends the input rather than the query so that
+		// the query completes over the records read so far.
+		ctx = context.Background()
+	} else {
+		reader = zbuf.NewCombiner(readers, zbuf.CmpTimeForward)
+	}
 	defer reader.Close()
 
 	writer, err := c.openOutput()
@@ -254,8 +273,6 @@ func (c *Command) Run(args []string) error {
 	if !c.quiet {
 		d.SetWarningsWriter(os.Stderr)
 	}
-	ctx, cancel := signalctx.New(os.Interrupt)
-	defer cancel()
 	if err := driver.Run(ctx, d, query, c.zctx, reader, driver.Config{
 		Warnings: wch,
 	}); err != nil {
@@ -269,11 +286,12 @@ func (c *Command) errorf(format string, args ...interface{}) {
 	_, _ = fmt.Fprintf(os.Stderr, format, args...)
 }
 
-func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
+func (c *Command) inputReaders(ctx context.Context, paths []string) ([]zbuf.Reader, error) {
 	cfg := detector.OpenConfig{
 		Format:         c.ReaderFlags.Format,
 		JSONTypeConfig: c.jsonTypeConfig,
 		JSONPathRegex:  c.jsonPathRegexp,
+		JSONMaps:       c.ReaderFlags.JSONMaps,
 		ZngCheck:       c.ReaderFlags.ZngCheck,
 	}
 	var readers []zbuf.Reader
@@ -281,7 +299,13 @@ func (c *Command) inputReaders(paths []string) ([]zbuf.Reader, error) {
 		if path == "-" {
 			path = detector.StdinPath
 		}
-		file, err := detector.OpenFile(c.zctx, path, cfg)
+		var file *zbuf.File
+		var err error
+		if c.follow && path != detector.StdinPath {
+			file, err = detector.OpenFollowFile(ctx, c.zctx, path, cfg)
+		} else {
+			file, err = detector.OpenFile(c.zctx, path, cfg)
+		}
 		if err != nil {
 			err = fmt.Errorf("%s: %w", path, err)
 			if c.stopErr {
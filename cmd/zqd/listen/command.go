@@ -53,6 +53,7 @@ type Command struct {
 	pprof          bool
 	prom           bool
 	zeekRunnerPath string
+	flows          bool
 	configfile     string
 	loggerConf     *logger.Config
 	logLevel       zapcore.Level
@@ -69,6 +70,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
 	f.StringVar(&c.conf.Root, "data", ".", "data location")
 	f.StringVar(&c.zeekRunnerPath, "zeekrunner", "", "path to command that generates zeek logs from pcap data")
+	f.BoolVar(&c.flows, "flows", false, "generate flow records from pcap data with the built-in extractor instead of zeek")
 	f.BoolVar(&c.pprof, "pprof", false, "add pprof routes to api")
 	f.BoolVar(&c.prom, "prometheus", false, "add prometheus metrics routes to api")
 	f.StringVar(&c.configfile, "config", "", "path to a zqd config file")
@@ -216,6 +218,13 @@ func (c *Command) loadConfigFile() error {
 }
 
 func (c *Command) initZeek() error {
+	if c.flows {
+		if c.zeekRunnerPath != "" {
+			return errors.New("-flows and -zeekrunner may not be used together")
+		}
+		c.conf.ZeekLauncher = zeek.FlowLauncher
+		return nil
+	}
 	if c.zeekRunnerPath == "" {
 		var err error
 		if c.zeekRunnerPath, err = exec.LookPath("zeekrunner"); err != nil {
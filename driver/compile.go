@@ -341,7 +341,7 @@ func computeColumnsR(p ast.Proc, colset map[string]struct{}) (map[string]struct{
 		// colsets on each branch, and then merge them at the
 		// split point.)
 		return nil, true
-	case *ast.UniqProc:
+	case *ast.UniqProc, *ast.JoinProc:
 		return nil, true
 	case *ast.HeadProc, *ast.TailProc, *ast.PassProc:
 		return colset, false
@@ -424,7 +424,7 @@ func buildSplitFlowgraph(branch, tail []ast.Proc, mergeField string, reverse boo
 
 func decomposable(rs []ast.Reducer) bool {
 	for _, r := range rs {
-		cr, err := rcompile.Compile(r)
+		cr, err := rcompile.Compile(resolver.NewContext(), r)
 		if err != nil {
 			return false
 		}
@@ -527,7 +527,7 @@ func parallelizeFlowgraph(seq *ast.SequentialProc, N int, inputSortField string,
 				// Unknown or multiple sort fields: we sort after the merge point, which can be unordered.
 				return buildSplitFlowgraph(seq.Procs[0:i], seq.Procs[i:], "", dir, N), true
 			}
-		case *ast.ParallelProc:
+		case *ast.ParallelProc, *ast.JoinProc:
 			return seq, false
 		case *ast.HeadProc, *ast.TailProc:
 			if inputSortField == "" {
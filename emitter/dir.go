@@ -19,16 +19,20 @@ var (
 // same descriptor to a file named <prefix><path>.<ext> in the directory indicated,
 // where <prefix> and <ext> are specificied and <path> is determined by the
 // _path field in the boom descriptor.  Note that more than one descriptor
-// can map to the same output file.
+// can map to the same output file except for formats like Parquet and CSV
+// that hold records of a single type, for which each descriptor after the
+// first with a given _path is written to <prefix><path>-<n>.<ext>.
 type Dir struct {
-	dir     iosrc.URI
-	prefix  string
-	ext     string
-	stderr  io.Writer // XXX use warnings channel
-	flags   *zio.WriterFlags
-	writers map[*zng.TypeRecord]*zio.Writer
-	paths   map[string]*zio.Writer
-	source  iosrc.Source
+	dir        iosrc.URI
+	prefix     string
+	ext        string
+	stderr     io.Writer // XXX use warnings channel
+	flags      *zio.WriterFlags
+	writers    map[*zng.TypeRecord]*zio.Writer
+	paths      map[string]*zio.Writer
+	counts     map[string]int
+	singleType bool
+	source     iosrc.Source
 }
 
 func unknownFormat(format string) error {
@@ -58,17 +62,29 @@ func NewDirWithSource(dir iosrc.URI, prefix string, stderr io.Writer, flags *zio
 		return nil, unknownFormat(flags.Format)
 	}
 	return &Dir{
-		dir:     dir,
-		prefix:  prefix,
-		ext:     e,
-		stderr:  stderr,
-		flags:   flags,
-		writers: make(map[*zng.TypeRecord]*zio.Writer),
-		paths:   make(map[string]*zio.Writer),
-		source:  source,
+		dir:        dir,
+		prefix:     prefix,
+		ext:        e,
+		stderr:     stderr,
+		flags:      flags,
+		writers:    make(map[*zng.TypeRecord]*zio.Writer),
+		paths:      make(map[string]*zio.Writer),
+		counts:     make(map[string]int),
+		singleType: isSingleType(flags.Format),
+		source:     source,
 	}, nil
 }
 
+// isSingleType returns true for output formats that require every record
+// in a file to have the same type.
+func isSingleType(format string) bool {
+	switch format {
+	case "csv", "tsv", "parquet":
+		return true
+	}
+	return false
+}
+
 func (d *Dir) Write(r *zng.Record) error {
 	out, err := d.lookupOutput(r)
 	if err != nil {
@@ -91,14 +107,17 @@ func (d *Dir) lookupOutput(rec *zng.Record) (*zio.Writer, error) {
 	return w, nil
 }
 
-// filename returns the name of the file for the specified path. This handles
-// the case of two tds one _path, adding a # in the filename for every _path that
-// has more than one td.
+// filename returns the name of the file for the specified path. For
+// single-type formats, this handles the case of two tds with one _path by
+// adding a -<n> suffix for every td after the first.
 func (d *Dir) filename(r *zng.Record) (iosrc.URI, string) {
 	var _path string
 	base, err := r.AccessString("_path")
 	if err == nil {
 		_path = base
+		if n := d.counts[_path]; n > 0 {
+			base += "-" + strconv.Itoa(n)
+		}
 	} else {
 		base = strconv.Itoa(r.Type.ID())
 	}
@@ -109,7 +128,11 @@ func (d *Dir) filename(r *zng.Record) (iosrc.URI, string) {
 func (d *Dir) newFile(rec *zng.Record) (*zio.Writer, error) {
 	filename, path := d.filename(rec)
 	if w, ok := d.paths[path]; ok {
-		return w, nil
+		if !d.singleType {
+			return w, nil
+		}
+		d.counts[path]++
+		filename, _ = d.filename(rec)
 	}
 	w, err := NewFileWithSource(filename, d.flags, d.source)
 	if err != nil {
@@ -41,6 +41,36 @@ func TestDirS3Source(t *testing.T) {
 	require.NoError(t, zbuf.Copy(w, r))
 }
 
+func TestDirSingleTypeFormat(t *testing.T) {
+	path := "s3://testbucket/dir"
+	tzng := `
+#0:record[_path:string,foo:string]
+0:[conn;1;]
+#1:record[_path:string,bar:string]
+1:[conn;2;]
+0:[conn;3;]`
+	uri, err := iosrc.ParseURI(path)
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	src := iosrcmock.NewMockSource(ctrl)
+
+	conn := bytes.NewBuffer(nil)
+	conn1 := bytes.NewBuffer(nil)
+	src.EXPECT().NewWriter(uri.AppendPath("conn.csv")).
+		Return(&nopCloser{conn}, nil)
+	src.EXPECT().NewWriter(uri.AppendPath("conn-1.csv")).
+		Return(&nopCloser{conn1}, nil)
+
+	r := tzngio.NewReader(strings.NewReader(tzng), resolver.NewContext())
+	w, err := NewDirWithSource(uri, "", os.Stderr, &zio.WriterFlags{Format: "csv"}, src)
+	require.NoError(t, err)
+	require.NoError(t, zbuf.Copy(w, r))
+	require.NoError(t, w.Close())
+	require.Equal(t, "_path,foo\nconn,1\nconn,3\n", conn.String())
+	require.Equal(t, "_path,bar\nconn,2\n", conn1.String())
+}
+
 type nopCloser struct{ *bytes.Buffer }
 
 func (nopCloser) Close() error { return nil }
@@ -1,11 +1,13 @@
 package expr
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
 	"net"
 	"regexp"
+	"unicode/utf8"
 
 	"github.com/brimsec/zq/ast"
 	"github.com/brimsec/zq/pkg/nano"
@@ -20,6 +22,7 @@ type ExpressionEvaluator func(*zng.Record) (zng.Value, error)
 var ErrNoSuchField = errors.New("field is not present")
 var ErrIncompatibleTypes = errors.New("incompatible types")
 var ErrIndexOutOfBounds = errors.New("array index out of bounds")
+var ErrNoSuchKey = errors.New("map key is not present")
 var ErrNoSuchFunction = errors.New("no such function")
 var ErrNotContainer = errors.New("cannot apply in to a non-container")
 var ErrBadCast = errors.New("bad cast")
@@ -339,12 +342,18 @@ func compare(lhs, rhs zngnative.Value) (bool, error) {
 			return false, ErrIncompatibleTypes
 		}
 
-	case zng.IdString, zng.IdBstring:
-		if rhs.Type.ID() != zng.IdString && rhs.Type.ID() != zng.IdBstring {
+	case zng.IdString, zng.IdBstring, zng.IdEnum:
+		if !zng.IsStringy(rhs.Type.ID()) {
 			return false, ErrIncompatibleTypes
 		}
 		return lhs.Value.(string) == rhs.Value.(string), nil
 
+	case zng.IdBytes:
+		if rhs.Type.ID() != zng.IdBytes {
+			return false, ErrIncompatibleTypes
+		}
+		return bytes.Equal(lhs.Value.([]byte), rhs.Value.([]byte)), nil
+
 	case zng.IdIP:
 		if rhs.Type.ID() != zng.IdIP {
 			return false, ErrIncompatibleTypes
@@ -404,7 +413,7 @@ func compilePatternMatch(lhsFunc, rhsFunc NativeEvaluator, op string) (NativeEva
 		var result bool
 		switch rhs.Type.ID() {
 		case zng.IdString, zng.IdBstring:
-			if lhs.Type.ID() != zng.IdString && lhs.Type.ID() != zng.IdBstring {
+			if !zng.IsStringy(lhs.Type.ID()) {
 				return zngnative.Value{}, ErrIncompatibleTypes
 			}
 			pattern := reglob.Reglob(rhs.Value.(string))
@@ -562,8 +571,8 @@ func compileCompareRelative(lhsFunc, rhsFunc NativeEvaluator, operator string) (
 				result = 1
 			}
 
-		case zng.IdString, zng.IdBstring:
-			if rhs.Type.ID() != zng.IdString && rhs.Type.ID() != zng.IdBstring {
+		case zng.IdString, zng.IdBstring, zng.IdEnum:
+			if !zng.IsStringy(rhs.Type.ID()) {
 				return zngnative.Value{}, ErrIncompatibleTypes
 			}
 			lv := lhs.Value.(string)
@@ -575,6 +584,12 @@ func compileCompareRelative(lhsFunc, rhsFunc NativeEvaluator, operator string) (
 			} else {
 				result = 1
 			}
+
+		case zng.IdBytes:
+			if rhs.Type.ID() != zng.IdBytes {
+				return zngnative.Value{}, ErrIncompatibleTypes
+			}
+			result = bytes.Compare(lhs.Value.([]byte), rhs.Value.([]byte))
 		default:
 			return zngnative.Value{}, ErrIncompatibleTypes
 		}
@@ -805,16 +820,19 @@ func compileArrayIndex(lhsFunc, rhsFunc NativeEvaluator, operator string) (Nativ
 			return zngnative.Value{}, err
 		}
 
-		aType, ok := lhs.Type.(*zng.TypeArray)
-		if !ok {
-			return zngnative.Value{}, ErrIncompatibleTypes
-		}
-
 		rhs, err := rhsFunc(rec)
 		if err != nil {
 			return zngnative.Value{}, err
 		}
 
+		if mType, ok := lhs.Type.(*zng.TypeMap); ok {
+			return indexMap(mType, lhs.Value.(zcode.Bytes), rhs)
+		}
+		aType, ok := lhs.Type.(*zng.TypeArray)
+		if !ok {
+			return zngnative.Value{}, ErrIncompatibleTypes
+		}
+
 		var idx uint
 		switch rhs.Type.ID() {
 		case zng.IdByte, zng.IdUint16, zng.IdUint32, zng.IdUint64:
@@ -837,6 +855,30 @@ func compileArrayIndex(lhsFunc, rhsFunc NativeEvaluator, operator string) (Nativ
 	}, nil
 }
 
+// indexMap returns the value bound to key in the map body zv.  A string
+// key may be used to index a map whose keys are of any string type.
+func indexMap(typ *zng.TypeMap, zv zcode.Bytes, key zngnative.Value) (zngnative.Value, error) {
+	keyType := zng.AliasedType(typ.KeyType)
+	if key.Type.ID() != keyType.ID() {
+		if !zng.IsStringy(key.Type.ID()) || !zng.IsStringy(keyType.ID()) {
+			return zngnative.Value{}, ErrIncompatibleTypes
+		}
+		key.Type = keyType
+	}
+	k, err := key.ToZngValue()
+	if err != nil {
+		return zngnative.Value{}, err
+	}
+	v, ok, err := typ.Lookup(zv, k.Bytes)
+	if err != nil {
+		return zngnative.Value{}, err
+	}
+	if !ok {
+		return zngnative.Value{}, ErrNoSuchKey
+	}
+	return zngnative.ToNativeValue(zng.Value{typ.ValType, v})
+}
+
 func compileConditional(node ast.ConditionalExpression) (NativeEvaluator, error) {
 	conditionFunc, err := compileNative(node.Condition)
 	if err != nil {
@@ -1048,7 +1090,7 @@ func compileCast(node ast.CastExpression) (NativeEvaluator, error) {
 			if err != nil {
 				return zngnative.Value{}, err
 			}
-			if val.Type.ID() != zng.IdString && val.Type.ID() != zng.IdBstring {
+			if !zng.IsStringy(val.Type.ID()) {
 				return zngnative.Value{}, ErrBadCast
 			}
 			ip := net.ParseIP(val.Value.(string))
@@ -1072,6 +1114,51 @@ func compileCast(node ast.CastExpression) (NativeEvaluator, error) {
 			}
 			return zngnative.Value{zng.TypeTime, i * 1_000_000_000}, nil
 		}, nil
+	case "string":
+		return func(rec *zng.Record) (zngnative.Value, error) {
+			val, err := fn(rec)
+			if err != nil {
+				return zngnative.Value{}, err
+			}
+			switch id := val.Type.ID(); {
+			case zng.IsStringy(id):
+				return zngnative.Value{zng.TypeString, val.Value.(string)}, nil
+			case id == zng.IdBytes:
+				b := val.Value.([]byte)
+				if !utf8.Valid(b) {
+					return zngnative.Value{}, ErrBadCast
+				}
+				return zngnative.Value{zng.TypeString, string(b)}, nil
+			default:
+				return zngnative.Value{}, ErrBadCast
+			}
+		}, nil
+	case "bytes":
+		return func(rec *zng.Record) (zngnative.Value, error) {
+			val, err := fn(rec)
+			if err != nil {
+				return zngnative.Value{}, err
+			}
+			switch id := val.Type.ID(); {
+			case zng.IsStringy(id):
+				return zngnative.Value{zng.TypeBytes, []byte(val.Value.(string))}, nil
+			case id == zng.IdBytes:
+				return val, nil
+			default:
+				return zngnative.Value{}, ErrBadCast
+			}
+		}, nil
+	case "enum":
+		return func(rec *zng.Record) (zngnative.Value, error) {
+			val, err := fn(rec)
+			if err != nil {
+				return zngnative.Value{}, err
+			}
+			if !zng.IsStringy(val.Type.ID()) {
+				return zngnative.Value{}, ErrBadCast
+			}
+			return zngnative.Value{zng.TypeEnum, val.Value.(string)}, nil
+		}, nil
 	default:
 		return nil, fmt.Errorf("cast to %s not implemeneted", node.Type)
 	}
@@ -20,7 +20,7 @@ import (
 // bounds, etc.), the resolver returns (nil, nil)
 type FieldExprResolver func(*zng.Record) zng.Value
 
-// fieldop, arrayIndex, and fieldRead are helpers used internally
+// fieldop, arrayIndex, mapIndex, and fieldRead are helpers used internally
 // by CompileFieldExpr() below.
 type fieldop interface {
 	apply(zng.Value) zng.Value
@@ -42,6 +42,29 @@ func (ai *arrayIndex) apply(e zng.Value) zng.Value {
 	return el
 }
 
+type mapIndex struct {
+	key string
+}
+
+func (mi *mapIndex) apply(e zng.Value) zng.Value {
+	typ, ok := zng.AliasedType(e.Type).(*zng.TypeMap)
+	if !ok || e.Bytes == nil {
+		return zng.Value{}
+	}
+	key, err := zng.AliasedType(typ.KeyType).Parse([]byte(mi.key))
+	if err != nil {
+		return zng.Value{}
+	}
+	v, ok, err := typ.Lookup(e.Bytes, key)
+	if err != nil {
+		return zng.Value{}
+	}
+	if !ok {
+		return zng.Value{typ.ValType, nil}
+	}
+	return zng.Value{typ.ValType, v}
+}
+
 type fieldRead struct {
 	field string
 }
@@ -108,6 +131,9 @@ outer:
 				}
 				ops = append([]fieldop{&arrayIndex{idx}}, ops...)
 				node = op.Field
+			case "MapIndex":
+				ops = append([]fieldop{&mapIndex{op.Param}}, ops...)
+				node = op.Field
 			case "RecordFieldRead":
 				ops = append([]fieldop{&fieldRead{op.Param}}, ops...)
 				node = op.Field
@@ -165,6 +191,8 @@ func FieldExprToString(node ast.FieldExpr) string {
 			return fmt.Sprintf("len(%s)", FieldExprToString(node.Field))
 		case "Index":
 			return fmt.Sprintf("%s[%s]", FieldExprToString(node.Field), node.Param)
+		case "MapIndex":
+			return fmt.Sprintf("%s[%s]", FieldExprToString(node.Field), strconv.Quote(node.Param))
 		case "RecordFieldRead":
 			return fmt.Sprintf("%s.%s", FieldExprToString(node.Field), node.Param)
 		default:
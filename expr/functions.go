@@ -1,15 +1,22 @@
 package expr
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
 	"net"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zngnative"
 )
@@ -38,15 +45,29 @@ var allFns = map[string]struct {
 	"Math.pow":   {2, 2, mathPow},
 	"Math.sqrt":  {1, 1, mathSqrt},
 
+	"Net.community_id": {5, 6, netCommunityId},
+	"Net.contains":     {2, 2, netContains},
+	"Net.isIPv4":       {1, 1, netIsIPv4},
+	"Net.isIPv6":       {1, 1, netIsIPv6},
+	"Net.isMulticast":  {1, 1, netIsMulticast},
+	"Net.isPrivate":    {1, 1, netIsPrivate},
+	"Net.mask":         {2, 2, netMask},
+
 	"String.byteLen":     {1, 1, stringByteLen},
+	"String.contains":    {2, 2, stringContains},
 	"String.formatFloat": {1, 1, stringFormatFloat},
 	"String.formatInt":   {1, 1, stringFormatInt},
 	"String.formatIp":    {1, 1, stringFormatIp},
+	"String.join":        {2, 2, stringJoin},
+	"String.match":       {2, 2, stringMatch},
 	"String.parseFloat":  {1, 1, stringParseFloat},
 	"String.parseInt":    {1, 1, stringParseInt},
 	"String.parseIp":     {1, 1, stringParseIp},
 	"String.replace":     {3, 3, stringReplace},
 	"String.runeLen":     {1, 1, stringRuneLen},
+	"String.split":       {2, 2, stringSplit},
+	"String.startsWith":  {2, 2, stringStartsWith},
+	"String.substr":      {2, 3, stringSubstr},
 	"String.toLower":     {1, 1, stringToLower},
 	"String.toUpper":     {1, 1, stringToUpper},
 	"String.trim":        {1, 1, stringTrim},
@@ -64,7 +85,7 @@ func err(fn string, err error) (zngnative.Value, error) {
 
 func lenFn(args []zngnative.Value) (zngnative.Value, error) {
 	switch zng.AliasedType(args[0].Type).(type) {
-	case *zng.TypeArray, *zng.TypeSet:
+	case *zng.TypeArray, *zng.TypeSet, *zng.TypeMap:
 		v, err := args[0].ToZngValue()
 		if err != nil {
 			return zngnative.Value{}, err
@@ -283,6 +304,204 @@ func mathSqrt(args []zngnative.Value) (zngnative.Value, error) {
 	return zngnative.Value{zng.TypeFloat64, r}, nil
 }
 
+func ipArg(fn string, v zngnative.Value) (net.IP, error) {
+	if v.Type.ID() != zng.IdIP {
+		_, e := err(fn, ErrBadArgument)
+		return nil, e
+	}
+	ip := v.Value.(net.IP)
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, nil
+	}
+	return ip, nil
+}
+
+func netIsIPv4(args []zngnative.Value) (zngnative.Value, error) {
+	ip, e := ipArg("Net.isIPv4", args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	return zngnative.Value{zng.TypeBool, len(ip) == net.IPv4len}, nil
+}
+
+func netIsIPv6(args []zngnative.Value) (zngnative.Value, error) {
+	ip, e := ipArg("Net.isIPv6", args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	return zngnative.Value{zng.TypeBool, len(ip) == net.IPv6len}, nil
+}
+
+func netIsMulticast(args []zngnative.Value) (zngnative.Value, error) {
+	ip, e := ipArg("Net.isMulticast", args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	return zngnative.Value{zng.TypeBool, ip.IsMulticast()}, nil
+}
+
+// privateNets are the IPv4 private address blocks of RFC 1918 and the IPv6
+// unique local address block of RFC 4193.
+var privateNets = []*net.IPNet{
+	{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)},
+	{IP: net.IP{172, 16, 0, 0}, Mask: net.CIDRMask(12, 32)},
+	{IP: net.IP{192, 168, 0, 0}, Mask: net.CIDRMask(16, 32)},
+	{IP: net.IP{0xfc, 15: 0}, Mask: net.CIDRMask(7, 128)},
+}
+
+func netIsPrivate(args []zngnative.Value) (zngnative.Value, error) {
+	ip, e := ipArg("Net.isPrivate", args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return zngnative.Value{zng.TypeBool, true}, nil
+		}
+	}
+	return zngnative.Value{zng.TypeBool, false}, nil
+}
+
+func netContains(args []zngnative.Value) (zngnative.Value, error) {
+	if args[0].Type.ID() != zng.IdNet {
+		return err("Net.contains", ErrBadArgument)
+	}
+	ip, e := ipArg("Net.contains", args[1])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	b := args[0].Value.(*net.IPNet).Contains(ip)
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+// netMask returns the network of the given number of bits containing an
+// address.
+func netMask(args []zngnative.Value) (zngnative.Value, error) {
+	ip, e := ipArg("Net.mask", args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	bits, ok := zngnative.CoerceNativeToUint(args[1])
+	if !ok || bits > uint64(len(ip)*8) {
+		return err("Net.mask", ErrBadArgument)
+	}
+	mask := net.CIDRMask(int(bits), len(ip)*8)
+	n := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	return zngnative.Value{zng.TypeNet, n}, nil
+}
+
+// IP protocol numbers used by Net.community_id.
+const (
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+	protoSCTP   = 132
+)
+
+var protoNumbers = map[string]uint64{
+	"icmp":      protoICMP,
+	"tcp":       protoTCP,
+	"udp":       protoUDP,
+	"icmp6":     protoICMPv6,
+	"ipv6-icmp": protoICMPv6,
+	"sctp":      protoSCTP,
+}
+
+// icmpCounterparts map the ICMP and ICMPv6 message types of requests and
+// replies to each other so that both directions of an exchange have the
+// same Community ID.
+var icmpCounterparts = map[uint64]uint64{
+	8: 0, 0: 8, 13: 14, 14: 13, 15: 16, 16: 15, 10: 9, 9: 10, 17: 18, 18: 17,
+}
+
+var icmp6Counterparts = map[uint64]uint64{
+	128: 129, 129: 128, 133: 134, 134: 133, 135: 136, 136: 135,
+	130: 131, 131: 130, 144: 145, 145: 144, 139: 140, 140: 139,
+}
+
+func portArg(v zngnative.Value) (uint64, bool) {
+	if v.Type.ID() == zng.IdPort {
+		return v.Value.(uint64), true
+	}
+	p, ok := zngnative.CoerceNativeToUint(v)
+	return p, ok && p <= 0xffff
+}
+
+// netCommunityId computes the version 1 Community ID flow hash, as
+// described at https://github.com/corelight/community-id-spec, of the
+// flow with the given originator address and port, responder address and
+// port, and IP protocol, which is a number or a name like "tcp", and an
+// optional seed.  For ICMP, the ports are the message type and code, as in
+// Zeek's conn log.
+func netCommunityId(args []zngnative.Value) (zngnative.Value, error) {
+	const fn = "Net.community_id"
+	saddr, e := ipArg(fn, args[0])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	sport, ok := portArg(args[1])
+	if !ok {
+		return err(fn, ErrBadArgument)
+	}
+	daddr, e := ipArg(fn, args[2])
+	if e != nil {
+		return zngnative.Value{}, e
+	}
+	dport, ok := portArg(args[3])
+	if !ok || len(saddr) != len(daddr) {
+		return err(fn, ErrBadArgument)
+	}
+	var proto uint64
+	if zng.IsStringy(args[4].Type.ID()) {
+		proto, ok = protoNumbers[strings.ToLower(args[4].Value.(string))]
+	} else {
+		proto, ok = zngnative.CoerceNativeToUint(args[4])
+	}
+	if !ok || proto > 0xff {
+		return err(fn, ErrBadArgument)
+	}
+	var seed uint64
+	if len(args) == 6 {
+		seed, ok = zngnative.CoerceNativeToUint(args[5])
+		if !ok || seed > 0xffff {
+			return err(fn, ErrBadArgument)
+		}
+	}
+	oneWay := false
+	switch proto {
+	case protoICMP, protoICMPv6:
+		counterparts := icmpCounterparts
+		if proto == protoICMPv6 {
+			counterparts = icmp6Counterparts
+		}
+		if t, ok := counterparts[sport]; ok {
+			dport = t
+		} else {
+			oneWay = true
+		}
+	}
+	if !oneWay {
+		c := bytes.Compare(saddr, daddr)
+		if c > 0 || c == 0 && sport > dport {
+			saddr, daddr = daddr, saddr
+			sport, dport = dport, sport
+		}
+	}
+	h := sha1.New()
+	binary.Write(h, binary.BigEndian, uint16(seed))
+	h.Write(saddr)
+	h.Write(daddr)
+	h.Write([]byte{byte(proto), 0})
+	switch proto {
+	case protoICMP, protoTCP, protoUDP, protoICMPv6, protoSCTP:
+		binary.Write(h, binary.BigEndian, uint16(sport))
+		binary.Write(h, binary.BigEndian, uint16(dport))
+	}
+	s := "1:" + base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
 func stringByteLen(args []zngnative.Value) (zngnative.Value, error) {
 	switch args[0].Type.ID() {
 	case zng.IdString, zng.IdBstring:
@@ -415,6 +634,147 @@ func stringTrim(args []zngnative.Value) (zngnative.Value, error) {
 	return zngnative.Value{zng.TypeString, s}, nil
 }
 
+func stringContains(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.contains", ErrBadArgument)
+	}
+	b := strings.Contains(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+func stringStartsWith(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.startsWith", ErrBadArgument)
+	}
+	b := strings.HasPrefix(args[0].Value.(string), args[1].Value.(string))
+	return zngnative.Value{zng.TypeBool, b}, nil
+}
+
+// stringSubstr returns the substring of its first argument starting at the
+// rune offset given by the second argument and continuing for the number
+// of runes given by the optional third argument, or to the end of the
+// string if it is absent.  Offsets and lengths past the end of the string
+// are truncated.
+func stringSubstr(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) {
+		return err("String.substr", ErrBadArgument)
+	}
+	start, ok := zngnative.CoerceNativeToInt(args[1])
+	if !ok || start < 0 {
+		return err("String.substr", ErrBadArgument)
+	}
+	runes := []rune(args[0].Value.(string))
+	if start > int64(len(runes)) {
+		start = int64(len(runes))
+	}
+	end := int64(len(runes))
+	if len(args) == 3 {
+		n, ok := zngnative.CoerceNativeToInt(args[2])
+		if !ok || n < 0 {
+			return err("String.substr", ErrBadArgument)
+		}
+		if start+n < end {
+			end = start + n
+		}
+	}
+	return zngnative.Value{zng.TypeString, string(runes[start:end])}, nil
+}
+
+// typeStringArray is the type of the arrays returned by functions like
+// String.split.  Functions do not have access to a type context, so the
+// type is not bound to one and must be localized by consumers of their
+// values.
+var typeStringArray = zng.NewTypeArray(-1, zng.TypeString)
+
+func stringArray(s []string) zngnative.Value {
+	var b zcode.Bytes
+	for _, elem := range s {
+		b = zcode.AppendPrimitive(b, zng.EncodeString(elem))
+	}
+	if b == nil {
+		// An empty array is distinct from an unset one.
+		b = zcode.Bytes{}
+	}
+	return zngnative.Value{typeStringArray, b}
+}
+
+func stringSplit(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.split", ErrBadArgument)
+	}
+	s := strings.Split(args[0].Value.(string), args[1].Value.(string))
+	return stringArray(s), nil
+}
+
+func stringJoin(args []zngnative.Value) (zngnative.Value, error) {
+	typ, ok := zng.AliasedType(args[0].Type).(*zng.TypeArray)
+	if !ok || !isString(args[1]) {
+		return err("String.join", ErrBadArgument)
+	}
+	if id := zng.AliasedType(typ.Type).ID(); id != zng.IdString && id != zng.IdBstring {
+		return err("String.join", ErrBadArgument)
+	}
+	var elems []string
+	for it := zcode.Iter(args[0].Value.(zcode.Bytes)); !it.Done(); {
+		zv, _, e := it.Next()
+		if e != nil {
+			return err("String.join", e)
+		}
+		elems = append(elems, string(zv))
+	}
+	s := strings.Join(elems, args[1].Value.(string))
+	return zngnative.Value{zng.TypeString, s}, nil
+}
+
+// regexpCache holds the compiled regular expressions used by String.match
+// since its pattern argument is nearly always a constant.
+var regexpCache struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}
+
+const maxCachedRegexps = 100
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	regexpCache.Lock()
+	defer regexpCache.Unlock()
+	if re, ok := regexpCache.m[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if regexpCache.m == nil || len(regexpCache.m) >= maxCachedRegexps {
+		regexpCache.m = make(map[string]*regexp.Regexp)
+	}
+	regexpCache.m[pattern] = re
+	return re, nil
+}
+
+// stringMatch matches the regular expression given by its second argument
+// against its first argument and returns an array of the text of the
+// capture groups of the leftmost match or, if the expression has no
+// capture groups, an array holding the text of the match.  If there is no
+// match, the array is unset.
+func stringMatch(args []zngnative.Value) (zngnative.Value, error) {
+	if !isString(args[0]) || !isString(args[1]) {
+		return err("String.match", ErrBadArgument)
+	}
+	re, e := compileRegexp(args[1].Value.(string))
+	if e != nil {
+		return err("String.match", ErrBadArgument)
+	}
+	match := re.FindStringSubmatch(args[0].Value.(string))
+	if match == nil {
+		return zngnative.Value{typeStringArray, zcode.Bytes(nil)}, nil
+	}
+	if len(match) > 1 {
+		match = match[1:]
+	}
+	return stringArray(match), nil
+}
+
 func timeFromISO(args []zngnative.Value) (zngnative.Value, error) {
 	if !isString(args[0]) {
 		return err("Time.fromISO", ErrBadArgument)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
 	"github.com/brimsec/zq/zng"
 	"github.com/stretchr/testify/require"
 )
@@ -196,6 +197,142 @@ func TestOtherStrFuncs(t *testing.T) {
 	testError(t, `String.trim("  hi  ", "  there  ")`, nil, expr.ErrTooManyArgs, "trim() with too many args")
 }
 
+func zstrings(s ...string) zng.Value {
+	var b zcode.Bytes
+	for _, elem := range s {
+		b = zcode.AppendPrimitive(b, zng.EncodeString(elem))
+	}
+	if b == nil {
+		b = zcode.Bytes{}
+	}
+	return zng.Value{zng.NewTypeArray(-1, zng.TypeString), b}
+}
+
+func TestStringSearchFuncs(t *testing.T) {
+	testSuccessful(t, `String.contains("www.example.com", "example")`, nil, zbool(true))
+	testSuccessful(t, `String.contains("www.example.com", "brim")`, nil, zbool(false))
+	testError(t, `String.contains("foo")`, nil, expr.ErrTooFewArgs, "contains() with too few args")
+	testError(t, `String.contains("foo", 1)`, nil, expr.ErrBadArgument, "contains() with non-string arg")
+
+	testSuccessful(t, `String.startsWith("/index.html", "/")`, nil, zbool(true))
+	testSuccessful(t, `String.startsWith("index.html", "/")`, nil, zbool(false))
+	testError(t, `String.startsWith("foo", "f", "o")`, nil, expr.ErrTooManyArgs, "startsWith() with too many args")
+
+	testSuccessful(t, `String.substr("hello", 1)`, nil, zstring("ello"))
+	testSuccessful(t, `String.substr("hello", 1, 3)`, nil, zstring("ell"))
+	testSuccessful(t, `String.substr("hello", 3, 10)`, nil, zstring("lo"))
+	testSuccessful(t, `String.substr("hello", 10)`, nil, zstring(""))
+	testSuccessful(t, `String.substr("日本語", 1, 1)`, nil, zstring("本"))
+	testError(t, `String.substr("hello")`, nil, expr.ErrTooFewArgs, "substr() with too few args")
+	testError(t, `String.substr("hello", -1)`, nil, expr.ErrBadArgument, "substr() with negative offset")
+	testError(t, `String.substr("hello", 1, -1)`, nil, expr.ErrBadArgument, "substr() with negative length")
+	testError(t, `String.substr(1, 1)`, nil, expr.ErrBadArgument, "substr() with non-string arg")
+}
+
+func TestStringSplitJoin(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[a:array[string],b:array[bstring],i:array[int32]]
+0:[[a;b;][c;d;][1;2;]]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, `String.split("a.b.c", ".")`, nil, zstrings("a", "b", "c"))
+	testSuccessful(t, `String.split("abc", ".")`, nil, zstrings("abc"))
+	testSuccessful(t, `len(String.split("a.b.c", "."))`, nil, zint64(3))
+	testError(t, `String.split("a.b.c")`, nil, expr.ErrTooFewArgs, "split() with too few args")
+	testError(t, `String.split("a.b.c", 1)`, nil, expr.ErrBadArgument, "split() with non-string arg")
+
+	testSuccessful(t, `String.join(a, ".")`, record, zstring("a.b"))
+	testSuccessful(t, `String.join(b, "")`, record, zstring("cd"))
+	testSuccessful(t, `String.join(String.split("a.b.c", "."), "/")`, nil, zstring("a/b/c"))
+	testError(t, `String.join(i, ".")`, record, expr.ErrBadArgument, "join() with non-string array")
+	testError(t, `String.join("a", ".")`, record, expr.ErrBadArgument, "join() with non-array arg")
+}
+
+func TestStringMatch(t *testing.T) {
+	testSuccessful(t, `String.match("http://example.com:8080/index.html", "^(\\w+)://([^/:]+)(:\\d+)?")`, nil, zstrings("http", "example.com", ":8080"))
+	testSuccessful(t, `String.match("Mozilla/5.0 (X11)", "\\d+\\.\\d+")`, nil, zstrings("5.0"))
+	testSuccessful(t, `String.match("abc", "(x)?c")`, nil, zstrings(""))
+	testSuccessful(t, `String.match("abc", "x")`, nil, zng.Value{zng.NewTypeArray(-1, zng.TypeString), nil})
+	testError(t, `String.match("abc", "(")`, nil, expr.ErrBadArgument, "match() with bad regexp")
+	testError(t, `String.match("abc")`, nil, expr.ErrTooFewArgs, "match() with too few args")
+}
+
+// znet returns the net value for the CIDR s.  The value is built by hand
+// rather than with zng.EncodeNet so that the tests check the encoding.
+func znet(t *testing.T, s string) zng.Value {
+	_, n, err := net.ParseCIDR(s)
+	require.NoError(t, err)
+	ip := n.IP.To4()
+	if ip == nil || len(n.Mask) != net.IPv4len {
+		ip = n.IP.To16()
+	}
+	b := append(append(zcode.Bytes{}, ip...), n.Mask...)
+	return zng.Value{zng.TypeNet, b}
+}
+
+func TestNetFuncs(t *testing.T) {
+	record, err := parseOneRecord(`
+#0:record[n:net,a:ip,a6:ip]
+0:[10.1.0.0/16;10.1.2.3;fe80::1;]`)
+	require.NoError(t, err)
+
+	testSuccessful(t, "Net.mask(a, 24)", record, znet(t, "10.1.2.0/24"))
+	testSuccessful(t, "Net.mask(a6, 64)", record, znet(t, "fe80::/64"))
+	testSuccessful(t, "Net.mask(a, 0)", record, znet(t, "0.0.0.0/0"))
+	testError(t, "Net.mask(a, 33)", record, expr.ErrBadArgument, "mask() with too many bits")
+	testError(t, "Net.mask(n, 8)", record, expr.ErrBadArgument, "mask() with non-ip arg")
+	testError(t, "Net.mask(a)", record, expr.ErrTooFewArgs, "mask() with too few args")
+
+	testSuccessful(t, "Net.contains(n, a)", record, zbool(true))
+	testSuccessful(t, "Net.contains(n, 10.2.0.1)", record, zbool(false))
+	testSuccessful(t, "Net.contains(n, a6)", record, zbool(false))
+	testError(t, "Net.contains(a, a)", record, expr.ErrBadArgument, "contains() with non-net arg")
+
+	testSuccessful(t, "Net.isIPv4(a)", record, zbool(true))
+	testSuccessful(t, "Net.isIPv4(a6)", record, zbool(false))
+	testSuccessful(t, "Net.isIPv4(::ffff:1.2.3.4)", record, zbool(true))
+	testSuccessful(t, "Net.isIPv6(a6)", record, zbool(true))
+	testSuccessful(t, "Net.isIPv6(a)", record, zbool(false))
+
+	testSuccessful(t, "Net.isPrivate(a)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(172.31.255.255)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(172.32.0.1)", record, zbool(false))
+	testSuccessful(t, "Net.isPrivate(192.168.0.1)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(fd00::1)", record, zbool(true))
+	testSuccessful(t, "Net.isPrivate(8.8.8.8)", record, zbool(false))
+	testError(t, `Net.isPrivate("10.0.0.1")`, record, expr.ErrBadArgument, "isPrivate() with non-ip arg")
+
+	testSuccessful(t, "Net.isMulticast(224.0.0.251)", record, zbool(true))
+	testSuccessful(t, "Net.isMulticast(ff02::fb)", record, zbool(true))
+	testSuccessful(t, "Net.isMulticast(a)", record, zbool(false))
+
+}
+
+func TestCommunityId(t *testing.T) {
+	record, err := parseOneRecord(`
+#zenum=string
+#0:record[orig_h:ip,orig_p:port,resp_h:ip,resp_p:port,proto:zenum]
+0:[128.232.110.120;34855;66.35.250.204;80;tcp;]`)
+	require.NoError(t, err)
+
+	// These are the baseline results of the Community ID specification.
+	tcp := zstring("1:LQU9qZlK+B5F3KDmev6m5PMibrg=")
+	testSuccessful(t, "Net.community_id(orig_h, orig_p, resp_h, resp_p, proto)", record, tcp)
+	testSuccessful(t, "Net.community_id(resp_h, resp_p, orig_h, orig_p, proto)", record, tcp)
+	testSuccessful(t, "Net.community_id(orig_h, orig_p, resp_h, resp_p, 6)", record, tcp)
+	testSuccessful(t, "Net.community_id(orig_h, orig_p, resp_h, resp_p, proto, 1)", record, zstring("1:3V71V58M3Ksw/yuFALMcW0LAHvc="))
+	testSuccessful(t, `Net.community_id(192.168.1.52, 54585, 8.8.8.8, 53, "udp")`, nil, zstring("1:d/FP5EW3wiY1vCndhwleRRKHowQ="))
+	testSuccessful(t, `Net.community_id(192.168.0.89, 8, 192.168.0.1, 0, "icmp")`, nil, zstring("1:X0snYXpgwiv9TZtqg64sgzUn6Dk="))
+	testSuccessful(t, `Net.community_id(192.168.0.1, 0, 192.168.0.89, 0, "icmp")`, nil, zstring("1:X0snYXpgwiv9TZtqg64sgzUn6Dk="))
+	// An ICMPv6 node information query (139) and its response (140).
+	icmp6 := zstring("1:oPVhmQYL42iV9FGiMqdb38yGSeA=")
+	testSuccessful(t, `Net.community_id(fe80::1, 139, fe80::2, 0, "icmp6")`, nil, icmp6)
+	testSuccessful(t, `Net.community_id(fe80::2, 140, fe80::1, 0, "icmp6")`, nil, icmp6)
+	testError(t, `Net.community_id(orig_h, orig_p, resp_h, resp_p, "foo")`, record, expr.ErrBadArgument, "community_id() with unknown protocol")
+	testError(t, `Net.community_id(orig_h, orig_p, fe80::1, resp_p, proto)`, record, expr.ErrBadArgument, "community_id() with mixed address families")
+	testError(t, `Net.community_id(orig_h, orig_p, resp_h, resp_p)`, record, expr.ErrTooFewArgs, "community_id() with too few args")
+}
+
 func TestLen(t *testing.T) {
 	record, err := parseOneRecord(`
 #0:record[s:set[int32],a:array[int32]]
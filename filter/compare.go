@@ -273,8 +273,9 @@ func CompareBstring(op string, pattern zng.Bstring) (Predicate, error) {
 	}
 	s := string(pattern)
 	return func(v zng.Value) bool {
+		// A bytes value is compared with the raw bytes of the pattern.
 		switch v.Type.ID() {
-		case zng.IdBstring, zng.IdString:
+		case zng.IdBstring, zng.IdString, zng.IdEnum, zng.IdBytes:
 			return compare(byteconv.UnsafeString(v.Bytes), s)
 		}
 		return false
@@ -298,7 +299,7 @@ func compareRegexp(op, pattern string) (Predicate, error) {
 	case "=~":
 		return func(v zng.Value) bool {
 			switch v.Type.ID() {
-			case zng.IdString, zng.IdBstring:
+			case zng.IdString, zng.IdBstring, zng.IdEnum:
 				return re.Match(v.Bytes)
 			}
 			return false
@@ -306,7 +307,7 @@ func compareRegexp(op, pattern string) (Predicate, error) {
 	case "!~":
 		return func(v zng.Value) bool {
 			switch v.Type.ID() {
-			case zng.IdString, zng.IdBstring:
+			case zng.IdString, zng.IdBstring, zng.IdEnum:
 				return !re.Match(v.Bytes)
 			}
 			return false
@@ -427,21 +428,27 @@ func CompareSubnet(op string, pattern *net.IPNet) (Predicate, error) {
 // Given a predicate for comparing individual elements, produce a new
 // predicate that implements the "in" comparison.  The new predicate looks
 // at the type of the value being compared, if it is a set or array,
-// the original predicate is applied to each element.  The new precicate
-// returns true iff the predicate matched an element from the collection.
+// the original predicate is applied to each element, and if it is a map,
+// the original predicate is applied to each key and each value.  The new
+// precicate returns true iff the predicate matched an element from the
+// collection.
 func Contains(compare Predicate) Predicate {
 	return func(v zng.Value) bool {
 		var el zng.Value
 		el.Type = zng.InnerType(v.Type)
-		if el.Type == nil {
+		mapType, _ := v.Type.(*zng.TypeMap)
+		if el.Type == nil && mapType == nil {
 			return false
 		}
-		for it := v.Iter(); !it.Done(); {
+		for it, k := v.Iter(), 0; !it.Done(); k++ {
 			var err error
 			el.Bytes, _, err = it.Next()
 			if err != nil {
 				return false
 			}
+			if mapType != nil {
+				el.Type = mapType.ElementType(k)
+			}
 			if compare(el) {
 				return true
 			}
@@ -173,7 +173,7 @@ func searchRecordOther(searchtext string, searchval ast.Literal) (Filter, error)
 	}
 	compare := func(zv zng.Value) bool {
 		switch zv.Type.ID() {
-		case zng.IdBstring, zng.IdString:
+		case zng.IdBstring, zng.IdString, zng.IdEnum:
 			s := byteconv.UnsafeString(zv.Bytes)
 			return stringSearch(s, searchtext)
 		default:
@@ -203,7 +203,7 @@ func searchRecordOther(searchtext string, searchval ast.Literal) (Filter, error)
 func searchRecordString(term string) Filter {
 	search := func(zv zng.Value) bool {
 		switch zv.Type.ID() {
-		case zng.IdBstring, zng.IdString:
+		case zng.IdBstring, zng.IdString, zng.IdEnum:
 			s := byteconv.UnsafeString(zv.Bytes)
 			return stringSearch(s, term)
 		default:
@@ -1,6 +1,7 @@
 package microindex
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -163,6 +164,136 @@ func (f *Finder) LookupClosest(keys *zng.Record) (*zng.Record, error) {
 	return lookup(reader, compare, false)
 }
 
+// seek returns a reader of the base section positioned at or before the
+// first record whose key is greater than or equal to the key of compare.
+// Unlike search, seek never skips a frame that may hold an equal key,
+// even if it follows a frame that ends with that key.
+func (f *Finder) seek(compare expr.KeyCompareFn) (zbuf.Reader, error) {
+	if f.reader == nil {
+		panic("finder hasn't been opened")
+	}
+	n := len(f.trailer.Sections)
+	off := int64(0)
+	for level := 1; level < n && compare != nil; level++ {
+		reader, err := f.newSectionReader(level, off)
+		if err != nil {
+			return nil, err
+		}
+		// Descend into the last frame whose first key is smaller than
+		// the key or into the first frame if there is no such frame.
+		var child *zng.Record
+		for {
+			rec, err := reader.Read()
+			if err != nil {
+				return nil, err
+			}
+			if rec == nil || (child != nil && compare(rec) >= 0) {
+				break
+			}
+			child = rec.Keep()
+			if compare(rec) >= 0 {
+				break
+			}
+		}
+		if child == nil {
+			return f.newSectionReader(0, 0)
+		}
+		off, err = child.AccessInt(f.trailer.ChildOffsetField)
+		if err != nil {
+			return nil, fmt.Errorf("b-tree child field: %w", err)
+		}
+	}
+	return f.newSectionReader(0, off)
+}
+
+// scan reads the base section from a reader returned by seek and sends to
+// hits each record from the first whose key is greater than or equal to
+// the key of compare up to but not including the first record for which
+// done returns true.
+func scan(ctx context.Context, reader zbuf.Reader, hits chan<- *zng.Record, compare expr.KeyCompareFn, done func(*zng.Record) bool) error {
+	for {
+		rec, err := reader.Read()
+		if err != nil || rec == nil {
+			return err
+		}
+		if compare != nil && compare(rec) < 0 {
+			continue
+		}
+		if done(rec) {
+			return nil
+		}
+		select {
+		case hits <- rec.Keep():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// LookupRange sends to hits each record whose key k satisfies lo <= k < hi
+// in key order.  A nil lo or hi leaves the range unbounded below or above.
+// As with LookupAll, unset key columns at the end of lo or hi are "don't
+// cares", so hi excludes all the records that match it.
+func (f *Finder) LookupRange(ctx context.Context, hits chan<- *zng.Record, lo, hi *zng.Record) error {
+	if f.IsEmpty() {
+		return nil
+	}
+	var locmp, hicmp expr.KeyCompareFn
+	var err error
+	if lo != nil {
+		if locmp, err = expr.NewKeyCompareFn(lo); err != nil {
+			return err
+		}
+	}
+	if hi != nil {
+		if hicmp, err = expr.NewKeyCompareFn(hi); err != nil {
+			return err
+		}
+	}
+	reader, err := f.seek(locmp)
+	if err != nil {
+		return err
+	}
+	return scan(ctx, reader, hits, locmp, func(rec *zng.Record) bool {
+		return hicmp != nil && hicmp(rec) >= 0
+	})
+}
+
+// LookupPrefix sends to hits each record whose first key, which must be a
+// string, begins with prefix, in key order.
+func (f *Finder) LookupPrefix(ctx context.Context, hits chan<- *zng.Record, prefix string) error {
+	if f.IsEmpty() {
+		return nil
+	}
+	lo, err := f.ParseKeys([]string{prefix})
+	if err != nil {
+		return err
+	}
+	it := lo.FieldIter()
+	name, val, err := it.Next()
+	if err != nil {
+		return err
+	}
+	switch zng.AliasedType(val.Type) {
+	case zng.TypeString, zng.TypeBstring:
+	default:
+		return fmt.Errorf("prefix lookup requires a string key but %s is %s", name, val.Type)
+	}
+	access := expr.CompileFieldAccess(name)
+	compare, err := expr.NewKeyCompareFn(lo)
+	if err != nil {
+		return err
+	}
+	reader, err := f.seek(compare)
+	if err != nil {
+		return err
+	}
+	p := []byte(prefix)
+	return scan(ctx, reader, hits, compare, func(rec *zng.Record) bool {
+		return !bytes.HasPrefix(access(rec).Bytes, p)
+	})
+}
+
 // ParseKeys uses the key template from the microindex trailer to parse
 // a slice of string values which correspnod to the DFS-order
 // of the fields in the key.  The inputs may be smaller than the
@@ -2,6 +2,7 @@ package microindex_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -193,3 +194,119 @@ func BenchmarkRead(b *testing.B) {
 	}
 }
 */
+
+// buildIndex writes the records of zngText to a microindex with a small
+// frame threshold so that the index has several levels.
+func buildIndex(t *testing.T, zngText string, keys []string) *microindex.Finder {
+	dir, err := ioutil.TempDir("", "microindex_test")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "test.zng")
+	writer, err := microindex.NewWriter(resolver.NewContext(), path, keys, 64)
+	require.NoError(t, err)
+	require.NoError(t, zbuf.Copy(writer, newTextReader(zngText)))
+	require.NoError(t, writer.Close())
+	uri, err := iosrc.ParseURI(path)
+	require.NoError(t, err)
+	finder := microindex.NewFinder(resolver.NewContext(), uri)
+	require.NoError(t, finder.Open())
+	t.Cleanup(func() { finder.Close() })
+	_, err = finder.NewSectionReader(2)
+	require.NoError(t, err)
+	return finder
+}
+
+func collect(t *testing.T, lookup func(chan<- *zng.Record) error) []string {
+	hits := make(chan *zng.Record)
+	var err error
+	go func() {
+		err = lookup(hits)
+		close(hits)
+	}()
+	var out []string
+	for rec := range hits {
+		out = append(out, rec.String())
+	}
+	require.NoError(t, err)
+	return out
+}
+
+func TestLookupRange(t *testing.T) {
+	lines := []string{"#0:record[a:int64,b:int64]"}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("0:[%d;%d;]", i/10, i%10))
+	}
+	finder := buildIndex(t, strings.Join(lines, "\n"), []string{"a", "b"})
+	ctx := context.Background()
+	lookup := func(lo, hi []string) []string {
+		var loRec, hiRec *zng.Record
+		var err error
+		if lo != nil {
+			loRec, err = finder.ParseKeys(lo)
+			require.NoError(t, err)
+			// ParseKeys reuses its record, so keep lo.
+			loRec = loRec.Keep()
+		}
+		if hi != nil {
+			hiRec, err = finder.ParseKeys(hi)
+			require.NoError(t, err)
+		}
+		return collect(t, func(hits chan<- *zng.Record) error {
+			return finder.LookupRange(ctx, hits, loRec, hiRec)
+		})
+	}
+
+	// Each value of a spans several frames.
+	out := lookup([]string{"5"}, []string{"7"})
+	require.Len(t, out, 20)
+	assert.Equal(t, "record[5,0]", out[0])
+	assert.Equal(t, "record[6,9]", out[19])
+
+	out = lookup([]string{"42", "7"}, []string{"43", "2"})
+	assert.Equal(t, []string{
+		"record[42,7]",
+		"record[42,8]",
+		"record[42,9]",
+		"record[43,0]",
+		"record[43,1]",
+	}, out)
+
+	assert.Len(t, lookup(nil, []string{"3"}), 30)
+	assert.Len(t, lookup([]string{"97"}, nil), 30)
+	assert.Len(t, lookup(nil, nil), 1000)
+	assert.Len(t, lookup([]string{"1000"}, nil), 0)
+	assert.Len(t, lookup([]string{"7"}, []string{"7"}), 0)
+}
+
+func TestLookupPrefix(t *testing.T) {
+	lines := []string{"#0:record[key:string,value:int64]"}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("0:[host%03d.example.com;%d;]", i, i))
+	}
+	finder := buildIndex(t, strings.Join(lines, "\n"), nil)
+	ctx := context.Background()
+	lookup := func(prefix string) []string {
+		return collect(t, func(hits chan<- *zng.Record) error {
+			return finder.LookupPrefix(ctx, hits, prefix)
+		})
+	}
+
+	out := lookup("host05")
+	require.Len(t, out, 10)
+	assert.Equal(t, "record[host050.example.com,50]", out[0])
+	assert.Equal(t, "record[host059.example.com,59]", out[9])
+	assert.Len(t, lookup("host"), 1000)
+	assert.Len(t, lookup("host999."), 1)
+	assert.Len(t, lookup("hosts"), 0)
+	assert.Len(t, lookup("a"), 0)
+}
+
+func TestLookupPrefixNotString(t *testing.T) {
+	lines := []string{"#0:record[key:int64]"}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("0:[%d;]", i))
+	}
+	finder := buildIndex(t, strings.Join(lines, "\n"), nil)
+	err := finder.LookupPrefix(context.Background(), make(chan *zng.Record), "1")
+	assert.EqualError(t, err, "prefix lookup requires a string key but key is int64")
+}
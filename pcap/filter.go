@@ -0,0 +1,340 @@
+package pcap
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// NewFilterSearch returns a search for the packets in span that match the
+// filter expression expr.  See ParseFilter for the expression syntax.
+func NewFilterSearch(span nano.Span, expr string) (*Search, error) {
+	filter, err := ParseFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Search{
+		span:   span,
+		filter: filter,
+		id:     fmt.Sprintf("%s_filter", span.Ts.StringFloat()),
+	}, nil
+}
+
+// ParseFilter compiles a packet filter expression into a PacketFilter.
+// The syntax is a subset of that of tcpdump(8).  An expression is made of
+// primitives combined with "and" (or "&&"), "or" (or "||"), "not" (or "!"),
+// and parentheses, where a primitive is one of
+//
+//	[src|dst] host ip
+//	[src|dst] net cidr
+//	[src|dst] port number
+//	[src|dst] portrange number-number
+//	ip | ip6 | tcp | udp | icmp | icmp6
+//
+// As in tcpdump, the "host" keyword may be omitted before an address or
+// network, and a protocol may directly precede a port primitive so that
+// "tcp dst port 80" is equivalent to "tcp and dst port 80".
+func ParseFilter(expr string) (PacketFilter, error) {
+	p := &filterParser{toks: tokenizeFilter(expr)}
+	if len(p.toks) == 0 {
+		return nil, fmt.Errorf("packet filter: empty expression")
+	}
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("packet filter: %w", err)
+	}
+	if tok := p.peek(); tok != "" {
+		return nil, fmt.Errorf("packet filter: unexpected %q", tok)
+	}
+	return func(packet gopacket.Packet) bool {
+		return match(newPacketInfo(packet))
+	}, nil
+}
+
+// packetInfo holds the fields of a packet that filters examine.
+type packetInfo struct {
+	src, dst         net.IP
+	ipv6             bool
+	proto            string
+	srcPort, dstPort int
+	hasPorts         bool
+}
+
+func newPacketInfo(packet gopacket.Packet) *packetInfo {
+	var info packetInfo
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		info.src, info.dst = ip.SrcIP, ip.DstIP
+	case *layers.IPv6:
+		info.src, info.dst = ip.SrcIP, ip.DstIP
+		info.ipv6 = true
+	default:
+		return &info
+	}
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		info.proto = "tcp"
+		info.srcPort, info.dstPort = int(l.SrcPort), int(l.DstPort)
+		info.hasPorts = true
+	case *layers.UDP:
+		info.proto = "udp"
+		info.srcPort, info.dstPort = int(l.SrcPort), int(l.DstPort)
+		info.hasPorts = true
+	case *layers.SCTP:
+		info.proto = "sctp"
+		info.srcPort, info.dstPort = int(l.SrcPort), int(l.DstPort)
+		info.hasPorts = true
+	default:
+		if packet.Layer(layers.LayerTypeICMPv4) != nil {
+			info.proto = "icmp"
+		} else if packet.Layer(layers.LayerTypeICMPv6) != nil {
+			info.proto = "icmp6"
+		}
+	}
+	return &info
+}
+
+type matchFn func(*packetInfo) bool
+
+func tokenizeFilter(s string) []string {
+	var toks []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			toks = append(toks, cur.String())
+			cur.Reset()
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			flush()
+		case c == '(' || c == ')':
+			flush()
+			toks = append(toks, string(c))
+		case c == '!' || c == '&' || c == '|':
+			flush()
+			if (c == '&' || c == '|') && i+1 < len(s) && s[i+1] == c {
+				i++
+				toks = append(toks, string([]byte{c, c}))
+			} else {
+				toks = append(toks, string(c))
+			}
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	flush()
+	return toks
+}
+
+type filterParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *filterParser) parseOr() (matchFn, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "or" || tok == "||"; tok = p.peek() {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(info *packetInfo) bool { return l(info) || right(info) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (matchFn, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "and" || tok == "&&"; tok = p.peek() {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(info *packetInfo) bool { return l(info) && right(info) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (matchFn, error) {
+	if tok := p.peek(); tok == "not" || tok == "!" {
+		p.next()
+		m, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(info *packetInfo) bool { return !m(info) }, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (matchFn, error) {
+	tok := p.next()
+	switch tok {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "(":
+		m, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return m, nil
+	case "ip":
+		return func(info *packetInfo) bool { return info.src != nil && !info.ipv6 }, nil
+	case "ip6":
+		return func(info *packetInfo) bool { return info.ipv6 }, nil
+	case "tcp", "udp", "sctp", "icmp", "icmp6":
+		proto := tok
+		m := func(info *packetInfo) bool { return info.proto == proto }
+		// Allow "tcp port 80" as shorthand for "tcp and port 80".
+		switch p.peek() {
+		case "src", "dst", "port", "portrange":
+			qual, err := p.parseQualified(p.next())
+			if err != nil {
+				return nil, err
+			}
+			return func(info *packetInfo) bool { return m(info) && qual(info) }, nil
+		}
+		return m, nil
+	}
+	return p.parseQualified(tok)
+}
+
+// parseQualified parses a primitive beginning with tok that refers to an
+// address or port, optionally preceded by a direction qualifier.
+func (p *filterParser) parseQualified(tok string) (matchFn, error) {
+	dir := ""
+	if tok == "src" || tok == "dst" {
+		dir = tok
+		tok = p.next()
+	}
+	switch tok {
+	case "host":
+		return p.parseHost(dir, p.next())
+	case "net":
+		return p.parseNet(dir, p.next())
+	case "port":
+		port, err := parsePort(p.next())
+		if err != nil {
+			return nil, err
+		}
+		return portMatch(dir, port, port), nil
+	case "portrange":
+		arg := p.next()
+		i := strings.IndexByte(arg, '-')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid port range %q", arg)
+		}
+		lo, err := parsePort(arg[:i])
+		if err != nil {
+			return nil, err
+		}
+		hi, err := parsePort(arg[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		return portMatch(dir, lo, hi), nil
+	}
+	// An address or network with "host" or "net" omitted.
+	if strings.Contains(tok, "/") {
+		return p.parseNet(dir, tok)
+	}
+	if net.ParseIP(tok) != nil {
+		return p.parseHost(dir, tok)
+	}
+	if tok == "" {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q", tok)
+}
+
+func (p *filterParser) parseHost(dir, arg string) (matchFn, error) {
+	ip := net.ParseIP(arg)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid host address %q", arg)
+	}
+	return addrMatch(dir, ip.Equal), nil
+}
+
+func (p *filterParser) parseNet(dir, arg string) (matchFn, error) {
+	_, ipnet, err := net.ParseCIDR(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network %q", arg)
+	}
+	return addrMatch(dir, ipnet.Contains), nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return int(port), nil
+}
+
+func addrMatch(dir string, match func(net.IP) bool) matchFn {
+	return func(info *packetInfo) bool {
+		if info.src == nil {
+			return false
+		}
+		switch dir {
+		case "src":
+			return match(info.src)
+		case "dst":
+			return match(info.dst)
+		}
+		return match(info.src) || match(info.dst)
+	}
+}
+
+func portMatch(dir string, lo, hi int) matchFn {
+	in := func(port int) bool { return port >= lo && port <= hi }
+	return func(info *packetInfo) bool {
+		if !info.hasPorts {
+			return false
+		}
+		switch dir {
+		case "src":
+			return in(info.srcPort)
+		case "dst":
+			return in(info.dstPort)
+		}
+		return in(info.srcPort) || in(info.dstPort)
+	}
+}
@@ -0,0 +1,79 @@
+package pcap_test
+
+import (
+	"testing"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcaptest"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPacket(t *testing.T, src, dst string, transport gopacket.SerializableLayer) gopacket.Packet {
+	data := pcaptest.Packet(t, src, dst, transport, "")
+	return gopacket.NewPacket(data, layers.LinkTypeEthernet, gopacket.Default)
+}
+
+func TestParseFilter(t *testing.T) {
+	tcp := newPacket(t, "10.0.0.1", "192.168.1.20", &layers.TCP{SrcPort: 51000, DstPort: 80})
+	udp := newPacket(t, "192.168.1.5", "8.8.8.8", &layers.UDP{SrcPort: 5353, DstPort: 53})
+	icmp := newPacket(t, "10.0.0.1", "10.0.0.2", &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(8, 0)})
+
+	cases := []struct {
+		expr    string
+		matches []gopacket.Packet
+	}{
+		{"tcp", []gopacket.Packet{tcp}},
+		{"udp or icmp", []gopacket.Packet{udp, icmp}},
+		{"ip", []gopacket.Packet{tcp, udp, icmp}},
+		{"ip6", nil},
+		{"host 10.0.0.1", []gopacket.Packet{tcp, icmp}},
+		{"src host 10.0.0.1", []gopacket.Packet{tcp, icmp}},
+		{"dst host 10.0.0.1", nil},
+		{"10.0.0.2", []gopacket.Packet{icmp}},
+		{"net 192.168.1.0/24", []gopacket.Packet{tcp, udp}},
+		{"dst net 192.168.1.0/24", []gopacket.Packet{tcp}},
+		{"src 192.168.1.0/24", []gopacket.Packet{udp}},
+		{"port 53", []gopacket.Packet{udp}},
+		{"tcp port 53", nil},
+		{"tcp dst port 80", []gopacket.Packet{tcp}},
+		{"portrange 50000-60000", []gopacket.Packet{tcp}},
+		{"not port 80", []gopacket.Packet{udp, icmp}},
+		{"!tcp && !udp", []gopacket.Packet{icmp}},
+		{"net 192.168.1.0/24 and (port 80 || port 53)", []gopacket.Packet{tcp, udp}},
+		{"not (host 10.0.0.1 or host 8.8.8.8)", nil},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			filter, err := pcap.ParseFilter(c.expr)
+			require.NoError(t, err)
+			var matches []gopacket.Packet
+			for _, p := range []gopacket.Packet{tcp, udp, icmp} {
+				if filter(p) {
+					matches = append(matches, p)
+				}
+			}
+			assert.Equal(t, c.matches, matches)
+		})
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"host",
+		"host example.com",
+		"net 10.0.0.0",
+		"port http",
+		"portrange 80",
+		"(tcp",
+		"tcp)",
+		"tcp and",
+		"bogus",
+	} {
+		_, err := pcap.ParseFilter(expr)
+		assert.Error(t, err, "expression %q", expr)
+	}
+}
@@ -0,0 +1,376 @@
+// Package flows summarizes the packets of a pcap into conn-like ZNG records,
+// one per flow, similar to those in a Zeek conn log.  It provides basic
+// visibility into a packet capture when Zeek is not available.
+package flows
+
+import (
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// DefaultTimeout is the default time a flow may be idle before it is
+// considered finished.
+const DefaultTimeout = time.Minute
+
+// tcpCloseDelay is how long a TCP flow lingers after it has been closed
+// by FIN or RST so that trailing packets are counted with it.
+const tcpCloseDelay = 5 * time.Second
+
+// scanInterval is how often, in packet time, the flow table is scanned
+// for finished flows.
+const scanInterval = time.Second
+
+// Reader is a zbuf.Reader of the flows in a pcap.  A flow's record is
+// produced once the flow is finished, i.e., when it has been idle for the
+// timeout, when a TCP connection has been closed, or at the end of the
+// pcap, so records are not strictly ordered by ts.  Each record has the
+// columns
+//
+//	_path          string ("conn")
+//	ts             time of the first packet
+//	id             record of orig_h, orig_p, resp_h, resp_p
+//	proto          string ("tcp", "udp", "icmp", etc.)
+//	duration       time from first to last packet
+//	orig_bytes     transport payload bytes sent by the originator
+//	resp_bytes     transport payload bytes sent by the responder
+//	tcp_flags      union of the TCP flags seen, in tcpdump notation
+//	orig_pkts      packets sent by the originator
+//	orig_ip_bytes  IP bytes sent by the originator
+//	resp_pkts      packets sent by the responder
+//	resp_ip_bytes  IP bytes sent by the responder
+//
+// For ICMP, orig_p and resp_p hold the type and code of the flow's first
+// message, as in Zeek.  Packets that are not IP are ignored.
+type Reader struct {
+	reader   pcapio.Reader
+	timeout  nano.Ts
+	builder  *zng.Builder
+	table    map[flowKey]*flow
+	out      []*flow
+	lastScan nano.Ts
+	eof      bool
+}
+
+// NewReader returns a Reader of the flows in r.  A flow that is idle for
+// longer than timeout is finished and a later packet with the same
+// addresses begins a new flow.  If timeout is zero, DefaultTimeout is used.
+func NewReader(zctx *resolver.Context, r pcapio.Reader, timeout time.Duration) *Reader {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	id := zctx.MustLookupTypeRecord([]zng.Column{
+		zng.NewColumn("orig_h", zng.TypeIP),
+		zng.NewColumn("orig_p", zng.TypePort),
+		zng.NewColumn("resp_h", zng.TypeIP),
+		zng.NewColumn("resp_p", zng.TypePort),
+	})
+	typ := zctx.MustLookupTypeRecord([]zng.Column{
+		zng.NewColumn("_path", zng.TypeString),
+		zng.NewColumn("ts", zng.TypeTime),
+		zng.NewColumn("id", id),
+		zng.NewColumn("proto", zng.TypeString),
+		zng.NewColumn("duration", zng.TypeDuration),
+		zng.NewColumn("orig_bytes", zng.TypeUint64),
+		zng.NewColumn("resp_bytes", zng.TypeUint64),
+		zng.NewColumn("tcp_flags", zng.TypeString),
+		zng.NewColumn("orig_pkts", zng.TypeUint64),
+		zng.NewColumn("orig_ip_bytes", zng.TypeUint64),
+		zng.NewColumn("resp_pkts", zng.TypeUint64),
+		zng.NewColumn("resp_ip_bytes", zng.TypeUint64),
+	})
+	return &Reader{
+		reader:  r,
+		timeout: nano.Ts(timeout),
+		builder: zng.NewBuilder(typ),
+		table:   make(map[flowKey]*flow),
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	for len(r.out) == 0 {
+		if r.eof {
+			return nil, nil
+		}
+		if err := r.readPacket(); err != nil {
+			return nil, err
+		}
+	}
+	f := r.out[0]
+	r.out = r.out[1:]
+	return r.build(f).Keep(), nil
+}
+
+func (r *Reader) readPacket() error {
+	block, typ, err := r.reader.Read()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if block == nil || err == io.EOF {
+		r.eof = true
+		r.finish(func(*flow) bool { return true })
+		return nil
+	}
+	if typ != pcapio.TypePacket {
+		return nil
+	}
+	pkt, ts, linkType, err := r.reader.Packet(block)
+	if pkt == nil {
+		return err
+	}
+	opts := gopacket.DecodeOptions{Lazy: true, NoCopy: true}
+	r.add(gopacket.NewPacket(pkt, linkType, opts), ts)
+	if ts >= r.lastScan+nano.Ts(scanInterval) {
+		r.lastScan = ts
+		r.finish(func(f *flow) bool { return r.expired(f, ts) })
+	}
+	return nil
+}
+
+// expired returns true if f is finished as of time ts.
+func (r *Reader) expired(f *flow, ts nano.Ts) bool {
+	if f.closed {
+		return ts > f.last+nano.Ts(tcpCloseDelay)
+	}
+	return ts > f.last+r.timeout
+}
+
+// finish moves the flows for which done returns true from the flow table
+// to the output queue, ordered by start time.
+func (r *Reader) finish(done func(*flow) bool) {
+	n := len(r.out)
+	for k, f := range r.table {
+		if done(f) {
+			r.out = append(r.out, f)
+			delete(r.table, k)
+		}
+	}
+	finished := r.out[n:]
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].first < finished[j].first
+	})
+}
+
+// flowKey identifies a flow independent of the direction of a packet.
+// The endpoint with the lesser address and port is always endpoint a.
+type flowKey struct {
+	proto layers.IPProtocol
+	aIP   [16]byte
+	bIP   [16]byte
+	aPort uint16
+	bPort uint16
+}
+
+type endpoint struct {
+	ip   [16]byte
+	port uint16
+}
+
+func less(a, b endpoint) bool {
+	if c := strings.Compare(string(a.ip[:]), string(b.ip[:])); c != 0 {
+		return c < 0
+	}
+	return a.port < b.port
+}
+
+type flow struct {
+	orig, resp endpoint
+	// icmpType and icmpCode are the type and code of the first ICMP
+	// message, reported as the ports of an ICMP flow.
+	icmpType, icmpCode       uint16
+	proto                    string
+	first, last              nano.Ts
+	origPkts, respPkts       uint64
+	origBytes, respBytes     uint64
+	origIPBytes, respIPBytes uint64
+	flags                    layers.TCP
+	isTCP                    bool
+	origFin, respFin         bool
+	closed                   bool
+}
+
+func (r *Reader) add(packet gopacket.Packet, ts nano.Ts) {
+	var src, dst endpoint
+	var proto layers.IPProtocol
+	var ipBytes int
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		copy(src.ip[:], ip.SrcIP.To16())
+		copy(dst.ip[:], ip.DstIP.To16())
+		proto = ip.Protocol
+		ipBytes = len(ip.Contents) + len(ip.Payload)
+	case *layers.IPv6:
+		copy(src.ip[:], ip.SrcIP.To16())
+		copy(dst.ip[:], ip.DstIP.To16())
+		proto = ip.NextHeader
+		ipBytes = len(ip.Contents) + len(ip.Payload)
+	default:
+		return
+	}
+	var tcp *layers.TCP
+	var name string
+	var payload int
+	var icmpType, icmpCode uint16
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		tcp = l
+		name = "tcp"
+		src.port, dst.port = uint16(l.SrcPort), uint16(l.DstPort)
+		payload = len(l.Payload)
+	case *layers.UDP:
+		name = "udp"
+		src.port, dst.port = uint16(l.SrcPort), uint16(l.DstPort)
+		payload = len(l.Payload)
+	case *layers.SCTP:
+		name = "sctp"
+		src.port, dst.port = uint16(l.SrcPort), uint16(l.DstPort)
+		payload = len(l.Payload)
+	default:
+		if l, ok := packet.Layer(layers.LayerTypeICMPv4).(*layers.ICMPv4); ok {
+			name = "icmp"
+			icmpType, icmpCode = uint16(l.TypeCode.Type()), uint16(l.TypeCode.Code())
+			payload = len(l.Payload)
+		} else if l, ok := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6); ok {
+			name = "icmp"
+			icmpType, icmpCode = uint16(l.TypeCode.Type()), uint16(l.TypeCode.Code())
+			payload = len(l.Payload)
+		} else {
+			name = strings.ToLower(proto.String())
+		}
+	}
+	key := flowKey{proto: proto, aIP: src.ip, aPort: src.port, bIP: dst.ip, bPort: dst.port}
+	if less(dst, src) {
+		key.aIP, key.aPort, key.bIP, key.bPort = dst.ip, dst.port, src.ip, src.port
+	}
+	f := r.table[key]
+	if f != nil && (r.expired(f, ts) || f.closed && tcp != nil && tcp.SYN && !tcp.ACK) {
+		// The flow finished before this packet, which may be a new
+		// connection reusing the addresses of a closed one.
+		r.out = append(r.out, f)
+		f = nil
+	}
+	if f == nil {
+		f = &flow{
+			orig:     src,
+			resp:     dst,
+			icmpType: icmpType,
+			icmpCode: icmpCode,
+			proto:    name,
+			first:    ts,
+			isTCP:    tcp != nil,
+		}
+		if tcp != nil && tcp.SYN && tcp.ACK {
+			// We missed the SYN, so the responder sent this packet.
+			f.orig, f.resp = dst, src
+		}
+		r.table[key] = f
+	}
+	if ts > f.last {
+		f.last = ts
+	}
+	fromOrig := src == f.orig
+	if fromOrig {
+		f.origPkts++
+		f.origBytes += uint64(payload)
+		f.origIPBytes += uint64(ipBytes)
+	} else {
+		f.respPkts++
+		f.respBytes += uint64(payload)
+		f.respIPBytes += uint64(ipBytes)
+	}
+	if tcp != nil {
+		f.addFlags(tcp, fromOrig)
+	}
+}
+
+func (f *flow) addFlags(tcp *layers.TCP, fromOrig bool) {
+	f.flags.SYN = f.flags.SYN || tcp.SYN
+	f.flags.FIN = f.flags.FIN || tcp.FIN
+	f.flags.RST = f.flags.RST || tcp.RST
+	f.flags.PSH = f.flags.PSH || tcp.PSH
+	f.flags.ACK = f.flags.ACK || tcp.ACK
+	f.flags.URG = f.flags.URG || tcp.URG
+	f.flags.ECE = f.flags.ECE || tcp.ECE
+	f.flags.CWR = f.flags.CWR || tcp.CWR
+	if tcp.FIN {
+		if fromOrig {
+			f.origFin = true
+		} else {
+			f.respFin = true
+		}
+	}
+	if tcp.RST || (f.origFin && f.respFin) {
+		f.closed = true
+	}
+}
+
+// tcpFlags formats the flags of a flow in the notation of tcpdump(8).
+func (f *flow) tcpFlags() string {
+	var b strings.Builder
+	for _, flag := range []struct {
+		set bool
+		c   byte
+	}{
+		{f.flags.SYN, 'S'},
+		{f.flags.FIN, 'F'},
+		{f.flags.PSH, 'P'},
+		{f.flags.RST, 'R'},
+		{f.flags.URG, 'U'},
+		{f.flags.ECE, 'E'},
+		{f.flags.CWR, 'W'},
+		{f.flags.ACK, '.'},
+	} {
+		if flag.set {
+			b.WriteByte(flag.c)
+		}
+	}
+	return b.String()
+}
+
+func (r *Reader) build(f *flow) *zng.Record {
+	origPort, respPort := f.orig.port, f.resp.port
+	if f.proto == "icmp" {
+		origPort, respPort = f.icmpType, f.icmpCode
+	}
+	var id zcode.Bytes
+	id = zcode.AppendPrimitive(id, zng.EncodeIP(ipOf(f.orig)))
+	id = zcode.AppendPrimitive(id, zng.EncodePort(uint32(origPort)))
+	id = zcode.AppendPrimitive(id, zng.EncodeIP(ipOf(f.resp)))
+	id = zcode.AppendPrimitive(id, zng.EncodePort(uint32(respPort)))
+	var flags zcode.Bytes
+	if f.isTCP {
+		flags = zng.EncodeString(f.tcpFlags())
+	}
+	return r.builder.Build(
+		zng.EncodeString("conn"),
+		zng.EncodeTime(f.first),
+		id,
+		zng.EncodeString(f.proto),
+		zng.EncodeDuration(int64(f.last-f.first)),
+		zng.EncodeUint(f.origBytes),
+		zng.EncodeUint(f.respBytes),
+		flags,
+		zng.EncodeUint(f.origPkts),
+		zng.EncodeUint(f.origIPBytes),
+		zng.EncodeUint(f.respPkts),
+		zng.EncodeUint(f.respIPBytes),
+	)
+}
+
+func ipOf(e endpoint) net.IP {
+	ip := net.IP(e.ip[:])
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	return ip
+}
@@ -0,0 +1,75 @@
+package flows_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/pcap/flows"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pcap/pcaptest"
+	"github.com/brimsec/zq/pkg/test"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/require"
+)
+
+type packet struct {
+	sec       int64
+	src, dst  string
+	transport gopacket.SerializableLayer
+	payload   string
+}
+
+func writePcap(t *testing.T, packets []packet) []byte {
+	var buf bytes.Buffer
+	w := pcapgo.NewWriter(&buf)
+	require.NoError(t, w.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	for _, p := range packets {
+		pcaptest.WritePacket(t, w, time.Unix(p.sec, 0), pcaptest.Packet(t, p.src, p.dst, p.transport, p.payload))
+	}
+	return buf.Bytes()
+}
+
+func TestFlows(t *testing.T) {
+	const a, b = "10.0.0.1", "10.0.0.2"
+	packets := []packet{
+		{1, a, b, &layers.TCP{SrcPort: 5000, DstPort: 80, SYN: true}, ""},
+		{1, b, a, &layers.TCP{SrcPort: 80, DstPort: 5000, SYN: true, ACK: true}, ""},
+		{2, a, b, &layers.TCP{SrcPort: 5000, DstPort: 80, ACK: true, PSH: true}, "hello"},
+		{2, "10.0.0.3", b, &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(8, 0)}, "ping"},
+		{3, b, a, &layers.TCP{SrcPort: 80, DstPort: 5000, ACK: true, FIN: true}, "bye"},
+		{3, a, b, &layers.TCP{SrcPort: 5000, DstPort: 80, ACK: true, FIN: true}, ""},
+		// A response whose request was not captured.
+		{4, b, a, &layers.TCP{SrcPort: 443, DstPort: 6000, SYN: true, ACK: true}, ""},
+		{5, a, b, &layers.UDP{SrcPort: 53, DstPort: 53}, "query"},
+		// Reuses the closed connection's addresses.
+		{20, a, b, &layers.TCP{SrcPort: 5000, DstPort: 80, SYN: true}, ""},
+		// Idle for longer than the timeout, so starts a new flow.
+		{100, b, a, &layers.UDP{SrcPort: 53, DstPort: 53}, "again"},
+	}
+	// Records appear in the order that flows finish: the first TCP
+	// connection when its addresses are reused, the first UDP flow when
+	// it is idle past the timeout, and the rest at the end of the pcap.
+	pcap := writePcap(t, packets)
+	r, err := pcapio.NewReader(bytes.NewReader(pcap))
+	require.NoError(t, err)
+	var out strings.Builder
+	err = zbuf.Copy(tzngio.NewWriter(&out), flows.NewReader(resolver.NewContext(), r, 30*time.Second))
+	require.NoError(t, err)
+	expected := `
+#0:record[_path:string,ts:time,id:record[orig_h:ip,orig_p:port,resp_h:ip,resp_p:port],proto:string,duration:duration,orig_bytes:uint64,resp_bytes:uint64,tcp_flags:string,orig_pkts:uint64,orig_ip_bytes:uint64,resp_pkts:uint64,resp_ip_bytes:uint64]
+0:[conn;1;[10.0.0.1;5000;10.0.0.2;80;]tcp;2;5;3;SFP.;3;125;2;83;]
+0:[conn;5;[10.0.0.1;53;10.0.0.2;53;]udp;0;5;0;-;1;33;0;0;]
+0:[conn;2;[10.0.0.3;8;10.0.0.2;0;]icmp;0;4;0;-;1;32;0;0;]
+0:[conn;4;[10.0.0.1;6000;10.0.0.2;443;]tcp;0;0;0;S.;0;0;1;40;]
+0:[conn;20;[10.0.0.1;5000;10.0.0.2;80;]tcp;0;0;0;S;1;40;0;0;]
+0:[conn;100;[10.0.0.2;53;10.0.0.1;53;]udp;0;5;0;-;1;33;0;0;]
+`
+	require.Equal(t, test.Trim(expected), out.String())
+}
@@ -0,0 +1,162 @@
+package pcap
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+)
+
+// MergeReader is an io.Reader that produces a single pcap-ng stream of the
+// packets matching a search over several pcaps, ordered by time.  Each
+// distinct link type among the packets is written as a pcap-ng interface.
+type MergeReader struct {
+	*Search
+	ctx     context.Context
+	sources []*mergeSource
+	buf     bytes.Buffer
+	w       *pcapgo.NgWriter
+	intfs   map[layers.LinkType]int
+}
+
+type mergeSource struct {
+	reader   pcapio.Reader
+	pkt      []byte
+	length   int
+	ts       nano.Ts
+	linkType layers.LinkType
+	done     bool
+}
+
+// MergeReader returns a reader of the packets matching s from each of the
+// given readers.  ErrNoPcapsFound is returned if no packets match.
+func (s *Search) MergeReader(ctx context.Context, readers []pcapio.Reader) (*MergeReader, error) {
+	m := &MergeReader{
+		Search: s,
+		ctx:    ctx,
+		intfs:  make(map[layers.LinkType]int),
+	}
+	for _, r := range readers {
+		src := &mergeSource{reader: r}
+		if err := m.advance(src); err != nil {
+			return nil, err
+		}
+		if !src.done {
+			m.sources = append(m.sources, src)
+		}
+	}
+	if len(m.sources) == 0 {
+		return nil, ErrNoPcapsFound
+	}
+	return m, nil
+}
+
+func (m *MergeReader) Read(p []byte) (int, error) {
+	for m.buf.Len() == 0 {
+		src := m.next()
+		if src == nil {
+			return 0, io.EOF
+		}
+		if err := m.write(src); err != nil {
+			return 0, err
+		}
+		if err := m.advance(src); err != nil {
+			return 0, err
+		}
+	}
+	return m.buf.Read(p)
+}
+
+// next returns the source holding the earliest pending packet or nil
+// if all sources are exhausted.
+func (m *MergeReader) next() *mergeSource {
+	var min *mergeSource
+	for _, src := range m.sources {
+		if src.done {
+			continue
+		}
+		if min == nil || src.ts < min.ts {
+			min = src
+		}
+	}
+	return min
+}
+
+func (m *MergeReader) write(src *mergeSource) error {
+	var err error
+	if m.w == nil {
+		m.w, err = pcapgo.NewNgWriter(&m.buf, src.linkType)
+		if err != nil {
+			return err
+		}
+		m.intfs[src.linkType] = 0
+	}
+	id, ok := m.intfs[src.linkType]
+	if !ok {
+		intf := pcapgo.DefaultNgInterface
+		intf.LinkType = src.linkType
+		id, err = m.w.AddInterface(intf)
+		if err != nil {
+			return err
+		}
+		m.intfs[src.linkType] = id
+	}
+	ci := gopacket.CaptureInfo{
+		Timestamp:      src.ts.Time(),
+		CaptureLength:  len(src.pkt),
+		Length:         src.length,
+		InterfaceIndex: id,
+	}
+	if err := m.w.WritePacket(ci, src.pkt); err != nil {
+		return err
+	}
+	return m.w.Flush()
+}
+
+// advance reads the next packet from src that matches the search, marking
+// src done when its reader is exhausted.
+func (m *MergeReader) advance(src *mergeSource) error {
+	opts := gopacket.DecodeOptions{Lazy: true, NoCopy: true}
+	for {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		block, typ, err := src.reader.Read()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if block == nil || err == io.EOF {
+			src.done = true
+			return nil
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		pktBuf, ts, linkType, err := src.reader.Packet(block)
+		if pktBuf == nil {
+			return err
+		}
+		if !m.span.ContainsClosed(ts) {
+			continue
+		}
+		if m.filter != nil && !m.filter(gopacket.NewPacket(pktBuf, linkType, opts)) {
+			continue
+		}
+		// The reader may reuse its buffer, so keep a copy.
+		src.pkt = append(src.pkt[:0], pktBuf...)
+		src.ts = ts
+		src.linkType = linkType
+		// Some pcaps record a capture length exceeding the original
+		// length, which the pcap-ng writer rejects.
+		src.length = src.reader.OrigLength(block)
+		if src.length < len(src.pkt) {
+			src.length = len(src.pkt)
+		}
+		return nil
+	}
+}
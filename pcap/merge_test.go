@@ -0,0 +1,66 @@
+package pcap_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTruncated returns a pcap of a single UDP packet sent at ts and
+// captured with the given snap length, along with the packet's length.
+func writeTruncated(t *testing.T, ts time.Time, snaplen int) ([]byte, int) {
+	udp := &layers.UDP{SrcPort: 5000, DstPort: 53}
+	pkt := newPacket(t, "10.0.0.1", "10.0.0.2", udp)
+	data := pkt.Data()
+	var buf bytes.Buffer
+	w := pcapgo.NewWriter(&buf)
+	require.NoError(t, w.WriteFileHeader(uint32(snaplen), layers.LinkTypeEthernet))
+	ci := gopacket.CaptureInfo{
+		Timestamp:     ts,
+		CaptureLength: snaplen,
+		Length:        len(data),
+	}
+	require.NoError(t, w.WritePacket(ci, data[:snaplen]))
+	return buf.Bytes(), len(data)
+}
+
+func TestMergeReaderLength(t *testing.T) {
+	var readers []pcapio.Reader
+	var length int
+	for i := 0; i < 2; i++ {
+		var b []byte
+		b, length = writeTruncated(t, time.Unix(int64(i), 0), 30)
+		r, err := pcapio.NewReader(bytes.NewReader(b))
+		require.NoError(t, err)
+		readers = append(readers, r)
+	}
+	m, err := pcap.NewRangeSearch(nano.MaxSpan).MergeReader(context.Background(), readers)
+	require.NoError(t, err)
+
+	r, err := pcapgo.NewNgReader(m, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	var n int
+	for {
+		data, ci, err := r.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.Len(t, data, 30)
+		assert.Equal(t, 30, ci.CaptureLength)
+		assert.Equal(t, length, ci.Length)
+		n++
+	}
+	assert.Equal(t, 2, n)
+}
@@ -114,6 +114,12 @@ func (r *NgReader) Packet(block []byte) ([]byte, nano.Ts, layers.LinkType, error
 	return packet, nano.TimeToTs(t), r.ifaces[ifno].LinkType, nil
 }
 
+// OrigLength returns the original length of the packet in an enhanced
+// packet block accepted by Packet.
+func (r *NgReader) OrigLength(block []byte) int {
+	return int(r.getUint32(block[24:28]))
+}
+
 func (r *NgReader) Offset() uint64 {
 	return r.offset
 }
@@ -128,6 +128,12 @@ func (r *PcapReader) readHeader() error {
 	return nil
 }
 
+// OrigLength returns the original length of the packet in a packet block
+// accepted by Packet.
+func (r *PcapReader) OrigLength(block []byte) int {
+	return int(r.byteOrder.Uint32(block[12:16]))
+}
+
 func (r *PcapReader) TsFromHeader(hdr []byte) nano.Ts {
 	ns := int64(r.byteOrder.Uint32(hdr[0:4])) * 1_000_000_000
 	ns += int64(r.byteOrder.Uint32(hdr[4:8]) * r.nanoSecsFactor)
@@ -25,10 +25,13 @@ const (
 // header (TypePacket), a pcap-ng section block (TypeSection), a pcap-ng
 // interface block (TypeInterface), or a pcap-ng packet block (TypePacket).
 // For TypePacket, the capture timestamp and the link-layer type of the packet
-// is indicated in the Info return value.
+// is indicated in the Info return value.  OrigLength returns the length
+// of the packet on the wire, which may exceed the length of the captured
+// packet, from a packet block accepted by Packet.
 type Reader interface {
 	Read() ([]byte, BlockType, error)
 	Packet([]byte) ([]byte, nano.Ts, layers.LinkType, error)
+	OrigLength([]byte) int
 	Offset() uint64
 }
 
@@ -0,0 +1,54 @@
+// Package pcaptest provides helpers for building packets and pcaps in tests.
+package pcaptest
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/require"
+)
+
+// Packet returns an Ethernet frame carrying an IPv4 packet from src to dst
+// with the given transport layer and payload.  The IP protocol is set from
+// the type of transport, which may be a TCP, UDP, or ICMPv4 layer.
+func Packet(t testing.TB, src, dst string, transport gopacket.SerializableLayer, payload string) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 0, 0, 0, 0, 1},
+		DstMAC:       net.HardwareAddr{0, 0, 0, 0, 0, 2},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version: 4,
+		TTL:     64,
+		SrcIP:   net.ParseIP(src),
+		DstIP:   net.ParseIP(dst),
+	}
+	switch l := transport.(type) {
+	case *layers.TCP:
+		ip.Protocol = layers.IPProtocolTCP
+		require.NoError(t, l.SetNetworkLayerForChecksum(ip))
+	case *layers.UDP:
+		ip.Protocol = layers.IPProtocolUDP
+		require.NoError(t, l.SetNetworkLayerForChecksum(ip))
+	case *layers.ICMPv4:
+		ip.Protocol = layers.IPProtocolICMPv4
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, eth, ip, transport, gopacket.Payload(payload)))
+	return buf.Bytes()
+}
+
+// WritePacket writes data to w as a packet captured in full at ts.
+func WritePacket(t testing.TB, w *pcapgo.Writer, ts time.Time, data []byte) {
+	ci := gopacket.CaptureInfo{
+		Timestamp:     ts,
+		CaptureLength: len(data),
+		Length:        len(data),
+	}
+	require.NoError(t, w.WritePacket(ci, data))
+}
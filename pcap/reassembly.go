@@ -0,0 +1,406 @@
+package pcap
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sort"
+
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// MaxPendingBytes is the maximum number of out-of-order bytes buffered for
+// each direction of a TCP connection.  When it is exceeded, the buffered
+// data is delivered as if the missing data had been lost.
+const MaxPendingBytes = 4 * 1024 * 1024
+
+// Chunk is a contiguous run of reassembled TCP payload sent in one
+// direction of a connection.
+type Chunk struct {
+	// Ts is the time of the packet that carried the data.
+	Ts nano.Ts
+	// Flow is the source and destination of the data.
+	Flow Flow
+	// Orig is true if the data was sent by the connection's originator.
+	Orig bool
+	// Offset is the position of the data within its direction's
+	// byte stream.  A gap between the end of one chunk and the offset
+	// of the next in the same direction indicates missing data.
+	Offset uint64
+	Data   []byte
+}
+
+// Reassembler reconstructs the ordered byte streams of the TCP connections
+// in a pcap, discarding retransmitted data and reordering out-of-order
+// segments.  Packets that are not TCP are ignored.  A connection's pending
+// data is delivered and its state dropped once it is closed by a FIN in
+// each direction or by a RST, or once a SYN with a new initial sequence
+// number shows that its addresses and ports have been reused.
+type Reassembler struct {
+	reader pcapio.Reader
+	opts   gopacket.DecodeOptions
+	conns  map[connKey]*conn
+	order  []*conn
+	out    []Chunk
+	eof    bool
+}
+
+func NewReassembler(r pcapio.Reader) *Reassembler {
+	return &Reassembler{
+		reader: r,
+		opts:   gopacket.DecodeOptions{Lazy: true, NoCopy: true},
+		conns:  make(map[connKey]*conn),
+	}
+}
+
+type connKey struct {
+	src, dst string
+	srcPort  uint16
+	dstPort  uint16
+}
+
+type conn struct {
+	key        connKey
+	orig, resp *half
+}
+
+// half is the state of one direction of a connection.
+type half struct {
+	flow    Flow
+	orig    bool
+	started bool
+	// syn is true if the stream began with a SYN, whose sequence
+	// number is isn.
+	syn bool
+	isn uint32
+	fin bool
+	// next is the sequence number of the next byte to deliver and
+	// offset is its position in the stream.
+	next    uint32
+	offset  uint64
+	pending []segment
+	size    int
+}
+
+type segment struct {
+	ts   nano.Ts
+	seq  uint32
+	data []byte
+}
+
+// Next returns the next chunk of reassembled data or nil when the input is
+// exhausted.
+func (r *Reassembler) Next() (*Chunk, error) {
+	for len(r.out) == 0 {
+		if r.eof {
+			return nil, nil
+		}
+		if err := r.readPacket(); err != nil {
+			return nil, err
+		}
+	}
+	c := r.out[0]
+	r.out = r.out[1:]
+	return &c, nil
+}
+
+func (r *Reassembler) readPacket() error {
+	block, typ, err := r.reader.Read()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if block == nil || err == io.EOF {
+		r.eof = true
+		// Deliver what remains, skipping over any missing data.
+		for _, c := range r.order {
+			r.flush(c.orig)
+			r.flush(c.resp)
+		}
+		return nil
+	}
+	if typ != pcapio.TypePacket {
+		return nil
+	}
+	pkt, ts, linkType, err := r.reader.Packet(block)
+	if pkt == nil {
+		return err
+	}
+	packet := gopacket.NewPacket(pkt, linkType, r.opts)
+	src, dst, ok := matchIP(packet)
+	if !ok {
+		return nil
+	}
+	tcp, ok := packet.TransportLayer().(*layers.TCP)
+	if !ok {
+		return nil
+	}
+	if !tcp.SYN && !tcp.FIN && !tcp.RST && len(tcp.Payload) == 0 {
+		return nil
+	}
+	c, h := r.lookup(src, dst, tcp)
+	if tcp.SYN {
+		if h.started && (!h.syn || tcp.Seq != h.isn) {
+			// A new connection with the same addresses and ports.
+			r.close(c)
+			c, h = r.lookup(src, dst, tcp)
+		}
+		if !h.started {
+			h.started = true
+			h.syn = true
+			h.isn = tcp.Seq
+			h.next = tcp.Seq + 1
+		}
+		return nil
+	}
+	if len(tcp.Payload) > 0 {
+		if !h.started {
+			// We missed the SYN, so begin the stream with the first
+			// segment seen.
+			h.started = true
+			h.next = tcp.Seq
+		}
+		r.add(h, segment{ts, tcp.Seq, tcp.Payload})
+	}
+	if tcp.FIN {
+		h.fin = true
+	}
+	if tcp.RST || (c.orig.fin && c.resp.fin) {
+		r.close(c)
+	}
+	return nil
+}
+
+// close delivers the pending data of c and forgets c, so that a later
+// packet with the same addresses and ports begins a new connection.
+func (r *Reassembler) close(c *conn) {
+	r.flush(c.orig)
+	r.flush(c.resp)
+	delete(r.conns, c.key)
+	delete(r.conns, connKey{c.key.dst, c.key.src, c.key.dstPort, c.key.srcPort})
+	for i, o := range r.order {
+		if o == c {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// lookup returns the connection of a packet and the half for its
+// direction, adding the connection if it is new.
+func (r *Reassembler) lookup(src, dst net.IP, tcp *layers.TCP) (*conn, *half) {
+	key := connKey{string(src.To16()), string(dst.To16()), uint16(tcp.SrcPort), uint16(tcp.DstPort)}
+	if c, ok := r.conns[key]; ok {
+		if c.orig.flow.S0.Port == int(tcp.SrcPort) && c.orig.flow.S0.IP.Equal(src) {
+			return c, c.orig
+		}
+		return c, c.resp
+	}
+	// The packet reader may reuse its buffer, so copy the addresses.
+	s0 := Socket{append(net.IP(nil), src...), int(tcp.SrcPort)}
+	s1 := Socket{append(net.IP(nil), dst...), int(tcp.DstPort)}
+	if tcp.SYN && tcp.ACK {
+		// This is a response to a SYN that was not captured.
+		s0, s1 = s1, s0
+	}
+	c := &conn{
+		key:  key,
+		orig: &half{flow: Flow{s0, s1}, orig: true},
+		resp: &half{flow: Flow{s1, s0}},
+	}
+	r.conns[key] = c
+	r.conns[connKey{key.dst, key.src, key.dstPort, key.srcPort}] = c
+	r.order = append(r.order, c)
+	if tcp.SYN && tcp.ACK {
+		return c, c.resp
+	}
+	return c, c.orig
+}
+
+// seqDiff returns the distance from sequence number b to a, accounting
+// for wraparound.
+func seqDiff(a, b uint32) int64 {
+	return int64(int32(a - b))
+}
+
+func (r *Reassembler) add(h *half, seg segment) {
+	d := seqDiff(seg.seq, h.next)
+	if d > 0 {
+		// Out of order, so hold the segment until the data before it
+		// arrives.
+		seg.data = append([]byte(nil), seg.data...)
+		h.pending = append(h.pending, seg)
+		h.size += len(seg.data)
+		if h.size > MaxPendingBytes {
+			r.flush(h)
+		}
+		return
+	}
+	r.deliver(h, seg)
+	r.drain(h)
+}
+
+// deliver emits the part of seg beyond what has already been delivered.
+func (r *Reassembler) deliver(h *half, seg segment) {
+	d := seqDiff(seg.seq, h.next)
+	if d > 0 {
+		// Skip over missing data.
+		h.offset += uint64(d)
+		h.next = seg.seq
+		d = 0
+	}
+	if -d >= int64(len(seg.data)) {
+		// Retransmission of data already delivered.
+		return
+	}
+	data := append([]byte(nil), seg.data[-d:]...)
+	r.out = append(r.out, Chunk{
+		Ts:     seg.ts,
+		Flow:   h.flow,
+		Orig:   h.orig,
+		Offset: h.offset,
+		Data:   data,
+	})
+	h.offset += uint64(len(data))
+	h.next += uint32(len(data))
+}
+
+// drain delivers pending segments that are now in order.
+func (r *Reassembler) drain(h *half) {
+	for {
+		var progress bool
+		pending := h.pending[:0]
+		for _, seg := range h.pending {
+			if seqDiff(seg.seq, h.next) <= 0 {
+				r.deliver(h, seg)
+				h.size -= len(seg.data)
+				progress = true
+			} else {
+				pending = append(pending, seg)
+			}
+		}
+		h.pending = pending
+		if !progress {
+			return
+		}
+	}
+}
+
+// flush delivers all pending segments in sequence order regardless of
+// missing data.
+func (r *Reassembler) flush(h *half) {
+	sort.SliceStable(h.pending, func(i, j int) bool {
+		return seqDiff(h.pending[i].seq, h.pending[j].seq) < 0
+	})
+	for _, seg := range h.pending {
+		r.deliver(h, seg)
+	}
+	h.pending = nil
+	h.size = 0
+}
+
+// ErrMultipleConnections is returned by a StreamReader whose input holds
+// data from more than one TCP connection.
+var ErrMultipleConnections = errors.New("pcap: stream input holds more than one TCP connection")
+
+// StreamReader is an io.Reader of the reassembled TCP payload sent in one
+// direction of the only TCP connection in a pcap.  Once data of a second
+// connection is found, Read returns ErrMultipleConnections.
+type StreamReader struct {
+	r    *Reassembler
+	orig bool
+	// conn is the flow, in the originator's direction, of the connection
+	// whose data is read.
+	conn string
+	buf  []byte
+}
+
+// NewStreamReader returns a StreamReader of the data sent by the
+// connection's originator if orig is true or else by its responder.
+func NewStreamReader(r pcapio.Reader, orig bool) *StreamReader {
+	return &StreamReader{r: NewReassembler(r), orig: orig}
+}
+
+func (s *StreamReader) Read(p []byte) (int, error) {
+	for len(s.buf) == 0 {
+		c, err := s.r.Next()
+		if err != nil {
+			return 0, err
+		}
+		if c == nil {
+			return 0, io.EOF
+		}
+		flow := c.Flow
+		if !c.Orig {
+			flow = Flow{flow.S1, flow.S0}
+		}
+		if s.conn == "" {
+			s.conn = flow.String()
+		} else if s.conn != flow.String() {
+			return 0, ErrMultipleConnections
+		}
+		if c.Orig == s.orig {
+			s.buf = c.Data
+		}
+	}
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+// ChunkReader is a zbuf.Reader of the reassembled TCP payload in a pcap
+// with a record for each chunk.  Each record has the columns ts, id (the
+// connection's orig_h, orig_p, resp_h, and resp_p), is_orig, offset, and
+// payload, which holds the chunk's data as a bytes value.
+type ChunkReader struct {
+	r       *Reassembler
+	builder *zng.Builder
+}
+
+func NewChunkReader(zctx *resolver.Context, r pcapio.Reader) *ChunkReader {
+	id := zctx.MustLookupTypeRecord([]zng.Column{
+		zng.NewColumn("orig_h", zng.TypeIP),
+		zng.NewColumn("orig_p", zng.TypePort),
+		zng.NewColumn("resp_h", zng.TypeIP),
+		zng.NewColumn("resp_p", zng.TypePort),
+	})
+	typ := zctx.MustLookupTypeRecord([]zng.Column{
+		zng.NewColumn("ts", zng.TypeTime),
+		zng.NewColumn("id", id),
+		zng.NewColumn("is_orig", zng.TypeBool),
+		zng.NewColumn("offset", zng.TypeUint64),
+		zng.NewColumn("payload", zng.TypeBytes),
+	})
+	return &ChunkReader{
+		r:       NewReassembler(r),
+		builder: zng.NewBuilder(typ),
+	}
+}
+
+func (c *ChunkReader) Read() (*zng.Record, error) {
+	chunk, err := c.r.Next()
+	if chunk == nil || err != nil {
+		return nil, err
+	}
+	orig, resp := chunk.Flow.S0, chunk.Flow.S1
+	if !chunk.Orig {
+		orig, resp = resp, orig
+	}
+	var id zcode.Bytes
+	id = zcode.AppendPrimitive(id, zng.EncodeIP(orig.IP))
+	id = zcode.AppendPrimitive(id, zng.EncodePort(uint32(orig.Port)))
+	id = zcode.AppendPrimitive(id, zng.EncodeIP(resp.IP))
+	id = zcode.AppendPrimitive(id, zng.EncodePort(uint32(resp.Port)))
+	return c.builder.Build(
+		zng.EncodeTime(chunk.Ts),
+		id,
+		zng.EncodeBool(chunk.Orig),
+		zng.EncodeUint(chunk.Offset),
+		zng.EncodeBytes(chunk.Data),
+	).Keep(), nil
+}
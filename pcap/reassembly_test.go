@@ -0,0 +1,237 @@
+package pcap_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pcap/pcaptest"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type segment struct {
+	fromClient bool
+	seq        uint32
+	flags      string
+	payload    string
+}
+
+// writeTCP writes a pcap of a TCP connection between 10.0.0.1:5000 and
+// 10.0.0.2:80 made of the given segments.
+func writeTCP(t *testing.T, segs []segment) []byte {
+	return writeConns(t, segs)
+}
+
+// writeConns is like writeTCP but writes the segments of each conns[i],
+// one after the other, as a connection from client port 5000+i.
+func writeConns(t *testing.T, conns ...[]segment) []byte {
+	var buf bytes.Buffer
+	w := pcapgo.NewWriter(&buf)
+	require.NoError(t, w.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	var i int
+	for port, segs := range conns {
+		for _, s := range segs {
+			writeSegment(t, w, 5000+layers.TCPPort(port), time.Unix(int64(i), 0), s)
+			i++
+		}
+	}
+	return buf.Bytes()
+}
+
+func writeSegment(t *testing.T, w *pcapgo.Writer, port layers.TCPPort, ts time.Time, s segment) {
+	src, dst := "10.0.0.1", "10.0.0.2"
+	tcp := &layers.TCP{SrcPort: port, DstPort: 80, Seq: s.seq}
+	if !s.fromClient {
+		src, dst = dst, src
+		tcp.SrcPort, tcp.DstPort = tcp.DstPort, tcp.SrcPort
+	}
+	for _, f := range s.flags {
+		switch f {
+		case 'S':
+			tcp.SYN = true
+		case 'A':
+			tcp.ACK = true
+		case 'F':
+			tcp.FIN = true
+		case 'R':
+			tcp.RST = true
+		}
+	}
+	pcaptest.WritePacket(t, w, ts, pcaptest.Packet(t, src, dst, tcp, s.payload))
+}
+
+func reassemble(t *testing.T, b []byte) []pcap.Chunk {
+	r, err := pcapio.NewReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	ra := pcap.NewReassembler(r)
+	var chunks []pcap.Chunk
+	for {
+		c, err := ra.Next()
+		require.NoError(t, err)
+		if c == nil {
+			return chunks
+		}
+		chunks = append(chunks, *c)
+	}
+}
+
+// streams returns the reassembled data sent by the client and the server.
+func streams(chunks []pcap.Chunk) (string, string) {
+	var client, server []byte
+	for _, c := range chunks {
+		if c.Orig {
+			client = append(client, c.Data...)
+		} else {
+			server = append(server, c.Data...)
+		}
+	}
+	return string(client), string(server)
+}
+
+func TestReassembly(t *testing.T) {
+	// Client ISN is 1000 and server ISN is 5000.
+	pkts := writeTCP(t, []segment{
+		{true, 1000, "S", ""},
+		{false, 5000, "SA", ""},
+		{true, 1001, "A", "GET / "},
+		// Out of order.
+		{true, 1015, "A", "\r\n"},
+		{true, 1007, "A", "HTTP/1"},
+		// Retransmission overlapping delivered and new data.
+		{true, 1011, "A", "/1.1"},
+		{false, 5001, "A", "HTTP/1.1 200 OK\r\n"},
+		// Exact retransmission.
+		{false, 5001, "A", "HTTP/1.1 200 OK\r\n"},
+		{false, 5018, "FA", "\r\n"},
+		{true, 1017, "FA", ""},
+	})
+	chunks := reassemble(t, pkts)
+	client, server := streams(chunks)
+	assert.Equal(t, "GET / HTTP/1.1\r\n", client)
+	assert.Equal(t, "HTTP/1.1 200 OK\r\n\r\n", server)
+	for _, c := range chunks {
+		if c.Orig {
+			assert.Equal(t, "10.0.0.1:5000-10.0.0.2:80", c.Flow.String())
+		} else {
+			assert.Equal(t, "10.0.0.2:80-10.0.0.1:5000", c.Flow.String())
+		}
+	}
+	last := chunks[len(chunks)-1]
+	assert.False(t, last.Orig)
+	assert.Equal(t, uint64(17), last.Offset)
+}
+
+func TestReassemblyMissingData(t *testing.T) {
+	// No handshake, and the segment at 110 is never seen.
+	pkts := writeTCP(t, []segment{
+		{true, 100, "A", "0123456789"},
+		{true, 120, "A", "klmnopqrst"},
+	})
+	chunks := reassemble(t, pkts)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, uint64(0), chunks[0].Offset)
+	assert.Equal(t, "0123456789", string(chunks[0].Data))
+	assert.Equal(t, uint64(20), chunks[1].Offset)
+	assert.Equal(t, "klmnopqrst", string(chunks[1].Data))
+}
+
+func TestReassemblyPortReuse(t *testing.T) {
+	// The second SYN, with a new ISN, begins a new connection, so its
+	// data starts a new stream rather than following a gap in the first.
+	pkts := writeTCP(t, []segment{
+		{true, 1000, "S", ""},
+		{true, 1001, "A", "first"},
+		{true, 1010, "A", "lost"},
+		{true, 5000, "S", ""},
+		{true, 5001, "A", "second"},
+	})
+	chunks := reassemble(t, pkts)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, uint64(0), chunks[0].Offset)
+	assert.Equal(t, "first", string(chunks[0].Data))
+	assert.Equal(t, uint64(9), chunks[1].Offset)
+	assert.Equal(t, "lost", string(chunks[1].Data))
+	assert.Equal(t, uint64(0), chunks[2].Offset)
+	assert.Equal(t, "second", string(chunks[2].Data))
+}
+
+func TestReassemblyClose(t *testing.T) {
+	// The pending data of a connection is delivered when it closes
+	// rather than at the end of the input, and a later segment with the
+	// same ports begins a new connection.
+	for _, closing := range [][]segment{
+		{{true, 1020, "FA", ""}, {false, 5001, "FA", ""}},
+		{{false, 5001, "R", ""}},
+	} {
+		segs := []segment{
+			{true, 1000, "S", ""},
+			{true, 1001, "A", "first"},
+			{true, 1010, "A", "lost"},
+		}
+		segs = append(segs, closing...)
+		segs = append(segs, segment{true, 9000, "A", "second"})
+		chunks := reassemble(t, writeTCP(t, segs))
+		require.Len(t, chunks, 3)
+		assert.Equal(t, "lost", string(chunks[1].Data))
+		assert.Equal(t, uint64(0), chunks[2].Offset)
+		assert.Equal(t, "second", string(chunks[2].Data))
+	}
+}
+
+func TestStreamReader(t *testing.T) {
+	f, err := os.Open("../zqd/testdata/valid.pcap")
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := pcapio.NewReader(f)
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(pcap.NewStreamReader(r, true))
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(b, []byte("GET /echo?.kl=Y HTTP/1.1\r\n")))
+}
+
+func readStream(t *testing.T, b []byte, orig bool) (string, error) {
+	r, err := pcapio.NewReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(pcap.NewStreamReader(r, orig))
+	return string(out), err
+}
+
+func TestStreamReaderDirection(t *testing.T) {
+	pkts := writeTCP(t, []segment{
+		{true, 1000, "S", ""},
+		{false, 5000, "SA", ""},
+		{true, 1001, "A", "ping"},
+		{false, 5001, "A", "pong"},
+		{true, 1005, "A", "ping"},
+	})
+	s, err := readStream(t, pkts, true)
+	require.NoError(t, err)
+	assert.Equal(t, "pingping", s)
+	s, err = readStream(t, pkts, false)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", s)
+}
+
+func TestStreamReaderMultipleConnections(t *testing.T) {
+	pkts := writeConns(t, []segment{
+		{true, 1000, "S", ""},
+		{true, 1001, "A", "first"},
+	}, []segment{
+		{true, 2000, "S", ""},
+		{true, 2001, "A", "second"},
+	})
+	s, err := readStream(t, pkts, true)
+	assert.Equal(t, pcap.ErrMultipleConnections, err)
+	assert.Equal(t, "first", s)
+	// The responders sent no data but the second connection is still
+	// found.
+	_, err = readStream(t, pkts, false)
+	assert.Equal(t, pcap.ErrMultipleConnections, err)
+}
@@ -0,0 +1,82 @@
+// Package bloom implements a Bloom filter, a compact set representation
+// whose membership test has no false negatives and a false positive rate
+// that is chosen when the filter is sized.
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+type Filter struct {
+	bits []byte
+	k    int
+}
+
+// New returns a Filter sized to hold n elements with a false positive
+// rate of about p.
+func New(n int, p float64) *Filter {
+	if n < 1 {
+		n = 1
+	}
+	m := int(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := int(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &Filter{
+		bits: make([]byte, (m+7)/8),
+		k:    k,
+	}
+}
+
+// FromBytes returns the Filter whose bit array and number of hash
+// functions are bits and k, as returned by the Bytes and K methods.
+func FromBytes(bits []byte, k int) *Filter {
+	return &Filter{bits: bits, k: k}
+}
+
+// Bytes returns the filter's bit array.
+func (f *Filter) Bytes() []byte {
+	return f.bits
+}
+
+// K returns the number of hash functions used by the filter.
+func (f *Filter) K() int {
+	return f.k
+}
+
+func (f *Filter) Add(b []byte) {
+	h1, h2 := hash(b)
+	m := uint64(len(f.bits) * 8)
+	for i := 0; i < f.k; i++ {
+		bit := (h1 + uint64(i)*h2) % m
+		f.bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns false if b was never added to the filter and true if
+// it probably was.
+func (f *Filter) Test(b []byte) bool {
+	m := uint64(len(f.bits) * 8)
+	if m == 0 {
+		return false
+	}
+	h1, h2 := hash(b)
+	for i := 0; i < f.k; i++ {
+		bit := (h1 + uint64(i)*h2) % m
+		if f.bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hash derives the two hashes from which the k hash functions are formed
+// as h1 + i*h2, following Kirsch and Mitzenmacher.
+func hash(b []byte) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write(b)
+	sum := h.Sum64()
+	return sum & math.MaxUint32, sum>>32 | 1
+}
@@ -0,0 +1,37 @@
+package bloom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	const n = 10000
+	f := New(n, 0.01)
+	for i := 0; i < n; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < n; i++ {
+		require.True(t, f.Test([]byte(strconv.Itoa(i))), i)
+	}
+	var fp int
+	for i := n; i < 2*n; i++ {
+		if f.Test([]byte(strconv.Itoa(i))) {
+			fp++
+		}
+	}
+	assert.Less(t, fp, n/50)
+
+	g := FromBytes(f.Bytes(), f.K())
+	assert.True(t, g.Test([]byte("42")))
+}
+
+func TestEmptyFilter(t *testing.T) {
+	t.Parallel()
+	assert.False(t, FromBytes(nil, 3).Test([]byte("x")))
+	assert.False(t, New(0, 0.01).Test([]byte("x")))
+}
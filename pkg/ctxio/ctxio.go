@@ -6,6 +6,7 @@ package ctxio
 import (
 	"context"
 	"io"
+	"time"
 )
 
 type writer struct {
@@ -48,3 +49,36 @@ func Copy(ctx context.Context, dst io.Writer, src io.Reader) (n int64, err error
 	}
 	return io.Copy(dst, src)
 }
+
+type tailReader struct {
+	io.Reader
+	ctx      context.Context
+	interval time.Duration
+}
+
+// NewTailReader returns a reader that, like tail -f, waits for data to be
+// appended to r when it reaches the end of r rather than returning io.EOF,
+// checking for more every interval.  The returned reader returns io.EOF
+// once ctx is canceled.
+func NewTailReader(ctx context.Context, r io.Reader, interval time.Duration) io.Reader {
+	return &tailReader{r, ctx, interval}
+}
+
+func (t *tailReader) Read(p []byte) (int, error) {
+	for {
+		n, err := t.Reader.Read(p)
+		if n > 0 && err == io.EOF {
+			err = nil
+		}
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		timer := time.NewTimer(t.interval)
+		select {
+		case <-timer.C:
+		case <-t.ctx.Done():
+			timer.Stop()
+			return 0, io.EOF
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileExclusive atomically creates the named file with the given
+// contents.  If the file already exists, it is left unchanged and an error
+// satisfying os.IsExist is returned.  Readers never observe a partially
+// written file.
+func WriteFileExclusive(name string, data []byte, perm os.FileMode) (err error) {
+	name, err = filepath.Abs(name)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(name), ".tmp-"+filepath.Base(name))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), perm); err != nil {
+		return err
+	}
+	// Unlike a rename, a link fails if the target exists.
+	return os.Link(f.Name(), name)
+}
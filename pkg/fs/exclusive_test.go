@@ -0,0 +1,30 @@
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileExclusive(t *testing.T) {
+	tdir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(tdir)
+
+	name := filepath.Join(tdir, "foo")
+	require.NoError(t, WriteFileExclusive(name, []byte("first"), 0600))
+	err = WriteFileExclusive(name, []byte("second"), 0600)
+	require.Error(t, err)
+	require.True(t, os.IsExist(err))
+
+	b, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(b))
+	// The temporary files are cleaned up.
+	infos, err := ioutil.ReadDir(tdir)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+}
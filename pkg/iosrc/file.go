@@ -11,6 +11,7 @@ import (
 
 var DefaultFileSource = &FileSource{Perm: 0666}
 var _ DirMaker = DefaultFileSource
+var _ ExclusiveWriter = DefaultFileSource
 
 type FileSource struct {
 	Perm os.FileMode
@@ -36,6 +37,14 @@ func (s *FileSource) WriteFile(d []byte, uri URI) error {
 	return wrapfileError(uri, err)
 }
 
+func (s *FileSource) WriteFileExclusive(d []byte, uri URI) error {
+	err := fs.WriteFileExclusive(uri.Filepath(), d, s.Perm)
+	if os.IsExist(err) {
+		return zqe.E(zqe.Exists, uri.String())
+	}
+	return wrapfileError(uri, err)
+}
+
 func (s *FileSource) MkdirAll(uri URI, perm os.FileMode) error {
 	return wrapfileError(uri, os.MkdirAll(uri.Filepath(), perm))
 }
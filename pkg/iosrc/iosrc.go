@@ -51,6 +51,13 @@ type ReplacerAble interface {
 	NewReplacer(URI) (io.WriteCloser, error)
 }
 
+// An ExclusiveWriter source supports atomically creating a URI only if it
+// does not already exist.  WriteFileExclusive returns an error of kind
+// zqe.Exists if the URI exists.
+type ExclusiveWriter interface {
+	WriteFileExclusive([]byte, URI) error
+}
+
 func NewReader(uri URI) (Reader, error) {
 	source, err := GetSource(uri)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 
 var defaultS3Source = &s3Source{}
 var _ Source = defaultS3Source
+var _ ExclusiveWriter = defaultS3Source
 
 type s3Source struct {
 	Config *aws.Config
@@ -52,6 +53,14 @@ func (s *s3Source) WriteFile(d []byte, u URI) error {
 	return err
 }
 
+func (s *s3Source) WriteFileExclusive(d []byte, u URI) error {
+	err := s3io.PutIfNotExists(u.String(), d, s.Config)
+	if errors.Is(err, s3io.ErrExists) {
+		return zqe.E(zqe.Exists, u.String())
+	}
+	return wrapErr(err)
+}
+
 func (s *s3Source) Remove(u URI) error {
 	return wrapErr(s3io.Remove(u.String(), s.Config))
 }
@@ -43,13 +43,12 @@ func (r *Reader) fill(min int) error {
 	r.buffer = r.buffer[:cap(r.buffer)]
 	copy(r.buffer, r.cursor)
 	clen := len(r.cursor)
-	space := len(r.buffer) - clen
-	for space > 0 {
+	// Read only until min bytes are available so that a reader of a
+	// stream (e.g., a followed search) is not held up waiting for data
+	// to fill the rest of the buffer.
+	for clen < min {
 		cc, err := r.Reader.Read(r.buffer[clen:])
-		if cc > 0 {
-			clen += cc
-			space -= cc
-		}
+		clen += cc
 		if err != nil {
 			if err == io.EOF {
 				r.eof = true
@@ -71,6 +70,9 @@ func (r *Reader) Peek(n int) ([]byte, error) {
 		if err := r.fill(n); err != nil {
 			return nil, err
 		}
+		if len(r.cursor) == 0 && r.eof {
+			return nil, io.EOF
+		}
 	}
 	if n > len(r.cursor) {
 		return r.cursor, ErrTruncated
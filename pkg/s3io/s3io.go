@@ -1,6 +1,7 @@
 package s3io
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"net/http"
@@ -18,6 +19,9 @@ import (
 
 var ErrInvalidS3Path = errors.New("path is not a valid s3 location")
 
+// ErrExists is returned by PutIfNotExists when the object already exists.
+var ErrExists = errors.New("object already exists")
+
 // uploader is an interface wrapper for s3manager.Uploader. This is only here
 // for unit testing purposes.
 type uploader interface {
@@ -98,6 +102,32 @@ func (w *Writer) Close() error {
 	return w.err
 }
 
+// PutIfNotExists writes an object only if no object exists at path, using
+// a conditional write (If-None-Match: *), and returns ErrExists otherwise.
+// A concurrent conditional write to the same path is also reported as
+// ErrExists.
+func PutIfNotExists(path string, data []byte, cfg *aws.Config) error {
+	bucket, key, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	req, _ := newClient(cfg).PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	req.HTTPRequest.Header.Set("If-None-Match", "*")
+	err = req.Send()
+	var reqerr awserr.RequestFailure
+	if errors.As(err, &reqerr) {
+		switch reqerr.StatusCode() {
+		case http.StatusPreconditionFailed, http.StatusConflict:
+			return ErrExists
+		}
+	}
+	return err
+}
+
 func RemoveAll(path string, cfg *aws.Config) error {
 	bucket, key, err := parsePath(path)
 	if err != nil {
@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,3 +68,30 @@ type mockUploader func(*s3manager.UploadInput, ...func(*s3manager.Uploader)) (*s
 func (m mockUploader) Upload(in *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
 	return m(in, opts...)
 }
+
+func TestPutIfNotExists(t *testing.T) {
+	objects := make(map[string][]byte)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "*", r.Header.Get("If-None-Match"))
+		if _, ok := objects[r.URL.Path]; ok {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		objects[r.URL.Path] = b
+	}))
+	defer srv.Close()
+	cfg := &aws.Config{
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:         aws.String(srv.URL),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+	}
+
+	require.NoError(t, PutIfNotExists("s3://bucket/key", []byte("first"), cfg))
+	err := PutIfNotExists("s3://bucket/key", []byte("second"), cfg)
+	require.Equal(t, ErrExists, err)
+	require.Equal(t, "first", string(objects["/bucket/key"]))
+}
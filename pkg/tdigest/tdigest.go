@@ -0,0 +1,177 @@
+// Package tdigest implements the merging t-digest of Dunning and Ertl, a
+// compact sketch of a distribution of numbers that estimates quantiles
+// with accuracy that is best near the extremes.  Digests can be merged,
+// so a distribution may be summarized in pieces (e.g., on parallel
+// branches of a flowgraph) and the pieces combined afterward.
+package tdigest
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultCompression bounds the number of centroids retained by a digest
+// to a small multiple of its value.
+const DefaultCompression = 100
+
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+type TDigest struct {
+	compression float64
+	centroids   []Centroid
+	unmerged    []Centroid
+	min         float64
+	max         float64
+}
+
+func New(compression float64) *TDigest {
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds a value with weight one to the digest.
+func (t *TDigest) Add(x float64) {
+	t.AddCentroid(Centroid{x, 1})
+}
+
+// AddCentroid adds a weighted value to the digest.
+func (t *TDigest) AddCentroid(c Centroid) {
+	if math.IsNaN(c.Mean) || c.Weight <= 0 {
+		return
+	}
+	t.min = math.Min(t.min, c.Mean)
+	t.max = math.Max(t.max, c.Mean)
+	t.unmerged = append(t.unmerged, c)
+	if len(t.unmerged) > 8*int(t.compression) {
+		t.compress()
+	}
+}
+
+// Merge adds the centroids of another digest to t.
+func (t *TDigest) Merge(other *TDigest) {
+	for _, c := range other.Centroids() {
+		t.AddCentroid(c)
+	}
+	// The extremes of other may lie beyond its centroid means.
+	if other.Count() > 0 {
+		t.min = math.Min(t.min, other.min)
+		t.max = math.Max(t.max, other.max)
+	}
+}
+
+// Centroids returns the centroids of the digest in order of increasing mean.
+func (t *TDigest) Centroids() []Centroid {
+	t.compress()
+	return t.centroids
+}
+
+// Count returns the total weight of the values added to the digest.
+func (t *TDigest) Count() float64 {
+	var n float64
+	for _, c := range t.centroids {
+		n += c.Weight
+	}
+	for _, c := range t.unmerged {
+		n += c.Weight
+	}
+	return n
+}
+
+// Min returns the smallest value added to the digest or +Inf if it is empty.
+func (t *TDigest) Min() float64 {
+	return t.min
+}
+
+// Max returns the largest value added to the digest or -Inf if it is empty.
+func (t *TDigest) Max() float64 {
+	return t.max
+}
+
+// SetBounds sets the smallest and largest values of the digest, which are
+// otherwise computed from the values added.  It is used to restore a digest
+// from its centroids.
+func (t *TDigest) SetBounds(min, max float64) {
+	t.min, t.max = min, max
+}
+
+// compress merges the unmerged centroids into the sorted centroids,
+// combining adjacent centroids whose total weight is within the bound
+// for their position in the distribution.
+func (t *TDigest) compress() {
+	if len(t.unmerged) == 0 {
+		return
+	}
+	all := append(t.centroids, t.unmerged...)
+	sort.Slice(all, func(i, j int) bool { return all[i].Mean < all[j].Mean })
+	var total float64
+	for _, c := range all {
+		total += c.Weight
+	}
+	out := make([]Centroid, 0, len(all))
+	cur := all[0]
+	var sofar float64
+	for _, c := range all[1:] {
+		proposed := cur.Weight + c.Weight
+		q0 := sofar / total
+		q1 := (sofar + proposed) / total
+		limit := 4 * total * math.Min(q0*(1-q0), q1*(1-q1)) / t.compression
+		if proposed <= limit {
+			cur.Mean += (c.Mean - cur.Mean) * c.Weight / proposed
+			cur.Weight = proposed
+			continue
+		}
+		sofar += cur.Weight
+		out = append(out, cur)
+		cur = c
+	}
+	t.centroids = append(out, cur)
+	t.unmerged = nil
+}
+
+// Quantile returns an estimate of the value at quantile q, where q is
+// between 0 and 1, or NaN if the digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	cs := t.Centroids()
+	if len(cs) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return t.min
+	}
+	if q >= 1 {
+		return t.max
+	}
+	if len(cs) == 1 {
+		return cs[0].Mean
+	}
+	var total float64
+	for _, c := range cs {
+		total += c.Weight
+	}
+	index := q * total
+	// Each centroid is taken to be centered on its mean, with half
+	// of its weight on either side.
+	center := cs[0].Weight / 2
+	if index < center {
+		return interpolate(t.min, cs[0].Mean, index/center)
+	}
+	for i := 0; i < len(cs)-1; i++ {
+		next := center + (cs[i].Weight+cs[i+1].Weight)/2
+		if index < next {
+			return interpolate(cs[i].Mean, cs[i+1].Mean, (index-center)/(next-center))
+		}
+		center = next
+	}
+	last := cs[len(cs)-1]
+	return interpolate(last.Mean, t.max, (index-center)/(last.Weight/2))
+}
+
+func interpolate(a, b, frac float64) float64 {
+	return a + (b-a)*math.Min(frac, 1)
+}
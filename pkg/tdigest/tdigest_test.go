@@ -0,0 +1,51 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmall(t *testing.T) {
+	t.Parallel()
+	td := New(DefaultCompression)
+	assert.True(t, math.IsNaN(td.Quantile(0.5)))
+	for _, x := range []float64{10, 0, 5} {
+		td.Add(x)
+	}
+	assert.Equal(t, 0., td.Quantile(0))
+	assert.Equal(t, 5., td.Quantile(0.5))
+	assert.Equal(t, 10., td.Quantile(1))
+	assert.Equal(t, 3., td.Count())
+}
+
+func TestAccuracy(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1))
+	var values []float64
+	whole := New(DefaultCompression)
+	parts := []*TDigest{New(DefaultCompression), New(DefaultCompression), New(DefaultCompression)}
+	for i := 0; i < 100000; i++ {
+		x := r.ExpFloat64()
+		values = append(values, x)
+		whole.Add(x)
+		parts[i%len(parts)].Add(x)
+	}
+	merged := New(DefaultCompression)
+	for _, p := range parts {
+		merged.Merge(p)
+	}
+	require.Less(t, len(whole.Centroids()), 10*DefaultCompression)
+	sort.Float64s(values)
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+		expected := values[int(q*float64(len(values)))]
+		assert.InEpsilon(t, expected, whole.Quantile(q), 0.02, "quantile %g", q)
+		assert.InEpsilon(t, expected, merged.Quantile(q), 0.02, "merged quantile %g", q)
+	}
+	assert.Equal(t, values[0], merged.Min())
+	assert.Equal(t, values[len(values)-1], merged.Max())
+}
@@ -11,6 +11,7 @@ import (
 	filterproc "github.com/brimsec/zq/proc/filter"
 	"github.com/brimsec/zq/proc/groupby"
 	"github.com/brimsec/zq/proc/head"
+	"github.com/brimsec/zq/proc/join"
 	"github.com/brimsec/zq/proc/merge"
 	"github.com/brimsec/zq/proc/orderedmerge"
 	"github.com/brimsec/zq/proc/pass"
@@ -39,6 +40,16 @@ func isContainerProc(node ast.Proc) bool {
 // the leaves.  A custom proc compiler can be included and it will be tried first
 // for each node encountered during the compilation.
 func Compile(custom Hook, node ast.Proc, pctx *proc.Context, parents []proc.Interface) ([]proc.Interface, error) {
+	if node, ok := node.(*ast.JoinProc); ok {
+		if len(parents) != 2 {
+			return nil, fmt.Errorf("proc.CompileProc: join requires two parents, got %d", len(parents))
+		}
+		join, err := join.New(pctx, parents[0], parents[1], node)
+		if err != nil {
+			return nil, fmt.Errorf("compiling join: %w", err)
+		}
+		return []proc.Interface{join}, nil
+	}
 	if !isContainerProc(node) && len(parents) != 1 {
 		return nil, fmt.Errorf("proc.CompileProc: expected single parent for node %T, got %d", node, len(parents))
 	}
@@ -134,8 +145,12 @@ func Compile(custom Hook, node ast.Proc, pctx *proc.Context, parents []proc.Inte
 			}
 			// merge unless we're at the end of the chain,
 			// in which case the output layer will mux
-			// into channels.
+			// into channels, or the next proc is a join,
+			// which consumes the branches itself.
 			if len(parents) > 1 && k < n-1 {
+				if _, ok := v.Procs[k+1].(*ast.JoinProc); ok {
+					continue
+				}
 				p := v.Procs[k].(*ast.ParallelProc)
 				if p.MergeOrderField != "" {
 					parents = []proc.Interface{orderedmerge.New(pctx, parents, p.MergeOrderField, p.MergeOrderReverse)}
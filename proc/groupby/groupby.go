@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/brimsec/zq/ast"
@@ -33,17 +34,8 @@ type GroupByParams struct {
 	emitPart     bool
 }
 
-type errTooBig int
-
-func (e errTooBig) Error() string {
-	return fmt.Sprintf("non-decomposable groupby aggregation exceeded configured cardinality limit (%d)", e)
-}
-
-func IsErrTooBig(err error) bool {
-	_, ok := err.(errTooBig)
-	return ok
-}
-
+// DefaultLimit is the number of groups held in memory by a groupby
+// proc before it begins to spill to disk.
 var DefaultLimit = 1000000
 
 func CompileParams(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByParams, error) {
@@ -62,7 +54,7 @@ func CompileParams(node *ast.GroupByProc, zctx *resolver.Context) (*GroupByParam
 	}
 	reducers := make([]compile.CompiledReducer, 0)
 	for _, reducer := range node.Reducers {
-		compiled, err := compile.Compile(reducer)
+		compiled, err := compile.Compile(zctx, reducer)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +145,17 @@ type Aggregator struct {
 	runManager   *sort.RunManager
 	consumePart  bool
 	emitPart     bool
+	// When the reducers are not decomposable, their state cannot be
+	// spilled.  Instead, once the table is full, the input records for
+	// any group not already in the table are spilled to inputRuns
+	// (sorted by inputCompare) and are reduced a group at a time at
+	// end of input.
+	inputRuns    *sort.RunManager
+	inputBuf     []*zng.Record
+	inputBufSize int
+	inputCompare expr.CompareFn
+	tableResults []*zng.Record
+	spillResult  *zng.Record
 }
 
 type GroupByRow struct {
@@ -190,10 +193,14 @@ func NewAggregator(c *proc.Context, params GroupByParams) *Aggregator {
 		resolvers = append(resolvers, expr.CompileFieldAccess(k.target))
 	}
 	rs := expr.NewCompareFn(true, resolvers...)
+	is := newInputCompareFn(params.keys)
+	var inputCompare expr.CompareFn
 	if params.inputSortDir < 0 {
 		keysCompare = func(a, b *zng.Record) int { return rs(b, a) }
+		inputCompare = func(a, b *zng.Record) int { return is(b, a) }
 	} else {
 		keysCompare = rs
+		inputCompare = is
 	}
 	return &Aggregator{
 		inputSortDir: params.inputSortDir,
@@ -212,6 +219,29 @@ func NewAggregator(c *proc.Context, params GroupByParams) *Aggregator {
 		valueCompare: valueCompare,
 		consumePart:  params.consumePart,
 		emitPart:     params.emitPart,
+		inputCompare: inputCompare,
+	}
+}
+
+// newInputCompareFn returns a function that compares input records by
+// the values of the group-by keys.  Unlike a comparison of the key
+// fields of output records, unset values of different types are not
+// equal so that the records of each group are adjacent when sorted.
+func newInputCompareFn(keys []GroupByKey) expr.CompareFn {
+	compare := expr.NewValueCompareFn(true)
+	return func(a, b *zng.Record) int {
+		for _, key := range keys {
+			// Errors yield unset values, which compare as nulls.
+			va, _ := key.expr(a)
+			vb, _ := key.expr(b)
+			if v := compare(va, vb); v != 0 {
+				return v
+			}
+			if va.Type != nil && vb.Type != nil && va.Type.ID() != vb.Type.ID() {
+				return strings.Compare(va.Type.String(), vb.Type.String())
+			}
+		}
+		return 0
 	}
 }
 
@@ -254,6 +284,9 @@ func (p *Proc) run() {
 		if p.agg.runManager != nil {
 			p.agg.runManager.Cleanup()
 		}
+		if p.agg.inputRuns != nil {
+			p.agg.inputRuns.Cleanup()
+		}
 	}()
 	for {
 		batch, err := p.parent.Pull()
@@ -407,10 +440,10 @@ func (a *Aggregator) Consume(r *zng.Record) error {
 
 	row, ok := a.table[string(keyBytes)]
 	if !ok {
+		if !a.decomposable && (a.inputRuns != nil || len(a.table) >= a.limit) {
+			return a.spillInput(r)
+		}
 		if len(a.table) >= a.limit {
-			if !a.decomposable {
-				return errTooBig(a.limit)
-			}
 			if err := a.spillTable(false); err != nil {
 				return err
 			}
@@ -452,6 +485,38 @@ func (a *Aggregator) spillTable(eof bool) error {
 	return nil
 }
 
+// spillInput buffers an input record for a group that is not in the table
+// and writes the buffer to a new run when it reaches sort.MemMaxBytes.
+func (a *Aggregator) spillInput(r *zng.Record) error {
+	if a.inputRuns == nil {
+		var err error
+		a.inputRuns, err = sort.NewRunManager(a.inputCompare)
+		if err != nil {
+			return err
+		}
+	}
+	r = r.Keep()
+	a.inputBuf = append(a.inputBuf, r)
+	a.inputBufSize += len(r.Raw)
+	if a.inputBufSize >= sort.MemMaxBytes {
+		return a.flushInput()
+	}
+	return nil
+}
+
+func (a *Aggregator) flushInput() error {
+	if len(a.inputBuf) == 0 {
+		return nil
+	}
+	// Note that this will sort a.inputBuf according to a.inputCompare.
+	if err := a.inputRuns.CreateRun(a.inputBuf); err != nil {
+		return err
+	}
+	a.inputBuf = nil
+	a.inputBufSize = 0
+	return nil
+}
+
 func (a *Aggregator) updateMaxTableKey(v zng.Value) {
 	if a.maxTableKey == nil {
 		a.maxTableKey = &v
@@ -477,6 +542,9 @@ func (a *Aggregator) updateMaxSpillKey(v zng.Value) {
 // the input is sorted in the primary key, Results can be called
 // before eof, and keys that are completed will returned.
 func (a *Aggregator) Results(eof bool) (zbuf.Batch, error) {
+	if a.inputRuns != nil {
+		return a.readInputSpills(eof)
+	}
 	if a.runManager == nil {
 		return a.readTable(eof, a.emitPart)
 	}
@@ -587,6 +655,109 @@ func (a *Aggregator) nextResultFromSpills() (*zng.Record, error) {
 	return zng.NewRecord(typ, zbytes), nil
 }
 
+// readInputSpills returns the results for the groups in the table merged,
+// in key order, with the results for the groups whose input records were
+// spilled.  Since the spilled groups may precede any group in the table,
+// no results are returned before eof.
+func (a *Aggregator) readInputSpills(eof bool) (zbuf.Batch, error) {
+	if !eof {
+		return nil, nil
+	}
+	if a.tableResults == nil {
+		if err := a.flushInput(); err != nil {
+			return nil, err
+		}
+		batch, err := a.readTable(true, false)
+		if err != nil {
+			return nil, err
+		}
+		a.tableResults = []*zng.Record{}
+		if batch != nil {
+			a.tableResults = batch.Records()
+			expr.SortStable(a.tableResults, a.keysCompare)
+		}
+	}
+	recs := make([]*zng.Record, 0, proc.BatchLen)
+	for len(recs) < proc.BatchLen {
+		if a.spillResult == nil {
+			var err error
+			a.spillResult, err = a.nextResultFromInputSpills()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(a.tableResults) > 0 && (a.spillResult == nil || a.keysCompare(a.tableResults[0], a.spillResult) <= 0) {
+			recs = append(recs, a.tableResults[0])
+			a.tableResults = a.tableResults[1:]
+		} else if a.spillResult != nil {
+			recs = append(recs, a.spillResult)
+			a.spillResult = nil
+		} else {
+			break
+		}
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return zbuf.NewArray(recs), nil
+}
+
+func (a *Aggregator) nextResultFromInputSpills() (*zng.Record, error) {
+	// Consume all input records that have the same grouping keys.
+	row := compile.NewRow(a.reducerDefs)
+	var firstRec *zng.Record
+	for {
+		rec, err := a.inputRuns.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			break
+		}
+		// Reducers may retain values from rec, which is only valid
+		// until the next read, so make a copy.
+		rec = rec.Keep()
+		if firstRec == nil {
+			firstRec = rec
+		} else if a.inputCompare(firstRec, rec) != 0 {
+			break
+		}
+		row.Consume(rec)
+		if _, err := a.inputRuns.Read(); err != nil {
+			return nil, err
+		}
+	}
+	if firstRec == nil {
+		return nil, nil
+	}
+	// Build the result record.
+	a.builder.Reset()
+	var types []zng.Type
+	for _, key := range a.keys {
+		keyVal, err := key.expr(firstRec)
+		if err != nil && !errors.Is(err, zng.ErrUnset) {
+			return nil, err
+		}
+		types = append(types, keyVal.Type)
+		a.builder.Append(keyVal.Bytes, keyVal.IsContainer())
+	}
+	zbytes, err := a.builder.Encode()
+	if err != nil {
+		return nil, err
+	}
+	cols := a.builder.TypedColumns(types)
+	for i, red := range row.Reducers {
+		v := red.Result()
+		cols = append(cols, zng.NewColumn(row.Defs[i].Target, v.Type))
+		zbytes = v.Encode(zbytes)
+	}
+	typ, err := a.zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	return zng.NewRecord(typ, zbytes), nil
+}
+
 // readTable returns a slice of records from the in-memory groupby
 // table. If flush is true, the entire table is returned. If flush is
 // false and input is sorted only completed keys are returned.
@@ -614,11 +785,7 @@ func (a *Aggregator) readTable(flush, decompose bool) (zbuf.Batch, error) {
 					return nil, err
 				}
 			} else {
-				// a reducer value is never a container
 				v = red.Result()
-				if v.IsContainer() {
-					panic("internal bug: reducer result cannot be a container!")
-				}
 			}
 			zv = v.Encode(zv)
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/brimsec/zq/pkg/test"
 	"github.com/brimsec/zq/proc/groupby"
 	"github.com/brimsec/zq/proc/proctest"
+	sortproc "github.com/brimsec/zq/proc/sort"
 	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
@@ -90,6 +91,13 @@ const reducersOut = `
 0:[b;1;1;1;1;1;1;]
 `
 
+const countDistinctOut = `
+#0:record[key1:string,countdistinct:uint64]
+0:[a;2;]
+0:[b;1;]
+0:[-;1;]
+`
+
 const arrayKeyIn = `
 #0:record[arr:array[int32],val:int32]
 0:[-;2;]
@@ -232,6 +240,9 @@ func tests() suite {
 	// Test various reducers
 	s.add(New("reducers", in, reducersOut, "first(n), last(n), sum(n), avg(n), min(n), max(n) by key1 | sort key1"))
 
+	// Test a non-decomposable reducer, which spills its input
+	s.add(New("count-distinct", in+unsetKeyIn, countDistinctOut, "countDistinct(key2) by key1 | sort key1"))
+
 	// Check out of bounds array indexes
 	s.add(New("array-out-of-bounds", arrayKeyIn, arrayKeyOut, "count() by arr | sort"))
 
@@ -524,3 +535,60 @@ func TestGroupbyStreamingSpill(t *testing.T) {
 type nopCloser struct{ io.Writer }
 
 func (*nopCloser) Close() error { return nil }
+
+func TestGroupbyInputSpill(t *testing.T) {
+	// This test verifies that a groupby with a non-decomposable reducer
+	// produces the same results, in the same order for sorted input,
+	// whether or not it spills its input records.
+	savedLimit := groupby.DefaultLimit
+	savedMemMaxBytes := sortproc.MemMaxBytes
+	sortproc.MemMaxBytes = 64
+	defer func() {
+		groupby.DefaultLimit = savedLimit
+		sortproc.MemMaxBytes = savedMemMaxBytes
+	}()
+
+	data := []string{"#0:record[ts:time,ip:ip,port:port]"}
+	for i := 0; i < 200; i++ {
+		data = append(data, fmt.Sprintf("0:[%d;1.1.1.%d;%d;]", i/9, i%3, i%5))
+	}
+
+	runOne := func(inputSortKey string) string {
+		proc, err := zql.ParseProc("every 1s countdistinct(port) by ip")
+		require.NoError(t, err)
+
+		zctx := resolver.NewContext()
+		zr := tzngio.NewReader(strings.NewReader(strings.Join(data, "\n")), zctx)
+		var outbuf bytes.Buffer
+		zw := detector.LookupWriter(&nopCloser{&outbuf}, &zio.WriterFlags{})
+		d := &testGroupByDriver{writer: zw, cb: func(int) {}}
+		err = driver.Run(context.Background(), d, proc, zctx, zr, driver.Config{
+			ReaderSortKey: inputSortKey,
+		})
+		require.NoError(t, err)
+		return outbuf.String()
+	}
+
+	for _, inputSortKey := range []string{"", "ts"} {
+		groupby.DefaultLimit = savedLimit
+		expected := runOne(inputSortKey)
+		groupby.DefaultLimit = 2
+		actual := runOne(inputSortKey)
+		lines := strings.Split(actual, "\n")
+		if inputSortKey != "" {
+			// Results are ordered by ts but not by the secondary key.
+			prev := -1
+			for _, line := range lines[1 : len(lines)-1] {
+				var ts int
+				_, err := fmt.Sscanf(line, "0:[%d;", &ts)
+				require.NoError(t, err)
+				require.LessOrEqual(t, prev, ts, "results out of order")
+				prev = ts
+			}
+		}
+		expectedLines := strings.Split(expected, "\n")
+		sort.Strings(expectedLines)
+		sort.Strings(lines)
+		require.Equal(t, expectedLines, lines, "input sort key %q", inputSortKey)
+	}
+}
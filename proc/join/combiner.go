@@ -0,0 +1,75 @@
+package join
+
+import (
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// A combiner builds joined records from pairs of left and right records.
+// The output type for each pair of input types is computed once and
+// cached.
+type combiner struct {
+	zctx  *resolver.Context
+	cache map[[2]*zng.TypeRecord]*combo
+}
+
+// A combo describes how to join a left record of one type with a right
+// record of another type.  The output record has the type typ and
+// comprises the columns of the left record followed by the columns of the
+// right record whose positions are marked in keep.
+type combo struct {
+	typ  *zng.TypeRecord
+	keep []bool
+}
+
+func newCombiner(zctx *resolver.Context) *combiner {
+	return &combiner{
+		zctx:  zctx,
+		cache: make(map[[2]*zng.TypeRecord]*combo),
+	}
+}
+
+func (c *combiner) lookup(left, right *zng.TypeRecord) (*combo, error) {
+	key := [2]*zng.TypeRecord{left, right}
+	if cb, ok := c.cache[key]; ok {
+		return cb, nil
+	}
+	cols := make([]zng.Column, len(left.Columns), len(left.Columns)+len(right.Columns))
+	copy(cols, left.Columns)
+	keep := make([]bool, len(right.Columns))
+	for k, col := range right.Columns {
+		// Fields of the left record take precedence.
+		if !left.HasField(col.Name) {
+			cols = append(cols, col)
+			keep[k] = true
+		}
+	}
+	typ, err := c.zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return nil, err
+	}
+	cb := &combo{typ: typ, keep: keep}
+	c.cache[key] = cb
+	return cb, nil
+}
+
+func (c *combiner) combine(left, right *zng.Record) (*zng.Record, error) {
+	cb, err := c.lookup(left.Type, right.Type)
+	if err != nil {
+		return nil, err
+	}
+	raw := make(zcode.Bytes, len(left.Raw), len(left.Raw)+len(right.Raw))
+	copy(raw, left.Raw)
+	it := right.Raw.Iter()
+	for _, keep := range cb.keep {
+		zv, _, err := it.NextTagAndBody()
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			raw = append(raw, zv...)
+		}
+	}
+	return zng.NewRecord(cb.typ, raw), nil
+}
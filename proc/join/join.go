@@ -0,0 +1,399 @@
+package join
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/proc"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each join proc
+// will consume to hold records before it spills its inputs to disk.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// A Join proc is a hash join of two inputs.  The right (build) input is
+// read into a table keyed by the values of the right keys, and then each
+// record of the left (probe) input is matched against the table using the
+// values of the left keys.  When no spilling occurs, the output is in the
+// order of the left input.
+//
+// Since both parents are typically fed by the same splitter, which only
+// advances when all of its outputs have asked for data, the left input is
+// consumed concurrently with the right and buffered until the table is
+// complete.  If the table and this buffer together exceed MemMaxBytes, both
+// inputs are hashed by key into partitions on disk and each partition is
+// then joined in turn, in which case output order is undefined.
+type Proc struct {
+	pctx      *proc.Context
+	left      proc.Interface
+	right     proc.Interface
+	leftJoin  bool
+	leftKeys  []expr.FieldExprResolver
+	rightKeys []expr.FieldExprResolver
+	combiner  *combiner
+	once      sync.Once
+	doneOnce  sync.Once
+	resultCh  chan proc.Result
+	doneCh    chan struct{}
+
+	table   *table
+	pending []*zng.Record
+	nbytes  int
+	spiller *spiller
+	out     []*zng.Record
+	key     []byte
+}
+
+func New(pctx *proc.Context, left, right proc.Interface, node *ast.JoinProc) (*Proc, error) {
+	if len(node.LeftKeys) == 0 || len(node.LeftKeys) != len(node.RightKeys) {
+		return nil, errors.New("join requires the same number of left and right keys")
+	}
+	var leftJoin bool
+	switch node.Kind {
+	case "", "inner":
+	case "left":
+		leftJoin = true
+	default:
+		return nil, errors.New("unknown join kind: " + node.Kind)
+	}
+	leftKeys, err := expr.CompileFieldExprs(node.LeftKeys)
+	if err != nil {
+		return nil, err
+	}
+	rightKeys, err := expr.CompileFieldExprs(node.RightKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &Proc{
+		pctx:      pctx,
+		left:      left,
+		right:     right,
+		leftJoin:  leftJoin,
+		leftKeys:  leftKeys,
+		rightKeys: rightKeys,
+		combiner:  newCombiner(pctx.TypeContext),
+		resultCh:  make(chan proc.Result),
+		doneCh:    make(chan struct{}),
+		table:     newTable(),
+	}, nil
+}
+
+func (p *Proc) Pull() (zbuf.Batch, error) {
+	p.once.Do(func() { go p.run() })
+	if r, ok := <-p.resultCh; ok {
+		return r.Batch, r.Err
+	}
+	return nil, p.pctx.Err()
+}
+
+func (p *Proc) Done() {
+	p.doneOnce.Do(func() { close(p.doneCh) })
+}
+
+func (p *Proc) run() {
+	defer func() {
+		close(p.resultCh)
+		if p.spiller != nil {
+			p.spiller.cleanup()
+		}
+	}()
+	leftCh := p.pullParent(p.left)
+	rightCh := p.pullParent(p.right)
+	// Build the table from the right input while buffering the left input.
+	for rightCh != nil {
+		select {
+		case res := <-rightCh:
+			if res.Err != nil {
+				p.sendResult(nil, res.Err)
+				return
+			}
+			if res.Batch == nil {
+				rightCh = nil
+				break
+			}
+			if err := p.build(res.Batch); err != nil {
+				p.sendResult(nil, err)
+				return
+			}
+		case res := <-leftCh:
+			if res.Err != nil {
+				p.sendResult(nil, res.Err)
+				return
+			}
+			if res.Batch == nil {
+				leftCh = nil
+				break
+			}
+			if err := p.buffer(res.Batch); err != nil {
+				p.sendResult(nil, err)
+				return
+			}
+		case <-p.pctx.Done():
+			return
+		}
+	}
+	if p.spiller != nil {
+		p.runSpilled(leftCh)
+		return
+	}
+	for _, rec := range p.pending {
+		if err := p.probe(rec); err != nil {
+			p.sendResult(nil, err)
+			return
+		}
+	}
+	p.pending = nil
+	for leftCh != nil {
+		select {
+		case res := <-leftCh:
+			if res.Err != nil {
+				p.sendResult(nil, res.Err)
+				return
+			}
+			if res.Batch == nil {
+				leftCh = nil
+				break
+			}
+			for _, rec := range res.Batch.Records() {
+				if err := p.probe(rec); err != nil {
+					p.sendResult(nil, err)
+					return
+				}
+			}
+		case <-p.pctx.Done():
+			return
+		}
+	}
+	p.flush()
+	p.sendResult(nil, nil)
+}
+
+// runSpilled finishes partitioning the left input and then joins each
+// of the partitions on disk.
+func (p *Proc) runSpilled(leftCh <-chan proc.Result) {
+	for leftCh != nil {
+		select {
+		case res := <-leftCh:
+			if res.Err != nil {
+				p.sendResult(nil, res.Err)
+				return
+			}
+			if res.Batch == nil {
+				leftCh = nil
+				break
+			}
+			if err := p.buffer(res.Batch); err != nil {
+				p.sendResult(nil, err)
+				return
+			}
+		case <-p.pctx.Done():
+			return
+		}
+	}
+	err := p.spiller.join(p.pctx.TypeContext, func(right zbuf.Reader) error {
+		p.table = newTable()
+		if right == nil {
+			return nil
+		}
+		for {
+			rec, err := right.Read()
+			if rec == nil || err != nil {
+				return err
+			}
+			if key, ok := p.keyOf(rec, p.rightKeys); ok {
+				p.table.add(key, rec.Keep())
+			}
+		}
+	}, func(left zbuf.Reader) error {
+		for p.pctx.Err() == nil {
+			rec, err := left.Read()
+			if rec == nil || err != nil {
+				return err
+			}
+			if err := p.probe(rec.Keep()); err != nil {
+				return err
+			}
+		}
+		return p.pctx.Err()
+	})
+	if err != nil {
+		p.sendResult(nil, err)
+		return
+	}
+	p.flush()
+	p.sendResult(nil, nil)
+}
+
+func (p *Proc) pullParent(parent proc.Interface) <-chan proc.Result {
+	ch := make(chan proc.Result)
+	go func() {
+		for {
+			batch, err := parent.Pull()
+			select {
+			case ch <- proc.Result{Batch: batch, Err: err}:
+				if proc.EOS(batch, err) {
+					return
+				}
+			case <-p.doneCh:
+				parent.Done()
+				return
+			case <-p.pctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func (p *Proc) sendResult(b zbuf.Batch, err error) {
+	select {
+	case p.resultCh <- proc.Result{Batch: b, Err: err}:
+	case <-p.doneCh:
+	case <-p.pctx.Done():
+	}
+}
+
+// build adds the records of a batch from the right input to the table.
+func (p *Proc) build(batch zbuf.Batch) error {
+	// We're keeping records owned by batch so don't call Unref.
+	for _, rec := range batch.Records() {
+		key, ok := p.keyOf(rec, p.rightKeys)
+		if !ok {
+			// Records with a missing or unset key never match.
+			continue
+		}
+		rec = rec.Keep()
+		if p.spiller != nil {
+			if err := p.spiller.write(rightSide, key, rec); err != nil {
+				return err
+			}
+			continue
+		}
+		p.table.add(key, rec)
+		p.nbytes += len(rec.Raw)
+	}
+	return p.checkMem()
+}
+
+// buffer holds the records of a batch from the left input until the
+// table is complete.
+func (p *Proc) buffer(batch zbuf.Batch) error {
+	for _, rec := range batch.Records() {
+		rec = rec.Keep()
+		if p.spiller != nil {
+			key, _ := p.keyOf(rec, p.leftKeys)
+			if err := p.spiller.write(leftSide, key, rec); err != nil {
+				return err
+			}
+			continue
+		}
+		p.pending = append(p.pending, rec)
+		p.nbytes += len(rec.Raw)
+	}
+	return p.checkMem()
+}
+
+// checkMem moves the table and the pending left records to disk when
+// they have grown too large to hold in memory.
+func (p *Proc) checkMem() error {
+	if p.spiller != nil || p.nbytes < MemMaxBytes {
+		return nil
+	}
+	var err error
+	p.spiller, err = newSpiller()
+	if err != nil {
+		return err
+	}
+	for key, recs := range p.table.entries {
+		for _, rec := range recs {
+			if err := p.spiller.write(rightSide, []byte(key), rec); err != nil {
+				return err
+			}
+		}
+	}
+	for _, rec := range p.pending {
+		key, _ := p.keyOf(rec, p.leftKeys)
+		if err := p.spiller.write(leftSide, key, rec); err != nil {
+			return err
+		}
+	}
+	p.table = newTable()
+	p.pending = nil
+	p.nbytes = 0
+	return nil
+}
+
+// probe looks up the matches for a left record and appends the joined
+// records to the output.
+func (p *Proc) probe(rec *zng.Record) error {
+	var matches []*zng.Record
+	if key, ok := p.keyOf(rec, p.leftKeys); ok {
+		matches = p.table.lookup(key)
+	}
+	if len(matches) == 0 && p.leftJoin {
+		p.emit(rec)
+	}
+	for _, match := range matches {
+		out, err := p.combiner.combine(rec, match)
+		if err != nil {
+			return err
+		}
+		p.emit(out)
+	}
+	return nil
+}
+
+func (p *Proc) emit(rec *zng.Record) {
+	p.out = append(p.out, rec)
+	if len(p.out) >= proc.BatchLen {
+		p.flush()
+	}
+}
+
+func (p *Proc) flush() {
+	if len(p.out) > 0 {
+		p.sendResult(zbuf.NewArray(p.out), nil)
+		p.out = nil
+	}
+}
+
+// keyOf encodes the values of the key expressions for rec into a byte
+// slice suitable for use as a table key.  The encoding includes the type
+// of each value so that values of different types never match.  It returns
+// false if any of the keys is missing or unset.  The returned slice is
+// valid until the next call to keyOf.
+func (p *Proc) keyOf(rec *zng.Record, resolvers []expr.FieldExprResolver) ([]byte, bool) {
+	key := p.key[:0]
+	for _, resolve := range resolvers {
+		v := resolve(rec)
+		if v.Type == nil || v.Bytes == nil {
+			return nil, false
+		}
+		key = zcode.AppendUvarint(key, uint64(zng.AliasedType(v.Type).ID()))
+		key = zcode.AppendPrimitive(key, v.Bytes)
+	}
+	p.key = key
+	return key, true
+}
+
+// table is an in-memory multimap from join keys to right records.
+type table struct {
+	entries map[string][]*zng.Record
+}
+
+func newTable() *table {
+	return &table{entries: make(map[string][]*zng.Record)}
+}
+
+func (t *table) add(key []byte, rec *zng.Record) {
+	t.entries[string(key)] = append(t.entries[string(key)], rec)
+}
+
+func (t *table) lookup(key []byte) []*zng.Record {
+	return t.entries[string(key)]
+}
@@ -0,0 +1,37 @@
+package join_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/proc/join"
+	"github.com/brimsec/zq/ztest"
+)
+
+func TestJoinExternal(t *testing.T) {
+	saved := join.MemMaxBytes
+	join.MemMaxBytes = 1024
+	defer func() {
+		join.MemMaxBytes = saved
+	}()
+
+	// Create enough records on each side to exceed join.MemMaxBytes.
+	var in, out strings.Builder
+	in.WriteString("#0:record[_path:string,uid:string,proto:string]\n")
+	in.WriteString("#1:record[_path:string,uid:string,query:string]\n")
+	out.WriteString("#0:record[uid:string,proto:string,query:string]\n")
+	for i := 0; i < 1000; i++ {
+		uid := fmt.Sprintf("C%04d", i)
+		in.WriteString(fmt.Sprintf("0:[conn;%s;udp;]\n", uid))
+		if i%3 != 0 {
+			in.WriteString(fmt.Sprintf("1:[dns;%s;%s.example.com;]\n", uid, uid))
+			out.WriteString(fmt.Sprintf("0:[%s;udp;%s.example.com;]\n", uid, uid))
+		}
+	}
+	(&ztest.ZTest{
+		ZQL:    "(filter _path=conn; filter _path=dns) | join uid | sort uid | cut uid, proto, query",
+		Input:  []string{in.String()},
+		Output: out.String(),
+	}).Run(t, "", "", "", "")
+}
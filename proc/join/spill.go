@@ -0,0 +1,134 @@
+package join
+
+import (
+	"bufio"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// numPartitions is the number of partitions into which each input is
+// hashed when spilling to disk.
+const numPartitions = 16
+
+const (
+	leftSide = iota
+	rightSide
+)
+
+// A spiller hashes the records of both join inputs into partitions held in
+// temporary zng files so that records with equal keys land in the same
+// partition.  Each partition is expected to be small enough to be joined
+// in memory.
+type spiller struct {
+	tempDir    string
+	partitions [numPartitions][2]*spillFile
+}
+
+// spillFile is a temporary file holding a sequence of zng records.
+type spillFile struct {
+	file *os.File
+	bw   *bufio.Writer
+	zw   *zngio.Writer
+}
+
+// newSpiller creates a temporary directory.  Call cleanup to remove it.
+func newSpiller() (*spiller, error) {
+	tempDir, err := ioutil.TempDir("", "zq-join-")
+	if err != nil {
+		return nil, err
+	}
+	return &spiller{tempDir: tempDir}, nil
+}
+
+func (s *spiller) cleanup() {
+	for _, partition := range s.partitions {
+		for _, f := range partition {
+			if f != nil {
+				f.file.Close()
+			}
+		}
+	}
+	os.RemoveAll(s.tempDir)
+}
+
+func partitionOf(key []byte) int {
+	h := fnv.New32a()
+	h.Write(key)
+	return int(h.Sum32() % numPartitions)
+}
+
+func (s *spiller) write(side int, key []byte, rec *zng.Record) error {
+	k := partitionOf(key)
+	f := s.partitions[k][side]
+	if f == nil {
+		name := filepath.Join(s.tempDir, strconv.Itoa(k)+"-"+strconv.Itoa(side))
+		file, err := fs.Create(name)
+		if err != nil {
+			return err
+		}
+		bw := bufio.NewWriter(file)
+		f = &spillFile{
+			file: file,
+			bw:   bw,
+			zw:   zngio.NewWriter(bw, zio.WriterFlags{}),
+		}
+		s.partitions[k][side] = f
+	}
+	return f.zw.Write(rec)
+}
+
+// join calls build with a reader for the right records of each partition
+// (or nil if there are none) followed by probe with a reader for the left
+// records of the same partition.  Partitions with no left records are
+// skipped.
+func (s *spiller) join(zctx *resolver.Context, build, probe func(zbuf.Reader) error) error {
+	for _, partition := range s.partitions {
+		left, right := partition[leftSide], partition[rightSide]
+		if left == nil {
+			continue
+		}
+		var rightReader zbuf.Reader
+		if right != nil {
+			var err error
+			rightReader, err = right.reader(zctx)
+			if err != nil {
+				return err
+			}
+		}
+		if err := build(rightReader); err != nil {
+			return err
+		}
+		leftReader, err := left.reader(zctx)
+		if err != nil {
+			return err
+		}
+		if err := probe(leftReader); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reader flushes the file and returns a reader for its records.
+func (f *spillFile) reader(zctx *resolver.Context) (zbuf.Reader, error) {
+	if err := f.zw.Flush(); err != nil {
+		return nil, err
+	}
+	if err := f.bw.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := f.file.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	return zngio.NewReader(bufio.NewReader(f.file), zctx), nil
+}
@@ -110,6 +110,12 @@ func (p *Proc) buildRule(inType *zng.TypeRecord, vals []zng.Value) (*putRule, er
 	for k, cl := range p.clauses {
 		typ := vals[k].Type
 		clauseTypes[k] = clauseType{typ, zng.IsContainerType(typ)}
+		if typ.ID() < 0 {
+			// The type of a value created by an expression
+			// function (e.g., String.split) is not bound to any
+			// type context.
+			typ = p.pctx.TypeContext.Localize(typ)
+		}
 		col := zng.Column{Name: cl.target, Type: typ}
 		position, hasCol := inType.ColumnOfField(cl.target)
 		if hasCol {
@@ -0,0 +1,150 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Collect gathers the values of a field into an array in the order in
+// which they are consumed.  Unset values are skipped, as are values whose
+// type differs from that of the first value.
+type Collect struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	typ      zng.Type
+	body     zcode.Bytes
+}
+
+func NewCollect(zctx *resolver.Context, resolver expr.FieldExprResolver) *Collect {
+	return &Collect{Resolver: resolver, zctx: zctx}
+}
+
+func (c *Collect) Consume(r *zng.Record) {
+	v := c.Resolver(r)
+	if v.Type == nil {
+		c.FieldNotFound++
+		return
+	}
+	c.consumeVal(v)
+}
+
+func (c *Collect) consumeVal(v zng.Value) {
+	if v.Bytes == nil {
+		return
+	}
+	if c.typ == nil {
+		c.typ = v.Type
+	} else if v.Type != c.typ {
+		c.TypeMismatch++
+		return
+	}
+	c.body = v.Encode(c.body)
+}
+
+func (c *Collect) Result() zng.Value {
+	if c.typ == nil {
+		return zng.Value{Type: zng.TypeNull}
+	}
+	return zng.Value{Type: c.zctx.LookupTypeArray(c.typ), Bytes: c.body}
+}
+
+func (c *Collect) ConsumePart(p zng.Value) error {
+	if p.Type == zng.TypeNull {
+		return nil
+	}
+	typ, ok := p.Type.(*zng.TypeArray)
+	if !ok {
+		return ErrBadValue
+	}
+	return consumeElements(typ.Type, p.Bytes, c.consumeVal)
+}
+
+func (c *Collect) ResultPart(*resolver.Context) (zng.Value, error) {
+	return c.Result(), nil
+}
+
+// Union gathers the distinct values of a field into a set.  Unset values
+// are skipped, as are values whose type differs from that of the first
+// value.
+type Union struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	zctx     *resolver.Context
+	typ      zng.Type
+	vals     map[string]struct{}
+}
+
+func NewUnion(zctx *resolver.Context, resolver expr.FieldExprResolver) *Union {
+	return &Union{
+		Resolver: resolver,
+		zctx:     zctx,
+		vals:     make(map[string]struct{}),
+	}
+}
+
+func (u *Union) Consume(r *zng.Record) {
+	v := u.Resolver(r)
+	if v.Type == nil {
+		u.FieldNotFound++
+		return
+	}
+	u.consumeVal(v)
+}
+
+func (u *Union) consumeVal(v zng.Value) {
+	if v.Bytes == nil {
+		return
+	}
+	if u.typ == nil {
+		u.typ = v.Type
+	} else if v.Type != u.typ {
+		u.TypeMismatch++
+		return
+	}
+	// The map key is the element's tag and body, which is what
+	// zng.NormalizeSet sorts by.
+	u.vals[string(v.Encode(nil))] = struct{}{}
+}
+
+func (u *Union) Result() zng.Value {
+	if u.typ == nil {
+		return zng.Value{Type: zng.TypeNull}
+	}
+	var body zcode.Bytes
+	for elem := range u.vals {
+		body = append(body, elem...)
+	}
+	return zng.Value{
+		Type:  u.zctx.LookupTypeSet(u.typ),
+		Bytes: zng.NormalizeSet(body),
+	}
+}
+
+func (u *Union) ConsumePart(p zng.Value) error {
+	if p.Type == zng.TypeNull {
+		return nil
+	}
+	typ, ok := p.Type.(*zng.TypeSet)
+	if !ok {
+		return ErrBadValue
+	}
+	return consumeElements(typ.InnerType, p.Bytes, u.consumeVal)
+}
+
+func (u *Union) ResultPart(*resolver.Context) (zng.Value, error) {
+	return u.Result(), nil
+}
+
+func consumeElements(typ zng.Type, body zcode.Bytes, consume func(zng.Value)) error {
+	for it := body.Iter(); !it.Done(); {
+		zv, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		consume(zng.Value{Type: typ, Bytes: zv})
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/brimsec/zq/expr"
 	"github.com/brimsec/zq/reducer"
 	"github.com/brimsec/zq/reducer/field"
+	"github.com/brimsec/zq/zng/resolver"
 )
 
 var (
@@ -21,7 +22,9 @@ type CompiledReducer struct {
 	Instantiate    func() reducer.Interface
 }
 
-func Compile(params ast.Reducer) (CompiledReducer, error) {
+// Compile compiles a reducer whose results, if they are of a container
+// type, will be in the type context zctx.
+func Compile(zctx *resolver.Context, params ast.Reducer) (CompiledReducer, error) {
 	var fld expr.FieldExprResolver
 	if params.Field != nil {
 		var err error
@@ -54,6 +57,40 @@ func Compile(params ast.Reducer) (CompiledReducer, error) {
 		inst = func() reducer.Interface {
 			return reducer.NewCountDistinct(fld)
 		}
+	case "Median":
+		inst = func() reducer.Interface {
+			return &reducer.Percentile{Resolver: fld, P: 50}
+		}
+	case "Percentile":
+		if params.Param < 0 || params.Param > 100 {
+			return CompiledReducer{}, fmt.Errorf("percentile must be between 0 and 100: %g", params.Param)
+		}
+		inst = func() reducer.Interface {
+			return &reducer.Percentile{Resolver: fld, P: params.Param}
+		}
+	case "Quantile":
+		if params.Param < 0 || params.Param > 1 {
+			return CompiledReducer{}, fmt.Errorf("quantile must be between 0 and 1: %g", params.Param)
+		}
+		inst = func() reducer.Interface {
+			return reducer.NewQuantile(fld, params.Param)
+		}
+	case "Collect":
+		inst = func() reducer.Interface {
+			return reducer.NewCollect(zctx, fld)
+		}
+	case "Union":
+		inst = func() reducer.Interface {
+			return reducer.NewUnion(zctx, fld)
+		}
+	case "Var":
+		inst = func() reducer.Interface {
+			return &reducer.Variance{Resolver: fld}
+		}
+	case "Stdev":
+		inst = func() reducer.Interface {
+			return &reducer.Variance{Resolver: fld, Stdev: true}
+		}
 	case "Sum", "Min", "Max":
 		inst = func() reducer.Interface {
 			return &field.FieldReducer{Op: params.Op, Resolver: fld}
@@ -0,0 +1,50 @@
+package reducer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zngnative"
+)
+
+// Percentile computes the exact value at percentile P (from 0 to 100) of
+// a numeric field by linear interpolation between the closest ranks.
+// Since it must hold every value, it is not decomposable.
+type Percentile struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	P        float64
+	values   []float64
+}
+
+func (p *Percentile) Consume(r *zng.Record) {
+	v := p.Resolver(r)
+	if v.Type == nil {
+		p.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(v)
+	if !ok {
+		p.TypeMismatch++
+		return
+	}
+	p.values = append(p.values, d)
+}
+
+func (p *Percentile) Result() zng.Value {
+	n := len(p.values)
+	if n == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	sort.Float64s(p.values)
+	rank := p.P / 100 * float64(n-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	v := p.values[lo] + (p.values[hi]-p.values[lo])*(rank-float64(lo))
+	return zng.NewFloat64(v)
+}
@@ -0,0 +1,155 @@
+package reducer
+
+import (
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/pkg/tdigest"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+// Quantile estimates the value at quantile Q (from 0 to 1) of a numeric
+// field using a t-digest.  Unlike Percentile, it uses bounded memory and
+// is decomposable.
+type Quantile struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	Q        float64
+	digest   *tdigest.TDigest
+}
+
+func NewQuantile(resolver expr.FieldExprResolver, q float64) *Quantile {
+	return &Quantile{
+		Resolver: resolver,
+		Q:        q,
+		digest:   tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (q *Quantile) Consume(r *zng.Record) {
+	v := q.Resolver(r)
+	if v.Type == nil {
+		q.FieldNotFound++
+		return
+	}
+	if v.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(v)
+	if !ok {
+		q.TypeMismatch++
+		return
+	}
+	q.digest.Add(d)
+}
+
+func (q *Quantile) Result() zng.Value {
+	if q.digest.Count() == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	return zng.NewFloat64(q.digest.Quantile(q.Q))
+}
+
+const (
+	meansName   = "means"
+	weightsName = "weights"
+	minName     = "min"
+	maxName     = "max"
+)
+
+func (q *Quantile) ConsumePart(p zng.Value) error {
+	rType, ok := p.Type.(*zng.TypeRecord)
+	if !ok {
+		return ErrBadValue
+	}
+	rec := zng.NewRecord(rType, p.Bytes)
+	means, err := decodeFloat64Array(rec, meansName)
+	if err != nil {
+		return err
+	}
+	weights, err := decodeFloat64Array(rec, weightsName)
+	if err != nil || len(weights) != len(means) {
+		return ErrBadValue
+	}
+	if len(means) == 0 {
+		return nil
+	}
+	min, err := decodeFloat64Field(rec, minName)
+	if err != nil {
+		return err
+	}
+	max, err := decodeFloat64Field(rec, maxName)
+	if err != nil {
+		return err
+	}
+	part := tdigest.New(tdigest.DefaultCompression)
+	for k := range means {
+		part.AddCentroid(tdigest.Centroid{Mean: means[k], Weight: weights[k]})
+	}
+	part.SetBounds(min, max)
+	q.digest.Merge(part)
+	return nil
+}
+
+func (q *Quantile) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	var means, weights zcode.Bytes
+	for _, c := range q.digest.Centroids() {
+		means = zcode.AppendPrimitive(means, zng.EncodeFloat64(c.Mean))
+		weights = zcode.AppendPrimitive(weights, zng.EncodeFloat64(c.Weight))
+	}
+	var zv zcode.Bytes
+	zv = zcode.AppendContainer(zv, means)
+	zv = zcode.AppendContainer(zv, weights)
+	zv = zng.NewFloat64(q.digest.Min()).Encode(zv)
+	zv = zng.NewFloat64(q.digest.Max()).Encode(zv)
+
+	arrayType := zctx.LookupTypeArray(zng.TypeFloat64)
+	cols := []zng.Column{
+		zng.NewColumn(meansName, arrayType),
+		zng.NewColumn(weightsName, arrayType),
+		zng.NewColumn(minName, zng.TypeFloat64),
+		zng.NewColumn(maxName, zng.TypeFloat64),
+	}
+	typ, err := zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: typ, Bytes: zv}, nil
+}
+
+func decodeFloat64Field(rec *zng.Record, field string) (float64, error) {
+	v, err := rec.ValueByField(field)
+	if err != nil || v.Type != zng.TypeFloat64 {
+		return 0, ErrBadValue
+	}
+	f, err := zng.DecodeFloat64(v.Bytes)
+	if err != nil {
+		return 0, ErrBadValue
+	}
+	return f, nil
+}
+
+func decodeFloat64Array(rec *zng.Record, field string) ([]float64, error) {
+	v, err := rec.ValueByField(field)
+	if err != nil {
+		return nil, ErrBadValue
+	}
+	typ, ok := v.Type.(*zng.TypeArray)
+	if !ok || typ.Type != zng.TypeFloat64 {
+		return nil, ErrBadValue
+	}
+	var out []float64
+	for it := v.Bytes.Iter(); !it.Done(); {
+		zv, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		f, err := zng.DecodeFloat64(zv)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
@@ -60,7 +60,7 @@ func TestDecomposableReducers(t *testing.T) {
 	recs := b.Records()
 
 	makeReducer := func(op, field string) compile.CompiledReducer {
-		cred, err := compile.Compile(ast.Reducer{
+		cred, err := compile.Compile(resolver, ast.Reducer{
 			Node: ast.Node{Op: op},
 			Var:  strings.ToLower(op),
 			Field: &ast.FieldRead{
@@ -126,6 +126,46 @@ func TestDecomposableReducers(t *testing.T) {
 			require.Equal(t, f, int64(10))
 		}
 	})
+	t.Run("quantile", func(t *testing.T) {
+		cred, err := compile.Compile(resolver, ast.Reducer{
+			Node:  ast.Node{Op: "Quantile"},
+			Var:   "quantile",
+			Field: &ast.FieldRead{Node: ast.Node{Op: "FieldRead"}, Field: "n"},
+			Param: 0.5,
+		})
+		require.NoError(t, err)
+		for i := 0; i <= len(recs); i++ {
+			res := runOne(t, resolver, cred, i, recs)
+			f, err := zng.DecodeFloat64(res.Bytes)
+			require.NoError(t, err)
+			require.Equal(t, f, 5.)
+		}
+	})
+	t.Run("var", func(t *testing.T) {
+		cred := makeReducer("Var", "n")
+		for i := 0; i <= len(recs); i++ {
+			res := runOne(t, resolver, cred, i, recs)
+			f, err := zng.DecodeFloat64(res.Bytes)
+			require.NoError(t, err)
+			require.InDelta(t, 50./3, f, 1e-9)
+		}
+	})
+	t.Run("collect", func(t *testing.T) {
+		cred := makeReducer("Collect", "n")
+		for i := 0; i <= len(recs); i++ {
+			res := runOne(t, resolver, cred, i, recs)
+			require.Equal(t, "array[int32]", res.Type.String())
+			require.Equal(t, "[0;5;10;]", res.Format(zng.OutFormatZNG))
+		}
+	})
+	t.Run("union", func(t *testing.T) {
+		cred := makeReducer("Union", "n")
+		for i := 0; i <= len(recs); i++ {
+			res := runOne(t, resolver, cred, i, append(recs, recs...))
+			require.Equal(t, "set[int32]", res.Type.String())
+			require.Equal(t, "[0;5;10;]", res.Format(zng.OutFormatZNG))
+		}
+	})
 	t.Run("field-sum", func(t *testing.T) {
 		cred := makeReducer("Sum", "n")
 		for i := 0; i <= len(recs); i++ {
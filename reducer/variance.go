@@ -0,0 +1,112 @@
+package reducer
+
+import (
+	"math"
+
+	"github.com/brimsec/zq/expr"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zngnative"
+)
+
+// Variance computes the population variance of a numeric field or, if
+// Stdev is true, its population standard deviation.  The running mean and
+// sum of squared deviations are maintained with Welford's algorithm.
+type Variance struct {
+	Reducer
+	Resolver expr.FieldExprResolver
+	Stdev    bool
+	count    uint64
+	mean     float64
+	m2       float64
+}
+
+func (v *Variance) Consume(r *zng.Record) {
+	val := v.Resolver(r)
+	if val.Type == nil {
+		v.FieldNotFound++
+		return
+	}
+	if val.Bytes == nil {
+		return
+	}
+	d, ok := zngnative.CoerceToFloat64(val)
+	if !ok {
+		v.TypeMismatch++
+		return
+	}
+	v.count++
+	delta := d - v.mean
+	v.mean += delta / float64(v.count)
+	v.m2 += delta * (d - v.mean)
+}
+
+func (v *Variance) Result() zng.Value {
+	if v.count == 0 {
+		return zng.Value{Type: zng.TypeFloat64}
+	}
+	variance := v.m2 / float64(v.count)
+	if v.Stdev {
+		return zng.NewFloat64(math.Sqrt(variance))
+	}
+	return zng.NewFloat64(variance)
+}
+
+const (
+	meanName = "mean"
+	m2Name   = "m2"
+)
+
+func (v *Variance) ConsumePart(p zng.Value) error {
+	rType, ok := p.Type.(*zng.TypeRecord)
+	if !ok {
+		return ErrBadValue
+	}
+	rec := zng.NewRecord(rType, p.Bytes)
+	countVal, err := rec.ValueByField(countName)
+	if err != nil || countVal.Type != zng.TypeUint64 {
+		return ErrBadValue
+	}
+	count, err := zng.DecodeUint(countVal.Bytes)
+	if err != nil {
+		return ErrBadValue
+	}
+	mean, err := decodeFloat64Field(rec, meanName)
+	if err != nil {
+		return err
+	}
+	m2, err := decodeFloat64Field(rec, m2Name)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	// Combine the partial results with the parallel algorithm of
+	// Chan et al.
+	n := v.count + count
+	delta := mean - v.mean
+	v.m2 += m2 + delta*delta*float64(v.count)*float64(count)/float64(n)
+	v.mean += delta * float64(count) / float64(n)
+	v.count = n
+	return nil
+}
+
+func (v *Variance) ResultPart(zctx *resolver.Context) (zng.Value, error) {
+	var zv zcode.Bytes
+	zv = zng.NewUint64(v.count).Encode(zv)
+	zv = zng.NewFloat64(v.mean).Encode(zv)
+	zv = zng.NewFloat64(v.m2).Encode(zv)
+
+	cols := []zng.Column{
+		zng.NewColumn(countName, zng.TypeUint64),
+		zng.NewColumn(meanName, zng.TypeFloat64),
+		zng.NewColumn(m2Name, zng.TypeFloat64),
+	}
+	typ, err := zctx.LookupTypeRecord(cols)
+	if err != nil {
+		return zng.Value{}, err
+	}
+	return zng.Value{Type: typ, Bytes: zv}, nil
+}
@@ -0,0 +1,74 @@
+package scanner
+
+import (
+	"context"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+)
+
+// NewSequenceScanner returns a Scanner of the records of the readers
+// returned by next, in turn, filtered by f, filterExpr, and s.  next
+// returns a nil reader when there are no more and may block until the next
+// reader is available.  A batch never spans two readers, so the records of
+// a reader are pulled as soon as it is exhausted, even if next then blocks.
+func NewSequenceScanner(ctx context.Context, next func() (zbuf.Reader, error), f filter.Filter, filterExpr ast.BooleanExpr, s nano.Span) Scanner {
+	return &sequenceScanner{
+		ctx:        ctx,
+		next:       next,
+		filter:     f,
+		filterExpr: filterExpr,
+		span:       s,
+	}
+}
+
+type sequenceScanner struct {
+	ctx        context.Context
+	next       func() (zbuf.Reader, error)
+	filter     filter.Filter
+	filterExpr ast.BooleanExpr
+	span       nano.Span
+
+	mu    sync.Mutex // protects below
+	cur   Scanner
+	stats ScannerStats
+}
+
+func (s *sequenceScanner) Pull() (zbuf.Batch, error) {
+	for {
+		if s.cur == nil {
+			r, err := s.next()
+			if r == nil || err != nil {
+				return nil, err
+			}
+			sc, err := NewScanner(s.ctx, r, s.filter, s.filterExpr, s.span)
+			if err != nil {
+				return nil, err
+			}
+			s.mu.Lock()
+			s.cur = sc
+			s.mu.Unlock()
+		}
+		batch, err := s.cur.Pull()
+		if batch != nil || err != nil {
+			return batch, err
+		}
+		s.mu.Lock()
+		s.stats.Accumulate(s.cur.Stats())
+		s.cur = nil
+		s.mu.Unlock()
+	}
+}
+
+func (s *sequenceScanner) Stats() *ScannerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.stats
+	if s.cur != nil {
+		stats.Accumulate(s.cur.Stats())
+	}
+	return &stats
+}
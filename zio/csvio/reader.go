@@ -0,0 +1,223 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/brimsec/zq/pkg/byteconv"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zio/zeekio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// Reader reads comma- or tab-separated values.  The first line of input is
+// a header naming the columns and each subsequent line is a record.  The
+// type of each column is inferred from its value in the first record:
+// numbers are float64, "true" and "false" are bool, RFC 3339 timestamps
+// are time, and everything else, including an empty value or a number
+// with a leading zero such as a ZIP code, is string.  A column becomes a
+// string column from the first record holding a value that is not of its
+// inferred type on.  An empty value is an empty string in a string column
+// and unset in any other column.  Dotted column names, as written by
+// Writer, are turned back into nested records.
+type Reader struct {
+	reader  *csv.Reader
+	zctx    *resolver.Context
+	header  []string
+	typ     *zng.TypeRecord
+	types   []zng.Type
+	vals    []zng.Value
+	builder zcode.Builder
+}
+
+func NewReader(r io.Reader, zctx *resolver.Context) *Reader {
+	return newReader(r, ',', zctx)
+}
+
+func NewTSVReader(r io.Reader, zctx *resolver.Context) *Reader {
+	return newReader(r, '\t', zctx)
+}
+
+func newReader(r io.Reader, comma rune, zctx *resolver.Context) *Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.ReuseRecord = true
+	return &Reader{
+		reader: reader,
+		zctx:   zctx,
+	}
+}
+
+func (r *Reader) Read() (*zng.Record, error) {
+	if r.header == nil {
+		fields, err := r.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if err := r.setHeader(fields); err != nil {
+			return nil, err
+		}
+	}
+	fields, err := r.reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if r.typ == nil {
+		if err := r.setType(fields); err != nil {
+			return nil, err
+		}
+	}
+	r.vals = r.vals[:0]
+	var retype bool
+	for k, field := range fields {
+		v, ok := parseValue(r.types[k], []byte(field))
+		if !ok {
+			r.types[k] = zng.TypeString
+			v, _ = parseValue(zng.TypeString, []byte(field))
+			retype = true
+		}
+		r.vals = append(r.vals, v)
+	}
+	if retype {
+		if err := r.setRecordType(); err != nil {
+			return nil, err
+		}
+	}
+	r.builder.Reset()
+	appendRecord(&r.builder, r.typ.Columns, r.vals)
+	return zng.NewVolatileRecord(r.typ, r.builder.Bytes()), nil
+}
+
+func (r *Reader) setHeader(fields []string) error {
+	seen := make(map[string]struct{})
+	for _, field := range fields {
+		if field == "" {
+			return errors.New("empty column name in header")
+		}
+		if _, ok := seen[field]; ok {
+			return fmt.Errorf("duplicate column name in header: %q", field)
+		}
+		seen[field] = struct{}{}
+	}
+	r.header = append([]string{}, fields...)
+	return nil
+}
+
+// setType infers the type of each column from its value in fields, the
+// first record, and sets the record type.
+func (r *Reader) setType(fields []string) error {
+	for _, field := range fields {
+		r.types = append(r.types, inferType([]byte(field)))
+	}
+	return r.setRecordType()
+}
+
+// setRecordType sets the record type from the column types, unflattening
+// dotted column names into nested records.
+func (r *Reader) setRecordType() error {
+	cols := make([]zng.Column, 0, len(r.types))
+	for k, typ := range r.types {
+		cols = append(cols, zng.NewColumn(r.header[k], typ))
+	}
+	cols, _, err := zeekio.Unflatten(r.zctx, cols, false)
+	if err != nil {
+		return err
+	}
+	r.typ, err = r.zctx.LookupTypeRecord(cols)
+	return err
+}
+
+// appendRecord appends the leaf values in vals to b according to the
+// nesting of the columns and returns the values not consumed.
+func appendRecord(b *zcode.Builder, cols []zng.Column, vals []zng.Value) []zng.Value {
+	for _, col := range cols {
+		if recType, ok := col.Type.(*zng.TypeRecord); ok {
+			b.BeginContainer()
+			vals = appendRecord(b, recType.Columns, vals)
+			b.EndContainer()
+			continue
+		}
+		b.AppendPrimitive(vals[0].Bytes)
+		vals = vals[1:]
+	}
+	return vals
+}
+
+func inferType(b []byte) zng.Type {
+	switch {
+	case string(b) == "true", string(b) == "false":
+		return zng.TypeBool
+	case isNumber(b):
+		if _, err := byteconv.ParseFloat64(b); err == nil {
+			return zng.TypeFloat64
+		}
+	}
+	if _, err := nano.ParseRFC3339Nano(b); err == nil {
+		return zng.TypeTime
+	}
+	return zng.TypeString
+}
+
+// parseValue parses b as a value of typ, which was returned by inferType,
+// returning false if b is not of that type.
+func parseValue(typ zng.Type, b []byte) (zng.Value, bool) {
+	if typ == zng.TypeString {
+		return zng.Value{zng.TypeString, zng.EncodeString(string(b))}, true
+	}
+	if len(b) == 0 {
+		return zng.Value{typ, nil}, true
+	}
+	switch typ {
+	case zng.TypeBool:
+		switch string(b) {
+		case "true":
+			return zng.NewBool(true), true
+		case "false":
+			return zng.NewBool(false), true
+		}
+	case zng.TypeFloat64:
+		if isNumber(b) {
+			if f, err := byteconv.ParseFloat64(b); err == nil {
+				return zng.NewFloat64(f), true
+			}
+		}
+	case zng.TypeTime:
+		if ts, err := nano.ParseRFC3339Nano(b); err == nil {
+			return zng.NewTime(ts), true
+		}
+	}
+	return zng.Value{}, false
+}
+
+// isNumber returns true if b looks like a decimal number so that words
+// such as "Inf" and "NaN" are not inferred as float64.  A number with a
+// leading zero, such as "01234", is not a number since the zero would be
+// lost.
+func isNumber(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	digits := b
+	if digits[0] == '-' || digits[0] == '+' {
+		digits = digits[1:]
+	}
+	if len(digits) > 1 && digits[0] == '0' && digits[1] >= '0' && digits[1] <= '9' {
+		return false
+	}
+	for _, c := range b {
+		if (c < '0' || c > '9') && c != '.' && c != '-' && c != '+' && c != 'e' && c != 'E' {
+			return false
+		}
+	}
+	return true
+}
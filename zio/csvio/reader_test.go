@@ -0,0 +1,103 @@
+package csvio
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trim(s string) string {
+	return strings.TrimLeft(s, "\n")
+}
+
+func readTzng(input string) (string, error) {
+	var out strings.Builder
+	r := NewReader(strings.NewReader(trim(input)), resolver.NewContext())
+	err := zbuf.Copy(tzngio.NewWriter(&out), r)
+	return out.String(), err
+}
+
+func TestReaderColumnTypes(t *testing.T) {
+	const input = `
+f,b,t,s
+1,true,1970-01-01T00:00:01Z,a
+2.5,false,1970-01-01T00:00:02Z,b
+`
+	const expected = `
+#0:record[f:float64,b:bool,t:time,s:string]
+0:[1;T;1;a;]
+0:[2.5;F;2;b;]
+`
+	out, err := readTzng(input)
+	require.NoError(t, err)
+	assert.Equal(t, trim(expected), out)
+}
+
+func TestReaderEmptyValues(t *testing.T) {
+	// An empty value is an empty string in a string column and unset in
+	// any other column.  An empty value in the first record makes its
+	// column a string column.
+	const input = `
+s,f,e
+a,1,
+,,x
+`
+	const expected = `
+#0:record[s:string,f:float64,e:string]
+0:[a;1;;]
+0:[;-;x;]
+`
+	out, err := readTzng(input)
+	require.NoError(t, err)
+	assert.Equal(t, trim(expected), out)
+}
+
+func TestReaderLeadingZeros(t *testing.T) {
+	const input = `
+zip,n,neg,z
+01234,0.5,-007,0
+98765,10,-1,1
+`
+	const expected = `
+#0:record[zip:string,n:float64,neg:string,z:float64]
+0:[01234;0.5;-007;0;]
+0:[98765;10;-1;1;]
+`
+	out, err := readTzng(input)
+	require.NoError(t, err)
+	assert.Equal(t, trim(expected), out)
+}
+
+func TestReaderTypeMismatch(t *testing.T) {
+	// A value that is not of the type inferred for its column from the
+	// first record makes the column a string column from then on.
+	const input = `
+n,b
+1,true
+two,false
+3,x
+`
+	const expected = `
+#0:record[n:float64,b:bool]
+0:[1;T;]
+#1:record[n:string,b:bool]
+1:[two;F;]
+#2:record[n:string,b:string]
+2:[3;x;]
+`
+	out, err := readTzng(input)
+	require.NoError(t, err)
+	assert.Equal(t, trim(expected), out)
+}
+
+func TestReaderHeader(t *testing.T) {
+	_, err := readTzng("a,a\n1,2")
+	assert.EqualError(t, err, `duplicate column name in header: "a"`)
+	_, err = readTzng("a,\n1,2")
+	assert.EqualError(t, err, "empty column name in header")
+}
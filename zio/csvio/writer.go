@@ -0,0 +1,100 @@
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zeekio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+var ErrMultipleTypes = errors.New("csv output requires records of a single type (use cut to select common fields)")
+
+// Writer writes records as comma- or tab-separated values preceded by a
+// header line of column names.  Nested records are flattened into columns
+// named "outer.inner" and unset values are written as empty fields.
+type Writer struct {
+	writer     *csv.Writer
+	flattener  *zeekio.Flattener
+	typ        *zng.TypeRecord
+	epochDates bool
+	fields     []string
+}
+
+func NewWriter(w io.Writer, flags zio.WriterFlags) *Writer {
+	return newWriter(w, ',', flags)
+}
+
+func NewTSVWriter(w io.Writer, flags zio.WriterFlags) *Writer {
+	return newWriter(w, '\t', flags)
+}
+
+func newWriter(w io.Writer, comma rune, flags zio.WriterFlags) *Writer {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return &Writer{
+		writer:     writer,
+		flattener:  zeekio.NewFlattener(resolver.NewContext()),
+		epochDates: flags.EpochDates,
+	}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	if rec.Type != w.typ {
+		if w.typ != nil {
+			return ErrMultipleTypes
+		}
+		w.typ = rec.Type
+		w.fields = make([]string, len(rec.Type.Columns))
+		for k, col := range rec.Type.Columns {
+			w.fields[k] = col.Name
+		}
+		if err := w.writer.Write(w.fields); err != nil {
+			return err
+		}
+	}
+	for k := range rec.Type.Columns {
+		s, err := w.formatValue(rec.Value(k))
+		if err != nil {
+			return err
+		}
+		w.fields[k] = s
+	}
+	return w.writer.Write(w.fields)
+}
+
+func (w *Writer) formatValue(v zng.Value) (string, error) {
+	if v.Bytes == nil {
+		return "", nil
+	}
+	switch zng.AliasedType(v.Type).ID() {
+	case zng.IdString, zng.IdBstring, zng.IdEnum:
+		// The CSV encoding takes care of quoting so strings are
+		// written verbatim.
+		return string(v.Bytes), nil
+	case zng.IdTime:
+		if w.epochDates {
+			break
+		}
+		ts, err := zng.DecodeTime(v.Bytes)
+		if err != nil {
+			return "", err
+		}
+		return nano.Ts(ts).Time().UTC().Format(time.RFC3339Nano), nil
+	}
+	return v.Format(zng.OutFormatUnescaped), nil
+}
+
+func (w *Writer) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
@@ -29,6 +29,7 @@ type OpenConfig struct {
 	Format         string
 	JSONTypeConfig *ndjsonio.TypeConfig
 	JSONPathRegex  string
+	JSONMaps       bool
 	AwsCfg         *aws.Config
 	ZngCheck       bool
 }
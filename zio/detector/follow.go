@@ -0,0 +1,194 @@
+package detector
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/ctxio"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// FollowInterval is how often a followed file is checked for new data
+// once all of its data has been read.
+var FollowInterval = 250 * time.Millisecond
+
+// OpenFollowFile is like OpenFile but, like tail -f, follows the local
+// file at path as data is appended to it.  Reads of the returned file wait
+// for more data at the end of the file until ctx is canceled.
+func OpenFollowFile(ctx context.Context, zctx *resolver.Context, path string, cfg OpenConfig) (*zbuf.File, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	rc := struct {
+		io.Reader
+		io.Closer
+	}{ctxio.NewTailReader(ctx, f, FollowInterval), f}
+	return OpenFromNamedReadCloser(zctx, rc, path, cfg)
+}
+
+type result struct {
+	rec *zng.Record
+	err error
+}
+
+// interleaver reads its readers concurrently and returns their records
+// in the order they are read.
+type interleaver struct {
+	readers   []zbuf.Reader
+	once      sync.Once
+	closeOnce sync.Once
+	ch        chan result
+	done      chan struct{}
+	err       error
+}
+
+var _ zbuf.ReadCloser = (*interleaver)(nil)
+var _ scanner.ScannerAble = (*interleaver)(nil)
+
+// Interleave returns a zbuf.ReadCloser of the records of readers, which
+// are read concurrently, in the order in which they are read.  Unlike a
+// zbuf.Combiner, a reader that is waiting for data (as when following a
+// file) does not hold back the records of the others.  Once all of the
+// readers have reached end of stream, Read will return end of stream.
+func Interleave(readers []zbuf.Reader) zbuf.ReadCloser {
+	return &interleaver{
+		readers: readers,
+		ch:      make(chan result),
+		done:    make(chan struct{}),
+	}
+}
+
+func (i *interleaver) start() {
+	var wg sync.WaitGroup
+	for _, r := range i.readers {
+		wg.Add(1)
+		go func(r zbuf.Reader) {
+			defer wg.Done()
+			i.run(r)
+		}(r)
+	}
+	go func() {
+		wg.Wait()
+		close(i.ch)
+	}()
+}
+
+func (i *interleaver) run(r zbuf.Reader) {
+	if closer, ok := r.(io.Closer); ok {
+		defer closer.Close()
+	}
+	for {
+		rec, err := r.Read()
+		if rec != nil {
+			rec = rec.Keep()
+		}
+		select {
+		case i.ch <- result{rec, err}:
+		case <-i.done:
+			return
+		}
+		if rec == nil || err != nil {
+			return
+		}
+	}
+}
+
+// recv returns the next record read, or nil at end of stream.  If block
+// is false and no record is ready, recv returns nil and ok is false.
+func (i *interleaver) recv(block bool) (rec *zng.Record, ok bool, err error) {
+	i.once.Do(i.start)
+	for i.err == nil {
+		var res result
+		var open bool
+		if block {
+			res, open = <-i.ch
+		} else {
+			select {
+			case res, open = <-i.ch:
+			default:
+				return nil, false, nil
+			}
+		}
+		if !open {
+			return nil, true, nil
+		}
+		if res.err != nil {
+			i.err = res.err
+			break
+		}
+		if res.rec != nil {
+			return res.rec, true, nil
+		}
+		// One of the readers is done.
+	}
+	return nil, true, i.err
+}
+
+func (i *interleaver) Read() (*zng.Record, error) {
+	rec, _, err := i.recv(true)
+	return rec, err
+}
+
+// nextBatch returns a reader of the records that are ready, waiting for
+// one if there are none.
+func (i *interleaver) nextBatch() (zbuf.Reader, error) {
+	rec, _, err := i.recv(true)
+	if rec == nil || err != nil {
+		return nil, err
+	}
+	recs := []*zng.Record{rec}
+	for len(recs) < scanner.BatchSize {
+		// Any error is returned by the next call to recv.
+		rec, ok, err := i.recv(false)
+		if !ok || rec == nil || err != nil {
+			break
+		}
+		recs = append(recs, rec)
+	}
+	return &recordReader{recs}, nil
+}
+
+type recordReader struct {
+	recs []*zng.Record
+}
+
+func (r *recordReader) Read() (*zng.Record, error) {
+	if len(r.recs) == 0 {
+		return nil, nil
+	}
+	rec := r.recs[0]
+	r.recs = r.recs[1:]
+	return rec, nil
+}
+
+func (i *interleaver) NewScanner(ctx context.Context, f filter.Filter, filterExpr ast.BooleanExpr, s nano.Span) (scanner.Scanner, error) {
+	return scanner.NewSequenceScanner(ctx, i.nextBatch, f, filterExpr, s), nil
+}
+
+// Close stops reading and closes the readers implementing io.Closer.
+func (i *interleaver) Close() error {
+	i.closeOnce.Do(func() {
+		started := true
+		i.once.Do(func() { started = false })
+		if started {
+			close(i.done)
+			return
+		}
+		for _, r := range i.readers {
+			if closer, ok := r.(io.Closer); ok {
+				closer.Close()
+			}
+		}
+	})
+	return nil
+}
@@ -0,0 +1,58 @@
+package detector
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowFiles(t *testing.T) {
+	FollowInterval = time.Millisecond
+	path1 := writeTemp(t, []byte("#0:record[v:int32,ts:time]\n0:[1;1;]\n"))
+	defer os.Remove(path1)
+	path2 := writeTemp(t, []byte("#0:record[v:int32,ts:time]\n0:[2;2;]\n"))
+	defer os.Remove(path2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	zctx := resolver.NewContext()
+	var readers []zbuf.Reader
+	for _, path := range []string{path1, path2} {
+		f, err := OpenFollowFile(ctx, zctx, path, OpenConfig{})
+		require.NoError(t, err)
+		readers = append(readers, f)
+	}
+	r := Interleave(readers)
+	defer r.Close()
+
+	readValues := func(n int) map[int64]bool {
+		vals := make(map[int64]bool)
+		for i := 0; i < n; i++ {
+			rec, err := r.Read()
+			require.NoError(t, err)
+			require.NotNil(t, rec)
+			v, err := rec.AccessInt("v")
+			require.NoError(t, err)
+			vals[v] = true
+		}
+		return vals
+	}
+	require.Equal(t, map[int64]bool{1: true, 2: true}, readValues(2))
+
+	f, err := os.OpenFile(path2, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString("0:[3;3;]\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, map[int64]bool{3: true}, readValues(1))
+
+	cancel()
+	rec, err := r.Read()
+	require.NoError(t, err)
+	require.Nil(t, rec)
+}
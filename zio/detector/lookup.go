@@ -6,7 +6,9 @@ import (
 
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/parquetio"
 	"github.com/brimsec/zq/zio/tableio"
 	"github.com/brimsec/zq/zio/textio"
 	"github.com/brimsec/zq/zio/tzngio"
@@ -48,6 +50,12 @@ func LookupWriter(w io.WriteCloser, wflags *zio.WriterFlags) *zio.Writer {
 		f = zbuf.NopFlusher(textio.NewWriter(w, flags))
 	case "table":
 		f = tableio.NewWriter(w, flags)
+	case "csv":
+		f = csvio.NewWriter(w, flags)
+	case "tsv":
+		f = csvio.NewTSVWriter(w, flags)
+	case "parquet":
+		f = parquetio.NewWriter(w)
 	}
 	return &zio.Writer{
 		WriteFlusher: f,
@@ -62,11 +70,26 @@ func lookupReader(r io.Reader, zctx *resolver.Context, path string, cfg OpenConf
 	case "zeek":
 		return zeekio.NewReader(r, zctx)
 	case "ndjson":
-		return ndjsonio.NewReader(r, zctx, cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
+		return newNDJSONReader(r, zctx, path, cfg)
 	case "zjson":
 		return zjsonio.NewReader(r, zctx), nil
 	case "zng":
 		return zngio.NewReaderWithOpts(r, zctx, zngio.ReaderOpts{Check: cfg.ZngCheck}), nil
+	case "csv":
+		return csvio.NewReader(r, zctx), nil
+	case "tsv":
+		return csvio.NewTSVReader(r, zctx), nil
 	}
 	return nil, fmt.Errorf("no such reader type: \"%s\"", cfg.Format)
 }
+
+func newNDJSONReader(r io.Reader, zctx *resolver.Context, path string, cfg OpenConfig) (*ndjsonio.Reader, error) {
+	nr, err := ndjsonio.NewReader(r, zctx, cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.JSONMaps {
+		nr.InferMaps()
+	}
+	return nr, nil
+}
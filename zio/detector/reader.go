@@ -6,7 +6,7 @@ import (
 	"io"
 
 	"github.com/brimsec/zq/zbuf"
-	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zio/csvio"
 	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zio/zeekio"
 	"github.com/brimsec/zq/zio/zjsonio"
@@ -43,13 +43,13 @@ func NewReaderWithConfig(r io.Reader, zctx *resolver.Context, path string, cfg O
 	track.Reset()
 
 	// ndjson must come after zjson since zjson is a subset of ndjson
-	nr, err := ndjsonio.NewReader(track, resolver.NewContext(), cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
+	nr, err := newNDJSONReader(track, resolver.NewContext(), path, cfg)
 	if err != nil {
 		return nil, err
 	}
 	ndjsonErr := match(nr, "ndjson")
 	if ndjsonErr == nil {
-		return ndjsonio.NewReader(recorder, zctx, cfg.JSONTypeConfig, cfg.JSONPathRegex, path)
+		return newNDJSONReader(recorder, zctx, path, cfg)
 	}
 	track.Reset()
 
@@ -57,8 +57,21 @@ func NewReaderWithConfig(r io.Reader, zctx *resolver.Context, path string, cfg O
 	if zngErr == nil {
 		return zngio.NewReaderWithOpts(recorder, zctx, zngio.ReaderOpts{Check: cfg.ZngCheck}), nil
 	}
+	track.Reset()
+
+	// csv and tsv come last since almost any text looks like one of them
+	csvErr := matchSeparated(csvio.NewReader(track, resolver.NewContext()), "csv")
+	if csvErr == nil {
+		return csvio.NewReader(recorder, zctx), nil
+	}
+	track.Reset()
+
+	tsvErr := matchSeparated(csvio.NewTSVReader(track, resolver.NewContext()), "tsv")
+	if tsvErr == nil {
+		return csvio.NewTSVReader(recorder, zctx), nil
+	}
 	parquetErr := errors.New("parquet: auto-detection not supported")
-	return nil, joinErrs([]error{tzngErr, zeekErr, ndjsonErr, zjsonErr, zngErr, parquetErr})
+	return nil, joinErrs([]error{tzngErr, zeekErr, ndjsonErr, zjsonErr, zngErr, csvErr, tsvErr, parquetErr})
 }
 
 func NewReader(r io.Reader, zctx *resolver.Context) (zbuf.Reader, error) {
@@ -79,3 +92,17 @@ func match(r zbuf.Reader, name string) error {
 	}
 	return nil
 }
+
+// matchSeparated is like match but additionally requires a record with at
+// least two columns so that arbitrary lines of text are not mistaken for
+// single-column CSV or TSV.
+func matchSeparated(r zbuf.Reader, name string) error {
+	rec, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	if rec == nil || len(zeekio.FlattenColumns(rec.Type.Columns)) < 2 {
+		return fmt.Errorf("%s: no records with at least two columns", name)
+	}
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 
 type inferParser struct {
 	zctx *resolver.Context
+	// maps causes nested objects to be parsed as maps rather than records.
+	maps bool
 }
 
 func (p *inferParser) parseObject(b []byte) (zng.Value, error) {
@@ -79,6 +81,9 @@ func (p *inferParser) parseValue(raw []byte, typ jsonparser.ValueType) (zng.Valu
 	case jsonparser.Array:
 		return p.parseArray(raw)
 	case jsonparser.Object:
+		if p.maps {
+			return p.parseMap(raw)
+		}
 		return p.parseObject(raw)
 	case jsonparser.Boolean:
 		return p.parseBool(raw)
@@ -176,6 +181,57 @@ func (p *inferParser) parseArray(raw []byte) (zng.Value, error) {
 	return zng.Value{typ, encodeContainer(vals)}, nil
 }
 
+// parseMap parses a JSON object into a map from string to the type of the
+// object's values, or to a union of those types if they differ.
+func (p *inferParser) parseMap(raw []byte) (zng.Value, error) {
+	var keys []zng.Value
+	var vals []zng.Value
+	err := jsonparser.ObjectEach(raw, func(key []byte, value []byte, typ jsonparser.ValueType, offset int) error {
+		k, err := p.parseString(key)
+		if err != nil {
+			return err
+		}
+		v, err := p.parseValue(value, typ)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, k)
+		vals = append(vals, v)
+		return nil
+	})
+	if err != nil {
+		return zng.Value{}, err
+	}
+	var b zcode.Bytes
+	var typ *zng.TypeMap
+	if union := p.unionType(vals); union != nil {
+		typ = p.zctx.LookupTypeMap(zng.TypeString, union)
+		b = zcode.Bytes{}
+		elems := encodeUnionArray(union, vals)
+		it := elems.Iter()
+		for _, k := range keys {
+			elem, _, err := it.Next()
+			if err != nil {
+				return zng.Value{}, err
+			}
+			b = zcode.AppendPrimitive(b, k.Bytes)
+			b = zcode.AppendContainer(b, elem)
+		}
+	} else {
+		valType := zng.Type(zng.TypeString)
+		if len(vals) > 0 {
+			valType = vals[0].Type
+		}
+		typ = p.zctx.LookupTypeMap(zng.TypeString, valType)
+		var pairs []zng.Value
+		for i := range keys {
+			pairs = append(pairs, keys[i], vals[i])
+		}
+		b = encodeContainer(pairs)
+	}
+	return zng.Value{typ, zng.NormalizeMap(b)}, nil
+}
+
 func (p *inferParser) parseBool(b []byte) (zng.Value, error) {
 	boolean, err := jsonparser.GetBoolean(b)
 	if err != nil {
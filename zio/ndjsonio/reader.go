@@ -45,7 +45,7 @@ func NewReader(reader io.Reader, zctx *resolver.Context, tc *TypeConfig, JSONPat
 	r := &Reader{
 		scanner: scanner,
 		stats:   ReadStats{Stats: &scanner.Stats, typeStats: &typeStats{}},
-		inf:     inferParser{zctx},
+		inf:     inferParser{zctx: zctx},
 		zctx:    zctx,
 	}
 	if tc != nil {
@@ -63,6 +63,14 @@ func NewReader(reader io.Reader, zctx *resolver.Context, tc *TypeConfig, JSONPat
 	return r, nil
 }
 
+// InferMaps causes the reader to infer nested JSON objects as ZNG maps from
+// string to value rather than as records.  This avoids creating a new record
+// type for every distinct set of keys when objects have dynamic keys (e.g.,
+// HTTP headers).  It has no effect on objects parsed with a TypeConfig.
+func (r *Reader) InferMaps() {
+	r.inf.maps = true
+}
+
 // typeRules is used internally and is derived from TypeConfig by
 // converting its descriptors into *zng.TypeRecord s for use by the
 // ndjson typed parser.
@@ -41,8 +41,19 @@ const (
 	timestampMicroseconds
 	timestampNanoseconds
 
+	// annotated int32s
+	annotatedInt8
+	annotatedInt16
+	annotatedInt32
+	annotatedUint8
+	annotatedUint16
+	annotatedUint32
+
+	// annotated int64s
+	annotatedInt64
+	annotatedUint64
+
 	// XXX INTERVAL
-	// XXX INT_*, UINT_* types
 
 	// composite types
 	list
@@ -63,6 +74,22 @@ func lookupPrimitiveType(typ *parquet.Type, cType *parquet.ConvertedType) (Handl
 			return timestampMilliseconds, nil
 		case parquet.ConvertedType_TIMESTAMP_MICROS:
 			return timestampMicroseconds, nil
+		case parquet.ConvertedType_INT_8:
+			return annotatedInt8, nil
+		case parquet.ConvertedType_INT_16:
+			return annotatedInt16, nil
+		case parquet.ConvertedType_INT_32:
+			return annotatedInt32, nil
+		case parquet.ConvertedType_INT_64:
+			return annotatedInt64, nil
+		case parquet.ConvertedType_UINT_8:
+			return annotatedUint8, nil
+		case parquet.ConvertedType_UINT_16:
+			return annotatedUint16, nil
+		case parquet.ConvertedType_UINT_32:
+			return annotatedUint32, nil
+		case parquet.ConvertedType_UINT_64:
+			return annotatedUint64, nil
 
 		// XXX case parquet.ConvertedType_INTERVAL:
 
@@ -99,19 +126,30 @@ func simpleParquetTypeToZngType(typ HandledType) zng.Type {
 	switch typ {
 	case boolean:
 		return zng.TypeBool
-	case tint32:
+	case tint32, annotatedInt32:
 		return zng.TypeInt32
-	case tint64:
+	case tint64, annotatedInt64:
 		return zng.TypeInt64
+	// ZNG has no signed 8-bit type so INT_8 is widened.
+	case annotatedInt8, annotatedInt16:
+		return zng.TypeInt16
+	case annotatedUint8:
+		return zng.TypeByte
+	case annotatedUint16:
+		return zng.TypeUint16
+	case annotatedUint32:
+		return zng.TypeUint32
+	case annotatedUint64:
+		return zng.TypeUint64
 	case float, double:
 		return zng.TypeFloat64
-	case byteArray:
-		return zng.TypeBstring
+	case byteArray, bson:
+		return zng.TypeBytes
 
-	case utf8, enum, json:
+	case utf8, json:
 		return zng.TypeString
-	case bson:
-		return zng.TypeBstring
+	case enum:
+		return zng.TypeEnum
 
 	case timestampMilliseconds, timestampMicroseconds, timestampNanoseconds:
 		return zng.TypeTime
@@ -231,6 +269,7 @@ func (r *Reader) buildColumns(opts ReaderOpts) error {
 	var columns []column
 	for i := 1; i < len(schema); {
 		n := 1
+		name := schema[i].Name
 		var col column
 		var err error
 		if schema[i].NumChildren != nil {
@@ -247,7 +286,7 @@ func (r *Reader) buildColumns(opts ReaderOpts) error {
 			if opts.IgnoreUnhandledColumns {
 				continue
 			}
-			return fmt.Errorf("cannot handle column %s", col.getName())
+			return fmt.Errorf("cannot handle column %s", name)
 		}
 
 		if opts.wantColumn(col.getName()) {
@@ -387,7 +426,7 @@ func appendItem(builder *zcode.Builder, typ HandledType, iter *columnIterator, m
 		} else {
 			builder.AppendPrimitive(zng.EncodeBool(b))
 		}
-	case tint32:
+	case tint32, annotatedInt8, annotatedInt16, annotatedInt32:
 		var i int32
 		i, _, dl = iter.nextInt32()
 		if maxDef > dl {
@@ -395,7 +434,17 @@ func appendItem(builder *zcode.Builder, typ HandledType, iter *columnIterator, m
 		} else {
 			builder.AppendPrimitive(zng.EncodeInt(int64(i)))
 		}
-	case tint64:
+	case annotatedUint8, annotatedUint16, annotatedUint32:
+		var i int32
+		i, _, dl = iter.nextInt32()
+		if maxDef > dl {
+			builder.AppendPrimitive(nil)
+		} else if typ == annotatedUint8 {
+			builder.AppendPrimitive(zng.EncodeByte(byte(i)))
+		} else {
+			builder.AppendPrimitive(zng.EncodeUint(uint64(uint32(i))))
+		}
+	case tint64, annotatedInt64:
 		var i int64
 		i, _, dl = iter.nextInt64()
 		if maxDef > dl {
@@ -403,6 +452,14 @@ func appendItem(builder *zcode.Builder, typ HandledType, iter *columnIterator, m
 		} else {
 			builder.AppendPrimitive(zng.EncodeInt(i))
 		}
+	case annotatedUint64:
+		var i int64
+		i, _, dl = iter.nextInt64()
+		if maxDef > dl {
+			builder.AppendPrimitive(nil)
+		} else {
+			builder.AppendPrimitive(zng.EncodeUint(uint64(i)))
+		}
 	case float:
 		var f float64
 		f, _, dl = iter.nextFloat()
@@ -433,7 +490,7 @@ func appendItem(builder *zcode.Builder, typ HandledType, iter *columnIterator, m
 		if maxDef > dl {
 			builder.AppendPrimitive(nil)
 		} else {
-			builder.AppendPrimitive(zng.EncodeBstring(string(a)))
+			builder.AppendPrimitive(zng.EncodeBytes(a))
 		}
 	case timestampMilliseconds, timestampMicroseconds, timestampNanoseconds:
 		var i int64
@@ -0,0 +1,318 @@
+package parquetio
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/brimsec/zq/zcode"
+	"github.com/brimsec/zq/zng"
+
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/layout"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var ErrMultipleTypes = errors.New("parquet output requires records of a single type (use -d to write a file per type)")
+
+// Writer writes records of a single type to a Parquet file.  Since a
+// Parquet file holds one schema, the schema is taken from the first record
+// and a record of any other type causes Write to return ErrMultipleTypes.
+// The file footer is written by Flush, after which the Writer may not be
+// used.  See types.md for the mapping of ZNG types to Parquet types.
+type Writer struct {
+	w       io.Writer
+	pw      *writer.JSONWriter
+	typ     *zng.TypeRecord
+	flushed bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+func (w *Writer) Write(rec *zng.Record) error {
+	if w.flushed {
+		return errors.New("parquet: write after flush")
+	}
+	if w.typ == nil {
+		schema, err := newSchema(rec.Type)
+		if err != nil {
+			return err
+		}
+		w.pw, err = writer.NewJSONWriter(schema, writerfile.NewWriterFile(w.w), 1)
+		if err != nil {
+			return err
+		}
+		w.pw.MarshalFunc = marshalJSON
+		w.typ = rec.Type
+	} else if rec.Type != w.typ {
+		return ErrMultipleTypes
+	}
+	obj, err := marshalRecord(w.typ, rec.Raw)
+	if err != nil {
+		return err
+	}
+	b, err := gojson.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return w.pw.Write(string(b))
+}
+
+// Flush writes any buffered rows and the file footer.
+func (w *Writer) Flush() error {
+	if w.flushed || w.pw == nil {
+		return nil
+	}
+	w.flushed = true
+	return w.pw.WriteStop()
+}
+
+// schemaItem is an element of the JSON schema understood by
+// writer.NewJSONWriter.
+type schemaItem struct {
+	Tag    string
+	Fields []*schemaItem `json:",omitempty"`
+}
+
+func newSchema(typ *zng.TypeRecord) (string, error) {
+	fields, err := newGroupFields(typ)
+	if err != nil {
+		return "", err
+	}
+	root := schemaItem{Tag: "name=root", Fields: fields}
+	b, err := gojson.Marshal(root)
+	return string(b), err
+}
+
+func newGroupFields(typ *zng.TypeRecord) ([]*schemaItem, error) {
+	var fields []*schemaItem
+	for _, col := range typ.Columns {
+		// The schema tag syntax has no escapes.
+		if col.Name == "" || strings.ContainsAny(col.Name, ",=") {
+			return nil, fmt.Errorf("parquet: unsupported column name %q", col.Name)
+		}
+		item, err := newSchemaItem(col.Name, col.Type, "OPTIONAL")
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, item)
+	}
+	return fields, nil
+}
+
+func newSchemaItem(name string, typ zng.Type, repetition string) (*schemaItem, error) {
+	tag := fmt.Sprintf("name=%s, repetitiontype=%s", name, repetition)
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		fields, err := newGroupFields(typ)
+		if err != nil {
+			return nil, err
+		}
+		return &schemaItem{Tag: tag, Fields: fields}, nil
+	case *zng.TypeArray, *zng.TypeSet:
+		// Elements are required since Parquet readers commonly
+		// assume so and ZNG containers rarely hold unset values.
+		elem, err := newSchemaItem("element", zng.InnerType(typ), "REQUIRED")
+		if err != nil {
+			return nil, err
+		}
+		return &schemaItem{Tag: tag + ", type=LIST", Fields: []*schemaItem{elem}}, nil
+	case *zng.TypeMap:
+		if zng.IsContainerType(zng.AliasedType(typ.KeyType)) {
+			return nil, fmt.Errorf("parquet: unsupported map key type %s", typ.KeyType)
+		}
+		key, err := newSchemaItem("key", typ.KeyType, "REQUIRED")
+		if err != nil {
+			return nil, err
+		}
+		val, err := newSchemaItem("value", typ.ValType, "OPTIONAL")
+		if err != nil {
+			return nil, err
+		}
+		return &schemaItem{Tag: tag + ", type=MAP", Fields: []*schemaItem{key, val}}, nil
+	}
+	ptyp, err := primitiveType(typ)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaItem{Tag: tag + ", type=" + ptyp}, nil
+}
+
+// primitiveType returns the Parquet primitive or converted type name used
+// to store values of the ZNG primitive type typ.
+func primitiveType(typ zng.Type) (string, error) {
+	switch zng.AliasedType(typ).ID() {
+	case zng.IdBool:
+		return "BOOLEAN", nil
+	case zng.IdByte:
+		return "UINT_8", nil
+	case zng.IdInt16:
+		return "INT_16", nil
+	case zng.IdUint16, zng.IdPort:
+		return "UINT_16", nil
+	case zng.IdInt32:
+		return "INT32", nil
+	case zng.IdUint32:
+		return "UINT_32", nil
+	case zng.IdInt64, zng.IdDuration:
+		return "INT64", nil
+	case zng.IdUint64:
+		return "UINT_64", nil
+	case zng.IdFloat64:
+		return "DOUBLE", nil
+	case zng.IdString, zng.IdBstring, zng.IdEnum, zng.IdIP, zng.IdNet:
+		return "UTF8", nil
+	case zng.IdBytes:
+		return "BYTE_ARRAY", nil
+	case zng.IdTime:
+		return "TIMESTAMP_MICROS", nil
+	}
+	return "", fmt.Errorf("parquet: unsupported type %s", typ)
+}
+
+// marshalRecord returns a value that encodes as the JSON object expected by
+// writer.JSONWriter for the record body zv.  Primitive values are encoded
+// as JSON strings, which the JSONWriter parses according to the schema.
+func marshalRecord(typ *zng.TypeRecord, zv zcode.Bytes) (map[string]interface{}, error) {
+	obj := make(map[string]interface{}, len(typ.Columns))
+	it := zv.Iter()
+	for _, col := range typ.Columns {
+		if it.Done() {
+			return nil, zng.ErrMismatch
+		}
+		body, _, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		v, err := marshalValue(col.Type, body)
+		if err != nil {
+			return nil, err
+		}
+		obj[col.Name] = v
+	}
+	return obj, nil
+}
+
+func marshalValue(typ zng.Type, zv zcode.Bytes) (interface{}, error) {
+	if zv == nil {
+		return nil, nil
+	}
+	switch typ := zng.AliasedType(typ).(type) {
+	case *zng.TypeRecord:
+		return marshalRecord(typ, zv)
+	case *zng.TypeArray, *zng.TypeSet:
+		inner := zng.InnerType(typ)
+		elems := []interface{}{}
+		for it := zv.Iter(); !it.Done(); {
+			body, _, err := it.Next()
+			if err != nil {
+				return nil, err
+			}
+			if body == nil {
+				return nil, errors.New("parquet: unset container elements are not supported")
+			}
+			v, err := marshalValue(inner, body)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, v)
+		}
+		return elems, nil
+	case *zng.TypeMap:
+		keys, vals, err := typ.Decode(zv)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(keys))
+		for k, key := range keys {
+			if key.Bytes == nil {
+				return nil, errors.New("parquet: unset map keys are not supported")
+			}
+			s, err := marshalPrimitive(key.Type, key.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			v, err := marshalValue(vals[k].Type, vals[k].Bytes)
+			if err != nil {
+				return nil, err
+			}
+			m[s] = v
+		}
+		return m, nil
+	}
+	return marshalPrimitive(typ, zv)
+}
+
+func marshalPrimitive(typ zng.Type, zv zcode.Bytes) (string, error) {
+	switch zng.AliasedType(typ).ID() {
+	case zng.IdBool:
+		b, err := zng.DecodeBool(zv)
+		return strconv.FormatBool(b), err
+	case zng.IdPort:
+		p, err := zng.DecodePort(zv)
+		return strconv.FormatUint(uint64(p), 10), err
+	case zng.IdByte:
+		b, err := zng.DecodeByte(zv)
+		return strconv.FormatUint(uint64(b), 10), err
+	case zng.IdUint16, zng.IdUint32, zng.IdUint64:
+		u, err := zng.DecodeUint(zv)
+		return strconv.FormatUint(u, 10), err
+	case zng.IdInt16, zng.IdInt32, zng.IdInt64, zng.IdDuration:
+		i, err := zng.DecodeInt(zv)
+		return strconv.FormatInt(i, 10), err
+	case zng.IdFloat64:
+		f, err := zng.DecodeFloat64(zv)
+		return strconv.FormatFloat(f, 'g', -1, 64), err
+	case zng.IdTime:
+		ts, err := zng.DecodeTime(zv)
+		return strconv.FormatInt(int64(ts)/1000, 10), err
+	case zng.IdBytes:
+		// Values pass through JSON on their way to the Parquet
+		// encoder, which can't carry arbitrary bytes, so each byte
+		// is sent as the rune of the same value and marshalJSON
+		// turns the runes back into bytes.
+		runes := make([]rune, len(zv))
+		for k, b := range zv {
+			runes[k] = rune(b)
+		}
+		return string(runes), nil
+	case zng.IdString, zng.IdBstring, zng.IdEnum:
+		return string(zv), nil
+	}
+	return typ.StringOf(zv, zng.OutFormatUnescaped, false), nil
+}
+
+// marshalJSON is like marshal.MarshalJSON but decodes the values of
+// BYTE_ARRAY columns without a UTF8 annotation, which hold ZNG bytes
+// values, as encoded by marshalPrimitive.
+func marshalJSON(ss []interface{}, bgn int, end int, sh *schema.SchemaHandler) (*map[string]*layout.Table, error) {
+	tables, err := marshal.MarshalJSON(ss, bgn, end, sh)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range *tables {
+		if t.Schema.GetType() != parquet.Type_BYTE_ARRAY || t.Schema.ConvertedType != nil {
+			continue
+		}
+		for k, v := range t.Values {
+			s, ok := v.(string)
+			if !ok {
+				continue
+			}
+			b := make([]byte, 0, len(s))
+			for _, r := range s {
+				b = append(b, byte(r))
+			}
+			t.Values[k] = string(b)
+		}
+	}
+	return tables, nil
+}
@@ -56,7 +56,8 @@ func zngParseContainer(builder *zcode.Builder, typ zng.Type, b []byte) ([]byte,
 	// skip leftbracket
 	b = b[1:]
 	childType, columns := zng.ContainedType(realType)
-	if childType == nil && columns == nil {
+	mapType, _ := realType.(*zng.TypeMap)
+	if childType == nil && columns == nil && mapType == nil {
 		return nil, zng.ErrNotPrimitive
 	}
 	k := 0
@@ -65,13 +66,22 @@ func zngParseContainer(builder *zcode.Builder, typ zng.Type, b []byte) ([]byte,
 			return nil, ErrUnterminated
 		}
 		if b[0] == rightbracket {
-			if _, ok := realType.(*zng.TypeSet); ok {
+			switch realType.(type) {
+			case *zng.TypeSet:
 				builder.TransformContainer(zng.NormalizeSet)
+			case *zng.TypeMap:
+				if k%2 != 0 {
+					return nil, ErrSyntax
+				}
+				builder.TransformContainer(zng.NormalizeMap)
 			}
 			builder.EndContainer()
 			return b[1:], nil
 		}
-		if columns != nil {
+		if mapType != nil {
+			childType = mapType.ElementType(k)
+			k++
+		} else if columns != nil {
 			if k >= len(columns) {
 				return nil, &zng.RecordTypeError{Name: "<record>", Type: typ.String(), Err: zng.ErrExtraField}
 			}
@@ -115,7 +115,8 @@ func (w *Writer) writeContainer(parent zng.Value) error {
 		return err
 	}
 	childType, columns := zng.ContainedType(realType)
-	if childType == nil && columns == nil {
+	mapType, _ := realType.(*zng.TypeMap)
+	if childType == nil && columns == nil && mapType == nil {
 		return ErrSyntax
 	}
 	k := 0
@@ -125,7 +126,10 @@ func (w *Writer) writeContainer(parent zng.Value) error {
 			if err != nil {
 				return err
 			}
-			if columns != nil {
+			if mapType != nil {
+				childType = mapType.ElementType(k)
+				k++
+			} else if columns != nil {
 				if k >= len(columns) {
 					return &zng.RecordTypeError{Name: "<record>", Type: parent.Type.String(), Err: zng.ErrExtraField}
 				}
@@ -80,6 +80,12 @@ func zngTypeToZeek(typ zng.Type) (string, error) {
 		return "interval", nil
 	case *zng.TypeOfBstring:
 		return "string", nil
+	case *zng.TypeOfBytes:
+		// Zeek has no binary type so bytes are written as their
+		// base64 encoding in a string column.
+		return "string", nil
+	case *zng.TypeOfEnum:
+		return "enum", nil
 	case *zng.TypeAlias:
 		if typ.Name == "zenum" {
 			return "enum", nil
@@ -12,11 +12,13 @@ import (
 type ReaderFlags struct {
 	Format   string
 	ZngCheck bool
+	JSONMaps bool
 }
 
 func (f *ReaderFlags) SetFlags(fs *flag.FlagSet) {
-	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,ndjson,zeek,zjson,tzng,parquet]")
+	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,zng,ndjson,zeek,zjson,tzng,parquet,csv,tsv]")
 	fs.BoolVar(&f.ZngCheck, "zngcheck", true, "check input records when reading ZNG streams")
+	fs.BoolVar(&f.JSONMaps, "jsonmaps", false, "infer nested JSON objects as maps instead of records")
 }
 
 // DefaultZngLZ4BlockSize is a reasonable default for
@@ -36,7 +38,7 @@ type WriterFlags struct {
 }
 
 func (f *WriterFlags) SetFlags(fs *flag.FlagSet) {
-	fs.StringVar(&f.Format, "f", "zng", "format for output data [zng,ndjson,table,text,types,zeek,zjson,tzng]")
+	fs.StringVar(&f.Format, "f", "zng", "format for output data [zng,ndjson,table,text,types,zeek,zjson,tzng,csv,tsv,parquet]")
 	fs.BoolVar(&f.ShowTypes, "T", false, "display field types in text output")
 	fs.BoolVar(&f.ShowFields, "F", false, "display field names in text output")
 	fs.BoolVar(&f.EpochDates, "E", false, "display epoch timestamps in text output")
@@ -83,6 +85,12 @@ func Extension(format string) string {
 		return ".tbl"
 	case "zng":
 		return ".zng"
+	case "csv":
+		return ".csv"
+	case "tsv":
+		return ".tsv"
+	case "parquet":
+		return ".parquet"
 	default:
 		return ""
 	}
@@ -219,27 +219,33 @@ func decodeUnion(builder *zcode.Builder, typ *zng.TypeUnion, body interface{}) e
 
 func decodeContainer(builder *zcode.Builder, typ zng.Type, body []interface{}) error {
 	childType, columns := zng.ContainedType(typ)
-	if childType == nil && columns == nil {
+	mapType, _ := typ.(*zng.TypeMap)
+	if childType == nil && columns == nil && mapType == nil {
 		return zng.ErrNotPrimitive
 	}
+	if mapType != nil && len(body)%2 != 0 {
+		return errors.New("bad json for zjson map value")
+	}
 	builder.BeginContainer()
 	for k, column := range body {
+		if mapType != nil {
+			childType = zng.AliasedType(mapType.ElementType(k))
+		} else if columns != nil {
+			if k >= len(columns) {
+				return &zng.RecordTypeError{Name: "<record>", Type: typ.String(), Err: zng.ErrExtraField}
+			}
+			childType = zng.AliasedType(columns[k].Type)
+		}
 		// each column either a string value or an array of string values
 		if column == nil {
 			// this is an unset column
-			if zng.IsContainerType(zng.AliasedType(childType)) || zng.IsContainerType(zng.AliasedType(columns[k].Type)) {
+			if zng.IsContainerType(zng.AliasedType(childType)) {
 				builder.AppendContainer(nil)
 			} else {
 				builder.AppendPrimitive(nil)
 			}
 			continue
 		}
-		if columns != nil {
-			if k >= len(columns) {
-				return &zng.RecordTypeError{Name: "<record>", Type: typ.String(), Err: zng.ErrExtraField}
-			}
-			childType = zng.AliasedType(columns[k].Type)
-		}
 		s, ok := column.(string)
 		if ok {
 			if err := decodeField(builder, childType, s); err != nil {
@@ -261,8 +267,11 @@ func decodeContainer(builder *zcode.Builder, typ zng.Type, body []interface{}) e
 			return err
 		}
 	}
-	if _, ok := typ.(*zng.TypeSet); ok {
+	switch typ.(type) {
+	case *zng.TypeSet:
 		builder.TransformContainer(zng.NormalizeSet)
+	case *zng.TypeMap:
+		builder.TransformContainer(zng.NormalizeMap)
 	}
 	builder.EndContainer()
 	return nil
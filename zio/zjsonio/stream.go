@@ -89,7 +89,8 @@ func encodeContainer(typ zng.Type, val []byte) (interface{}, error) {
 		return nil, nil
 	}
 	childType, columns := zng.ContainedType(typ)
-	if childType == nil && columns == nil {
+	mapType, _ := typ.(*zng.TypeMap)
+	if childType == nil && columns == nil && mapType == nil {
 		return nil, errors.New("invalid container")
 	}
 	k := 0
@@ -102,7 +103,10 @@ func encodeContainer(typ zng.Type, val []byte) (interface{}, error) {
 			if err != nil {
 				return nil, err
 			}
-			if columns != nil {
+			if mapType != nil {
+				childType = mapType.ElementType(k)
+				k++
+			} else if columns != nil {
 				if k >= len(columns) {
 					return nil, &zng.RecordTypeError{Name: "<record>", Type: typ.String(), Err: zng.ErrExtraField}
 				}
@@ -127,6 +127,33 @@ func TestZngIndex(t *testing.T) {
 		checkReader(t, ireader, expected, true)
 		err = fp.Close()
 		require.NoError(t, err)
+
+		var reversed []int
+		for i := len(expected) - 1; i >= 0; i-- {
+			reversed = append(reversed, expected[i])
+		}
+
+		// Reverse readers should return the same records in the
+		// opposite order, both with an existing index and without.
+		for _, index := range []*TimeIndex{index, NewTimeIndex()} {
+			fp, err = fs.Open(fname)
+			require.NoError(t, err)
+			rreader, err := index.NewReverseReader(fp, resolver.NewContext(), span)
+			require.NoError(t, err)
+			checkReader(t, rreader, reversed, false)
+			err = rreader.Close()
+			require.NoError(t, err)
+		}
+
+		fp, err = fs.Open(fname)
+		require.NoError(t, err)
+		info, err := fp.Stat()
+		require.NoError(t, err)
+		rreader, err := NewReverseReader(fp, info.Size(), resolver.NewContext(), span)
+		require.NoError(t, err)
+		checkReader(t, rreader, reversed, false)
+		err = fp.Close()
+		require.NoError(t, err)
 	}
 
 	// get a scratch directory
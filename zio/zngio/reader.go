@@ -148,6 +148,8 @@ again:
 			err = r.readTypeUnion()
 		case zng.TypeDefAlias:
 			err = r.readTypeAlias()
+		case zng.TypeDefMap:
+			err = r.readTypeMap()
 		case zng.CtrlEOS:
 			r.reset()
 		case zng.CtrlCompressed:
@@ -355,6 +357,27 @@ func (r *Reader) readTypeArray() error {
 	return nil
 }
 
+func (r *Reader) readTypeMap() error {
+	keyID, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	valID, err := r.readUvarint()
+	if err != nil {
+		return zng.ErrBadFormat
+	}
+	keyType, err := r.zctx.LookupType(int(keyID))
+	if err != nil {
+		return err
+	}
+	valType, err := r.zctx.LookupType(int(valID))
+	if err != nil {
+		return err
+	}
+	r.zctx.AddType(zng.NewTypeMap(-1, keyType, valType))
+	return nil
+}
+
 func (r *Reader) readTypeAlias() error {
 	len, err := r.readUvarint()
 	if err != nil {
@@ -0,0 +1,181 @@
+package zngio
+
+import (
+	"io"
+	"os"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// NewReverseReader returns a reader for the given zng file that emits the
+// records within span in the opposite order from which they appear in the
+// file.  If the index is not yet built, the entire file is scanned to
+// build it before the returned reader is created.
+func (ti *TimeIndex) NewReverseReader(f *os.File, zctx *resolver.Context, span nano.Span) (zbuf.ReadCloser, error) {
+	ti.mu.Lock()
+	ready := ti.indexReady
+	ti.mu.Unlock()
+	if !ready {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if err := ti.build(f); err != nil {
+			return nil, err
+		}
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	r := newReverseReader(f, info.Size(), zctx, ti.order, ti.index, span)
+	return struct {
+		zbuf.Reader
+		io.Closer
+	}{r, f}, nil
+}
+
+// build reads all of r to build the index.
+func (ti *TimeIndex) build(r io.Reader) error {
+	ir := &indexReader{
+		Reader: *NewReader(r, resolver.NewContext()),
+		start:  nano.MinTs,
+		end:    nano.MaxTs,
+		parent: ti,
+	}
+	for {
+		rec, err := ir.Read()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			return nil
+		}
+	}
+}
+
+// NewReverseReader returns a reader for the zng data of the given size
+// in r that emits the records within span in the opposite order from which
+// they appear in r.  Since no index is available, r is scanned once to
+// locate its streams before any records are emitted.
+func NewReverseReader(r io.ReaderAt, size int64, zctx *resolver.Context, span nano.Span) (zbuf.Reader, error) {
+	ti := NewTimeIndex()
+	if err := ti.build(io.NewSectionReader(r, 0, size)); err != nil {
+		return nil, err
+	}
+	return newReverseReader(r, size, zctx, ti.order, ti.index, span), nil
+}
+
+// reverseReader reads the streams of a zng file from last to first,
+// buffering the records of each stream in memory so they may be emitted
+// in reverse.  The memory needed is thus bounded by the size of the
+// largest stream in the file.
+type reverseReader struct {
+	r     io.ReaderAt
+	zctx  *resolver.Context
+	order Ordering
+	start nano.Ts
+	end   nano.Ts
+	// segments holds the offset of each stream (or run of streams)
+	// in the file.  The first segment always begins at offset 0 and
+	// its timestamp is unknown.
+	segments []mark
+	size     int64
+	next     int
+	recs     []*zng.Record
+}
+
+func newReverseReader(r io.ReaderAt, size int64, zctx *resolver.Context, order Ordering, index []mark, span nano.Span) *reverseReader {
+	segments := []mark{{Offset: 0}}
+	for _, m := range index {
+		if m.Offset > 0 {
+			segments = append(segments, m)
+		}
+	}
+	return &reverseReader{
+		r:        r,
+		zctx:     zctx,
+		order:    order,
+		start:    span.Ts,
+		end:      span.End(),
+		segments: segments,
+		size:     size,
+		next:     len(segments) - 1,
+	}
+}
+
+func (r *reverseReader) Read() (*zng.Record, error) {
+	for {
+		if len(r.recs) == 0 {
+			if r.next < 0 {
+				return nil, nil
+			}
+			if err := r.load(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rec := r.recs[len(r.recs)-1]
+		r.recs = r.recs[:len(r.recs)-1]
+		switch r.order {
+		case OrderDescending:
+			// Records are emitted in ascending order.
+			if rec.Ts() > r.end {
+				r.done()
+				return nil, nil
+			}
+		case OrderAscending:
+			// Records are emitted in descending order.
+			if rec.Ts() < r.start {
+				r.done()
+				return nil, nil
+			}
+		}
+		if rec.Ts() < r.start || rec.Ts() > r.end {
+			continue
+		}
+		return rec, nil
+	}
+}
+
+func (r *reverseReader) done() {
+	r.next = -1
+	r.recs = nil
+}
+
+// load reads the records of the next segment into r.recs, skipping the
+// segment if the index shows that none of its records fall within the
+// span.
+func (r *reverseReader) load() error {
+	i := r.next
+	r.next--
+	seg := r.segments[i]
+	if i > 0 {
+		// The timestamp of a segment is that of its first record.
+		if r.order == OrderDescending && seg.Ts < r.start {
+			return nil
+		}
+		if r.order == OrderAscending && seg.Ts > r.end {
+			return nil
+		}
+	}
+	end := r.size
+	if i+1 < len(r.segments) {
+		end = r.segments[i+1].Offset
+	}
+	reader := NewReader(io.NewSectionReader(r.r, seg.Offset, end-seg.Offset), r.zctx)
+	for {
+		rec, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			return nil
+		}
+		r.recs = append(r.recs, rec.Keep())
+	}
+}
@@ -119,6 +119,9 @@ func (b *Builder) parseRecord(typ *TypeRecord, in []string) ([]string, error) {
 		case *TypeUnion:
 			// XXX need a value syntax that indicates which type to use
 			return nil, errors.New("union values not yet supported")
+		case *TypeMap:
+			// XXX need a value syntax for key/value pairs
+			return nil, errors.New("map values not yet supported")
 		default:
 			if err := b.parsePrimitive(v, in[0]); err != nil {
 				return nil, err
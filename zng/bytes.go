@@ -0,0 +1,55 @@
+package zng
+
+import (
+	"encoding/base64"
+
+	"github.com/brimsec/zq/zcode"
+)
+
+type TypeOfBytes struct{}
+
+func NewBytes(b []byte) Value {
+	return Value{TypeBytes, EncodeBytes(b)}
+}
+
+func EncodeBytes(b []byte) zcode.Bytes {
+	return zcode.Bytes(b)
+}
+
+func DecodeBytes(zv zcode.Bytes) ([]byte, error) {
+	if zv == nil {
+		return nil, ErrUnset
+	}
+	return []byte(zv), nil
+}
+
+// Parse decodes the base64 text representation of a bytes value.  Empty
+// text is an empty value, which is distinct from an unset (nil) value.
+func (t *TypeOfBytes) Parse(in []byte) (zcode.Bytes, error) {
+	out := make([]byte, base64.StdEncoding.DecodedLen(len(in)))
+	n, err := base64.StdEncoding.Decode(out, in)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
+
+func (t *TypeOfBytes) ID() int {
+	return IdBytes
+}
+
+func (t *TypeOfBytes) String() string {
+	return "bytes"
+}
+
+// Values of type bytes are arbitrary binary data, which are represented
+// in every text format as base64.  Since the base64 alphabet does not
+// include any of the separators or escape characters used by these
+// formats, no further escaping is needed.
+func (t *TypeOfBytes) StringOf(zv zcode.Bytes, fmt OutFmt, inContainer bool) string {
+	return base64.StdEncoding.EncodeToString(zv)
+}
+
+func (t *TypeOfBytes) Marshal(zv zcode.Bytes) (interface{}, error) {
+	return t.StringOf(zv, OutFormatUnescaped, false), nil
+}
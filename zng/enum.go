@@ -0,0 +1,45 @@
+package zng
+
+import (
+	"github.com/brimsec/zq/zcode"
+)
+
+// TypeOfEnum is a string whose value is one of a set of symbols defined
+// outside the scope of ZNG (e.g., a Zeek enum).  It is encoded and
+// formatted exactly like a string but is distinct in type.
+type TypeOfEnum struct{}
+
+func NewEnum(s string) Value {
+	return Value{TypeEnum, EncodeEnum(s)}
+}
+
+func EncodeEnum(s string) zcode.Bytes {
+	return zcode.Bytes(s)
+}
+
+func DecodeEnum(zv zcode.Bytes) (string, error) {
+	if zv == nil {
+		return "", ErrUnset
+	}
+	return string(zv), nil
+}
+
+func (t *TypeOfEnum) Parse(in []byte) (zcode.Bytes, error) {
+	return TypeString.Parse(in)
+}
+
+func (t *TypeOfEnum) ID() int {
+	return IdEnum
+}
+
+func (t *TypeOfEnum) String() string {
+	return "enum"
+}
+
+func (t *TypeOfEnum) StringOf(zv zcode.Bytes, fmt OutFmt, inContainer bool) string {
+	return TypeString.StringOf(zv, fmt, inContainer)
+}
+
+func (t *TypeOfEnum) Marshal(zv zcode.Bytes) (interface{}, error) {
+	return t.StringOf(zv, OutFormatUnescaped, false), nil
+}
@@ -0,0 +1,198 @@
+package zng
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/brimsec/zq/zcode"
+)
+
+// TypeMap is a container of key/value pairs.  A map value is encoded as
+// a container body whose elements alternate between keys and values,
+// i.e., key1, value1, key2, value2, and so forth.
+type TypeMap struct {
+	id      int
+	KeyType Type
+	ValType Type
+}
+
+func NewTypeMap(id int, keyType, valType Type) *TypeMap {
+	return &TypeMap{id, keyType, valType}
+}
+
+func (t *TypeMap) ID() int {
+	return t.id
+}
+
+//XXX get rid of this when we implement full ZNG
+func (t *TypeMap) SetID(id int) {
+	t.id = id
+}
+
+func (t *TypeMap) String() string {
+	return fmt.Sprintf("map[%s,%s]", t.KeyType, t.ValType)
+}
+
+// ElementType returns the type of the kth element of a map body, which
+// is the key type for even elements and the value type for odd elements.
+func (t *TypeMap) ElementType(k int) Type {
+	if k%2 == 0 {
+		return t.KeyType
+	}
+	return t.ValType
+}
+
+func (t *TypeMap) Decode(zv zcode.Bytes) ([]Value, []Value, error) {
+	if zv == nil {
+		return nil, nil, ErrUnset
+	}
+	var keys, vals []Value
+	for it := zv.Iter(); !it.Done(); {
+		key, _, err := it.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if it.Done() {
+			return nil, nil, ErrBadValue
+		}
+		val, _, err := it.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, Value{t.KeyType, key})
+		vals = append(vals, Value{t.ValType, val})
+	}
+	return keys, vals, nil
+}
+
+// Lookup returns the value bound to the given key in the map body zv.
+// The second return value is false if the key is not present.
+func (t *TypeMap) Lookup(zv zcode.Bytes, key zcode.Bytes) (zcode.Bytes, bool, error) {
+	for it := zv.Iter(); !it.Done(); {
+		k, _, err := it.Next()
+		if err != nil {
+			return nil, false, err
+		}
+		if it.Done() {
+			return nil, false, ErrBadValue
+		}
+		v, _, err := it.Next()
+		if err != nil {
+			return nil, false, err
+		}
+		if bytes.Equal(k, key) {
+			return v, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (t *TypeMap) Parse(in []byte) (zcode.Bytes, error) {
+	panic("zeek.TypeMap.Parse shouldn't be called")
+}
+
+func (t *TypeMap) StringOf(zv zcode.Bytes, fmt OutFmt, _ bool) string {
+	if len(zv) == 0 && (fmt == OutFormatZeek || fmt == OutFormatZeekAscii) {
+		return "(empty)"
+	}
+
+	var b strings.Builder
+	separator := byte(',')
+	switch fmt {
+	case OutFormatZNG:
+		b.WriteByte('[')
+		separator = ';'
+	case OutFormatDebug:
+		b.WriteString("map[")
+	}
+
+	first := true
+	k := 0
+	for it := zv.Iter(); !it.Done(); k++ {
+		val, _, err := it.Next()
+		if err != nil {
+			//XXX
+			b.WriteString("ERR")
+			break
+		}
+		if first {
+			first = false
+		} else if fmt != OutFormatZNG && k%2 == 1 {
+			b.WriteByte(':')
+		} else {
+			b.WriteByte(separator)
+		}
+		if val == nil {
+			b.WriteByte('-')
+		} else {
+			b.WriteString(t.ElementType(k).StringOf(val, fmt, true))
+		}
+	}
+
+	switch fmt {
+	case OutFormatZNG:
+		if !first {
+			b.WriteByte(';')
+		}
+		b.WriteByte(']')
+	case OutFormatDebug:
+		b.WriteByte(']')
+	}
+	return b.String()
+}
+
+// Marshal turns a map into a Go map keyed by the unescaped string
+// representation of each key so that it is encoded as a JSON object.
+func (t *TypeMap) Marshal(zv zcode.Bytes) (interface{}, error) {
+	keys, vals, err := t.Decode(zv)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]Value, len(keys))
+	for k, key := range keys {
+		m[key.Format(OutFormatUnescaped)] = vals[k]
+	}
+	return m, nil
+}
+
+// NormalizeMap interprets zv as a map body and returns an equivalent map
+// body whose entries are sorted by the tag-counted value of their keys.
+// When a key appears more than once, the last entry for that key is kept.
+func NormalizeMap(zv zcode.Bytes) zcode.Bytes {
+	type entry struct {
+		key zcode.Bytes
+		val zcode.Bytes
+	}
+	entries := make([]entry, 0, 8)
+	for it := zv.Iter(); !it.Done(); {
+		key, _, err := it.NextTagAndBody()
+		if err != nil {
+			panic(err)
+		}
+		if it.Done() {
+			panic(ErrBadValue)
+		}
+		val, _, err := it.NextTagAndBody()
+		if err != nil {
+			panic(err)
+		}
+		entries = append(entries, entry{key, val})
+	}
+	if len(entries) < 2 {
+		return zv
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) == -1
+	})
+	norm := make(zcode.Bytes, 0, len(zv))
+	for i, e := range entries {
+		if i+1 < len(entries) && bytes.Equal(e.key, entries[i+1].key) {
+			continue
+		}
+		norm = append(norm, e.key...)
+		norm = append(norm, e.val...)
+	}
+	return norm
+}
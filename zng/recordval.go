@@ -245,7 +245,7 @@ func (r *Record) AccessString(field string) (string, error) {
 		return "", err
 	}
 	switch AliasedType(v.Type).(type) {
-	case *TypeOfString, *TypeOfBstring:
+	case *TypeOfString, *TypeOfBstring, *TypeOfEnum:
 		return DecodeString(v.Bytes)
 	default:
 		return "", ErrTypeMismatch
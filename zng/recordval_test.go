@@ -2,6 +2,7 @@ package zng_test
 
 import (
 	"fmt"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -103,3 +104,11 @@ func TestRecordTs(t *testing.T) {
 		assert.Exactly(t, c.expected, rec.Ts(), "input: %q", input)
 	}
 }
+
+func TestEncodeNet(t *testing.T) {
+	for _, s := range []string{"10.1.2.0/24", "fe80::/64"} {
+		_, n, err := net.ParseCIDR(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, zng.TypeNet.StringOf(zng.EncodeNet(n), zng.OutFormatUnescaped, false))
+	}
+}
@@ -108,6 +108,10 @@ func arrayKey(inner zng.Type) string {
 	return fmt.Sprintf("a%d", inner.ID())
 }
 
+func mapKey(keyType, valType zng.Type) string {
+	return fmt.Sprintf("m%d,%d", keyType.ID(), valType.ID())
+}
+
 func aliasKey(name string) string {
 	return fmt.Sprintf("x%s", name)
 }
@@ -146,6 +150,8 @@ func typeKey(typ zng.Type) string {
 		return arrayKey(typ.Type)
 	case *zng.TypeSet:
 		return setKey(typ.InnerType)
+	case *zng.TypeMap:
+		return mapKey(typ.KeyType, typ.ValType)
 	case *zng.TypeUnion:
 		return unionKey(typ.Types)
 	}
@@ -166,6 +172,8 @@ func (c *Context) addTypeWithLock(key string, typ zng.Type) {
 		typ.SetID(id)
 	case *zng.TypeSet:
 		typ.SetID(id)
+	case *zng.TypeMap:
+		typ.SetID(id)
 	case *zng.TypeUnion:
 		typ.SetID(id)
 	}
@@ -254,6 +262,19 @@ func (c *Context) LookupTypeArray(inner zng.Type) *zng.TypeArray {
 	return typ
 }
 
+func (c *Context) LookupTypeMap(keyType, valType zng.Type) *zng.TypeMap {
+	key := mapKey(keyType, valType)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.lut[key]
+	if ok {
+		return c.table[id].(*zng.TypeMap)
+	}
+	typ := zng.NewTypeMap(-1, keyType, valType)
+	c.addTypeWithLock(key, typ)
+	return typ
+}
+
 func (c *Context) LookupTypeUnion(types []zng.Type) *zng.TypeUnion {
 	key := unionKey(types)
 	c.mu.Lock()
@@ -401,6 +422,12 @@ func (c *Context) parseType(in string) (string, zng.Type, error) {
 			return "", nil, err
 		}
 		return rest, t, nil
+	case "map":
+		rest, t, err := c.parseMapTypeBody(rest)
+		if err != nil {
+			return "", nil, err
+		}
+		return rest, t, nil
 	}
 	c.mu.RLock()
 	// check alias
@@ -538,6 +565,19 @@ func (c *Context) parseUnionTypeBody(in string) (string, zng.Type, error) {
 	return rest, c.LookupTypeUnion(types), nil
 }
 
+// parseMapTypeBody parses a map type body of the form "[keytype,valtype]"
+// presuming the map keyword is already matched.
+func (c *Context) parseMapTypeBody(in string) (string, zng.Type, error) {
+	rest, types, err := c.parseTypeList(in)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(types) != 2 {
+		return "", nil, zng.ErrTypeSyntax
+	}
+	return rest, c.LookupTypeMap(types[0], types[1]), nil
+}
+
 // parse an array body type of the form "[type]"
 func (c *Context) parseArrayTypeBody(in string) (string, *zng.TypeArray, error) {
 	rest, ok := match(in, "[")
@@ -582,6 +622,16 @@ func (c *Context) TranslateType(ext zng.Type) (zng.Type, error) {
 		return c.LookupTypeArray(inner), nil
 	case *zng.TypeUnion:
 		return c.TranslateTypeUnion(ext)
+	case *zng.TypeMap:
+		keyType, err := c.TranslateType(ext.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		valType, err := c.TranslateType(ext.ValType)
+		if err != nil {
+			return nil, err
+		}
+		return c.LookupTypeMap(keyType, valType), nil
 	case *zng.TypeAlias:
 		return c.LookupTypeAlias(ext.Name, ext.Type)
 	}
@@ -80,6 +80,8 @@ func (e *Encoder) encodeType(dst []byte, ext zng.Type) ([]byte, zng.Type, error)
 		return e.encodeTypeArray(dst, ext)
 	case *zng.TypeUnion:
 		return e.encodeTypeUnion(dst, ext)
+	case *zng.TypeMap:
+		return e.encodeTypeMap(dst, ext)
 	case *zng.TypeAlias:
 		return e.encodeTypeAlias(dst, ext)
 	}
@@ -187,6 +189,39 @@ func serializeTypeArray(dst []byte, inner zng.Type) []byte {
 	return zcode.AppendUvarint(dst, uint64(inner.ID()))
 }
 
+func (e *Encoder) encodeTypeMap(dst []byte, ext *zng.TypeMap) ([]byte, zng.Type, error) {
+	var keyType, valType zng.Type
+	var err error
+	dst, keyType, err = e.encodeType(dst, ext.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, valType, err = e.encodeType(dst, ext.ValType)
+	if err != nil {
+		return nil, nil, err
+	}
+	typ := e.zctx.LookupTypeMap(keyType, valType)
+	if e.isEncoded(typ.ID()) {
+		return dst, typ, nil
+	}
+	return serializeTypeMap(dst, keyType, valType), typ, nil
+}
+
+func serializeTypeMap(dst []byte, keyType, valType zng.Type) []byte {
+	dst = append(dst, zng.TypeDefMap)
+	dst = zcode.AppendUvarint(dst, uint64(typeID(keyType)))
+	return zcode.AppendUvarint(dst, uint64(typeID(valType)))
+}
+
+// typeID returns the ID used to reference typ in a typedef, which for an
+// alias is the ID of the alias itself rather than that of its target.
+func typeID(typ zng.Type) int {
+	if alias, ok := typ.(*zng.TypeAlias); ok {
+		return alias.AliasID()
+	}
+	return typ.ID()
+}
+
 func serializeTypes(dst []byte, types []zng.Type) []byte {
 	for _, typ := range types {
 		switch typ := typ.(type) {
@@ -200,6 +235,8 @@ func serializeTypes(dst []byte, types []zng.Type) []byte {
 			dst = serializeTypeArray(dst, typ.Type)
 		case *zng.TypeUnion:
 			dst = serializeTypeUnion(dst, typ.Types)
+		case *zng.TypeMap:
+			dst = serializeTypeMap(dst, typ.KeyType, typ.ValType)
 		case *zng.TypeAlias:
 			dst = serializeTypeAlias(dst, typ)
 		}
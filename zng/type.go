@@ -82,7 +82,9 @@ var (
 	TypeUint64   = &TypeOfUint64{}
 	TypeFloat64  = &TypeOfFloat64{}
 	TypeString   = &TypeOfString{}
+	TypeBytes    = &TypeOfBytes{}
 	TypeBstring  = &TypeOfBstring{}
+	TypeEnum     = &TypeOfEnum{}
 	TypeIP       = &TypeOfIP{}
 	TypePort     = &TypeOfPort{}
 	TypeNet      = &TypeOfNet{}
@@ -123,6 +125,7 @@ const (
 	TypeDefAlias   = 0x84
 	CtrlEOS        = 0x85
 	CtrlCompressed = 0x86
+	TypeDefMap     = 0x87
 )
 
 type CompressionFormat int
@@ -151,8 +154,12 @@ func LookupPrimitive(name string) Type {
 		return TypeFloat64
 	case "string":
 		return TypeString
+	case "bytes":
+		return TypeBytes
 	case "bstring":
 		return TypeBstring
+	case "enum":
+		return TypeEnum
 	case "ip":
 		return TypeIP
 	case "port":
@@ -191,8 +198,12 @@ func LookupPrimitiveById(id int) Type {
 		return TypeFloat64
 	case IdString:
 		return TypeString
+	case IdBytes:
+		return TypeBytes
 	case IdBstring:
 		return TypeBstring
+	case IdEnum:
+		return TypeEnum
 	case IdIP:
 		return TypeIP
 	case IdPort:
@@ -250,6 +261,17 @@ func ContainedType(typ Type) (Type, []Column) {
 	}
 }
 
+// IsStringy returns true if the type ID is that of a string, bstring,
+// or enum, each of which holds its value as a sequence of characters.
+func IsStringy(id int) bool {
+	switch id {
+	case IdString, IdBstring, IdEnum:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsUnionType(typ Type) bool {
 	_, ok := typ.(*TypeUnion)
 	return ok
@@ -257,7 +279,7 @@ func IsUnionType(typ Type) bool {
 
 func IsContainerType(typ Type) bool {
 	switch typ.(type) {
-	case *TypeSet, *TypeArray, *TypeRecord, *TypeUnion:
+	case *TypeSet, *TypeArray, *TypeRecord, *TypeUnion, *TypeMap:
 		return true
 	default:
 		return false
@@ -271,6 +293,8 @@ func AliasTypes(typ Type) []*TypeAlias {
 		aliases = AliasTypes(typ.InnerType)
 	case *TypeArray:
 		aliases = AliasTypes(typ.Type)
+	case *TypeMap:
+		aliases = append(AliasTypes(typ.KeyType), AliasTypes(typ.ValType)...)
 	case *TypeRecord:
 		for _, col := range typ.Columns {
 			aliases = append(aliases, AliasTypes(col.Type)...)
@@ -143,7 +143,7 @@ func (v Value) Elements() ([]Value, error) {
 
 func (v Value) ContainerLength() (int, error) {
 	switch v.Type.(type) {
-	case *TypeSet, *TypeArray:
+	case *TypeSet, *TypeArray, *TypeMap:
 		if v.Bytes == nil {
 			return -1, ErrLenUnset
 		}
@@ -154,6 +154,10 @@ func (v Value) ContainerLength() (int, error) {
 			}
 			n++
 		}
+		if _, ok := v.Type.(*TypeMap); ok {
+			// Each entry of a map comprises a key and a value.
+			n /= 2
+		}
 		return n, nil
 	default:
 		return -1, ErrNotContainer
@@ -70,6 +70,19 @@ func walkRecord(typ *TypeRecord, body zcode.Bytes, rv RecordVisitor) error {
 			if err := walkSet(t, body, rv); err != nil {
 				return err
 			}
+		case *TypeMap:
+			if !container {
+				return &RecordTypeError{Name: col.Name, Type: col.Type.String(), Err: ErrNotContainer}
+			}
+			if err := rv(t, body); err != nil {
+				if err == SkipContainer {
+					continue
+				}
+				return err
+			}
+			if err := walkMap(t, body, rv); err != nil {
+				return err
+			}
 		case *TypeUnion:
 			if !container {
 				return &RecordTypeError{Name: col.Name, Type: col.Type.String(), Err: ErrNotContainer}
@@ -146,6 +159,19 @@ func walkVector(typ *TypeArray, body zcode.Bytes, rv RecordVisitor) error {
 			if err := walkSet(t, body, rv); err != nil {
 				return err
 			}
+		case *TypeMap:
+			if !container {
+				return &RecordTypeError{Name: "<map element>", Type: t.String(), Err: ErrNotContainer}
+			}
+			if err := rv(t, body); err != nil {
+				if err == SkipContainer {
+					continue
+				}
+				return err
+			}
+			if err := walkMap(t, body, rv); err != nil {
+				return err
+			}
 		case *TypeUnion:
 			if !container {
 				return &RecordTypeError{Name: "<union value>", Type: t.String(), Err: ErrNotContainer}
@@ -229,6 +255,19 @@ func walkUnion(typ *TypeUnion, body zcode.Bytes, rv RecordVisitor) error {
 		if err := walkSet(t, body, rv); err != nil {
 			return err
 		}
+	case *TypeMap:
+		if !container {
+			return &RecordTypeError{Name: "<map element>", Type: t.String(), Err: ErrNotContainer}
+		}
+		if err := rv(t, body); err != nil {
+			if err == SkipContainer {
+				return nil
+			}
+			return err
+		}
+		if err := walkMap(t, body, rv); err != nil {
+			return err
+		}
 	case *TypeUnion:
 		if !container {
 			return &RecordTypeError{Name: "<union value>", Type: t.String(), Err: ErrNotContainer}
@@ -276,3 +315,32 @@ func walkSet(typ *TypeSet, body zcode.Bytes, rv RecordVisitor) error {
 	}
 	return nil
 }
+
+// walkMap visits the keys and values of a map.  Each key and value is
+// walked as the lone column of a record so that containers nested inside
+// a map are visited just as they are elsewhere.
+func walkMap(typ *TypeMap, body zcode.Bytes, rv RecordVisitor) error {
+	if body == nil {
+		return nil
+	}
+	keyRec := &TypeRecord{Columns: []Column{{Name: "<map key>", Type: typ.KeyType}}}
+	valRec := &TypeRecord{Columns: []Column{{Name: "<map value>", Type: typ.ValType}}}
+	k := 0
+	for it := zcode.Iter(body); !it.Done(); k++ {
+		tagAndBody, _, err := it.NextTagAndBody()
+		if err != nil {
+			return err
+		}
+		rec := keyRec
+		if k%2 == 1 {
+			rec = valRec
+		}
+		if err := walkRecord(rec, tagAndBody, rv); err != nil {
+			return err
+		}
+	}
+	if k%2 != 0 {
+		return &RecordTypeError{Name: "<map>", Type: typ.String(), Err: ErrBadValue}
+	}
+	return nil
+}
@@ -59,6 +59,13 @@ func ToNativeValue(zv zng.Value) (Value, error) {
 		}
 		return Value{zv.Type, s}, nil
 
+	case zng.IdBytes:
+		b, err := zng.DecodeBytes(zv.Bytes)
+		if err != nil {
+			return Value{}, err
+		}
+		return Value{zv.Type, b}, nil
+
 	case zng.IdBstring:
 		s, err := zng.DecodeBstring(zv.Bytes)
 		if err != nil {
@@ -66,6 +73,13 @@ func ToNativeValue(zv zng.Value) (Value, error) {
 		}
 		return Value{zv.Type, s}, nil
 
+	case zng.IdEnum:
+		s, err := zng.DecodeEnum(zv.Bytes)
+		if err != nil {
+			return Value{}, err
+		}
+		return Value{zv.Type, s}, nil
+
 	case zng.IdIP:
 		a, err := zng.DecodeIP(zv.Bytes)
 		if err != nil {
@@ -102,12 +116,12 @@ func ToNativeValue(zv zng.Value) (Value, error) {
 		return Value{zv.Type, d}, nil
 	}
 
-	// Keep arrays, sets, and records in their zval encoded form.
+	// Keep arrays, sets, records, and maps in their zval encoded form.
 	// The purpose of Value is to avoid encoding temporary
 	// values but since we can't construct these types in expressions,
 	// this just lets us lazily decode them.
 	switch zv.Type.(type) {
-	case *zng.TypeArray, *zng.TypeSet, *zng.TypeRecord:
+	case *zng.TypeArray, *zng.TypeSet, *zng.TypeRecord, *zng.TypeMap:
 		return Value{zv.Type, zv.Bytes}, nil
 	}
 
@@ -156,10 +170,18 @@ func (v *Value) ToZngValue() (zng.Value, error) {
 		s := v.Value.(string)
 		return zng.Value{zng.TypeString, zng.EncodeString(s)}, nil
 
+	case zng.IdBytes:
+		b := v.Value.([]byte)
+		return zng.Value{zng.TypeBytes, zng.EncodeBytes(b)}, nil
+
 	case zng.IdBstring:
 		s := v.Value.(string)
 		return zng.Value{zng.TypeBstring, zng.EncodeBstring(s)}, nil
 
+	case zng.IdEnum:
+		s := v.Value.(string)
+		return zng.Value{zng.TypeEnum, zng.EncodeEnum(s)}, nil
+
 	case zng.IdIP:
 		i := v.Value.(net.IP)
 		return zng.Value{zng.TypeIP, zng.EncodeIP(i)}, nil
@@ -181,9 +203,9 @@ func (v *Value) ToZngValue() (zng.Value, error) {
 		return zng.Value{zng.TypeDuration, zng.EncodeDuration(d)}, nil
 	}
 
-	// Arrays, sets, and records are just zval encoded.
+	// Arrays, sets, records, and maps are just zval encoded.
 	switch v.Type.(type) {
-	case *zng.TypeArray, *zng.TypeSet, *zng.TypeRecord:
+	case *zng.TypeArray, *zng.TypeSet, *zng.TypeRecord, *zng.TypeMap:
 		return zng.Value{v.Type, v.Value.(zcode.Bytes)}, nil
 	}
 
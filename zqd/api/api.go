@@ -43,6 +43,11 @@ type SearchRequest struct {
 	Proc  json.RawMessage `json:"proc" validate:"required"`
 	Span  nano.Span       `json:"span"`
 	Dir   int             `json:"dir" validate:"required"`
+	// Follow keeps the search running after it reaches the end of the
+	// data in the space, streaming the records within Span that are
+	// added to the space until the search is canceled.  The added
+	// records follow in the order they are added rather than in Dir.
+	Follow bool `json:"follow,omitempty"`
 }
 
 type SearchRecords struct {
@@ -69,6 +74,21 @@ type SearchStats struct {
 	ScannerStats
 }
 
+// RequestIDHeader is the header holding the id of a request, which the
+// server generates if the client does not set it.  The id of a search
+// request is the id under which the server runs the search.
+const RequestIDHeader = "X-Request-ID"
+
+// SearchInfo describes a search that is running on the server.
+type SearchInfo struct {
+	ID        string    `json:"id"`
+	Space     SpaceID   `json:"space"`
+	Span      nano.Span `json:"span"`
+	Dir       int       `json:"dir"`
+	StartTime nano.Ts   `json:"start_time"`
+	ScannerStats
+}
+
 type ScannerStats struct {
 	BytesRead      int64 `json:"bytes_read"`
 	BytesMatched   int64 `json:"bytes_matched"`
@@ -164,6 +184,18 @@ type LogPostRequest struct {
 	JSONTypeConfig *ndjsonio.TypeConfig `json:"json_type_config"`
 }
 
+// A log post may upload the log files themselves rather than name paths
+// on the zqd host by sending them as the parts of a multipart/form-data
+// request body.  These are the names of the form fields in such a body.
+// The stop_err and json_type_config fields, which hold the values of the
+// corresponding LogPostRequest fields as text and JSON, respectively, are
+// optional but must precede the files.
+const (
+	LogPostFieldFile           = "file"
+	LogPostFieldStopErr        = "stop_err"
+	LogPostFieldJSONTypeConfig = "json_type_config"
+)
+
 type LogPostWarning struct {
 	Type    string `json:"type"`
 	Warning string `json:"warning"`
@@ -176,16 +208,45 @@ type LogPostStatus struct {
 }
 
 // PcapSearch are the query string args to the packet endpoint when searching
-// for packets within a connection 5-tuple.
+// for packets within a connection 5-tuple.  If Filter is set, packets are
+// instead selected by the tcpdump-style filter expression it holds and the
+// 5-tuple fields are ignored.  Format selects the form of the response (see
+// the PcapFormat constants) and defaults to PcapFormatPcap.  For
+// PcapFormatStream, Stream selects the direction of the connection whose
+// data is returned (see the PcapStream constants) and defaults to
+// PcapStreamOrig.
 type PcapSearch struct {
 	Span    nano.Span
-	Proto   string `validate:"required"`
-	SrcHost net.IP `validate:"required"`
+	Proto   string
+	SrcHost net.IP
 	SrcPort uint16
-	DstHost net.IP `validate:"required"`
+	DstHost net.IP
 	DstPort uint16
+	Filter  string
+	Format  string
+	Stream  string
 }
 
+const (
+	// PcapFormatPcap returns the matching packets as a pcap.
+	PcapFormatPcap = "pcap"
+	// PcapFormatStream returns the reassembled payload sent in one
+	// direction of the matching TCP connection as a byte stream.  The
+	// response is cut short if the search matches more than one
+	// connection.
+	PcapFormatStream = "stream"
+	// PcapFormatZng returns the reassembled payload of the matching TCP
+	// connections as ZNG records, one for each contiguous chunk of data.
+	PcapFormatZng = "zng"
+)
+
+const (
+	// PcapStreamOrig selects the data sent by the connection's originator.
+	PcapStreamOrig = "orig"
+	// PcapStreamResp selects the data sent by the connection's responder.
+	PcapStreamResp = "resp"
+)
+
 // ToQuery transforms a packet search into a url.Values.
 func (ps *PcapSearch) ToQuery() url.Values {
 	tssec, tsns := ps.Span.Ts.Split()
@@ -196,6 +257,16 @@ func (ps *PcapSearch) ToQuery() url.Values {
 	q.Add("ts_ns", strconv.Itoa(int(tsns)))
 	q.Add("duration_sec", strconv.Itoa(dursec))
 	q.Add("duration_ns", strconv.Itoa(durns))
+	if ps.Format != "" {
+		q.Add("format", ps.Format)
+	}
+	if ps.Stream != "" {
+		q.Add("stream", ps.Stream)
+	}
+	if ps.Filter != "" {
+		q.Add("filter", ps.Filter)
+		return q
+	}
 	q.Add("proto", ps.Proto)
 	q.Add("src_host", ps.SrcHost.String())
 	q.Add("dst_host", ps.DstHost.String())
@@ -224,6 +295,23 @@ func (ps *PcapSearch) FromQuery(v url.Values) error {
 	if durNs, err = strconv.ParseInt(v.Get("duration_ns"), 10, 64); err != nil {
 		return err
 	}
+	ps.Span = nano.Span{
+		Ts:  nano.Unix(tsSec, tsNs),
+		Dur: nano.Duration(durSec, durNs),
+	}
+	switch ps.Format = v.Get("format"); ps.Format {
+	case "", PcapFormatPcap, PcapFormatStream, PcapFormatZng:
+	default:
+		return fmt.Errorf("unsupported format: %s", ps.Format)
+	}
+	switch ps.Stream = v.Get("stream"); ps.Stream {
+	case "", PcapStreamOrig, PcapStreamResp:
+	default:
+		return fmt.Errorf("unsupported stream: %s", ps.Stream)
+	}
+	if ps.Filter = v.Get("filter"); ps.Filter != "" {
+		return nil
+	}
 	if v.Get("src_port") != "" {
 		p, err := strconv.ParseUint(v.Get("src_port"), 10, 16)
 		if err != nil {
@@ -238,11 +326,6 @@ func (ps *PcapSearch) FromQuery(v url.Values) error {
 		}
 		ps.DstPort = uint16(p)
 	}
-	span := nano.Span{
-		Ts:  nano.Unix(tsSec, tsNs),
-		Dur: nano.Duration(durSec, durNs),
-	}
-	ps.Span = span
 	ps.Proto = v.Get("proto")
 	switch ps.Proto {
 	case "tcp", "udp", "icmp":
@@ -258,7 +341,17 @@ func (ps *PcapSearch) FromQuery(v url.Values) error {
 	return nil
 }
 
+// IndexSearchRequest is a search of an archive index.  By default, Patterns
+// hold the keys of an exact-match search.  If Lo or Hi is set, the search
+// returns the index records whose keys k satisfy Lo <= k < Hi, and if Prefix
+// is set, it returns those whose first key begins with Prefix.  For range
+// and prefix searches of a standard index, Patterns holds the indexed field
+// name or a ":" followed by the indexed type name; for a custom index,
+// Patterns is empty.
 type IndexSearchRequest struct {
 	IndexName string   `json:"index_name"`
 	Patterns  []string `json:"patterns"`
+	Lo        []string `json:"lo,omitempty"`
+	Hi        []string `json:"hi,omitempty"`
+	Prefix    string   `json:"prefix,omitempty"`
 }
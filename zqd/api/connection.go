@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
+	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 
 	"net/http"
@@ -83,30 +86,37 @@ func checkError(client *resty.Client, resp *resty.Response) error {
 }
 
 func (c *Connection) stream(req *resty.Request) (io.ReadCloser, error) {
+	_, r, err := c.streamResponse(req)
+	return r, err
+}
+
+// streamResponse is like stream but also returns the response, whose body
+// is the returned io.ReadCloser.
+func (c *Connection) streamResponse(req *resty.Request) (*resty.Response, io.ReadCloser, error) {
 	resp, err := req.SetDoNotParseResponse(true).Send() // disables middleware
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	r := resp.RawBody()
 	if resp.IsSuccess() {
-		return r, nil
+		return resp, r, nil
 	}
 	defer r.Close()
 	body, err := ioutil.ReadAll(r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	resErr := &ErrorResponse{Response: resp}
 	if resty.IsJSONType(resp.Header().Get("Content-Type")) {
 		var apierr Error
 		if err := json.Unmarshal(body, &apierr); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		resErr.Err = &apierr
 	} else {
 		resErr.Err = errors.New(string(body))
 	}
-	return nil, resErr
+	return nil, nil, resErr
 }
 
 // SetTimeout sets the underlying http request timeout to the given duration
@@ -205,13 +215,24 @@ func (c *Connection) SpaceDelete(ctx context.Context, id SpaceID) (err error) {
 }
 
 func (c *Connection) SearchRaw(ctx context.Context, search SearchRequest, params map[string]string) (io.ReadCloser, error) {
+	_, r, err := c.SearchRawWithID(ctx, search, params)
+	return r, err
+}
+
+// SearchRawWithID is like SearchRaw but also returns the id under which the
+// server runs the search, which may be passed to SearchCancel.
+func (c *Connection) SearchRawWithID(ctx context.Context, search SearchRequest, params map[string]string) (string, io.ReadCloser, error) {
 	req := c.Request(ctx).
 		SetBody(search).
 		SetQueryParam("format", "zng")
 	req.SetQueryParams(params)
 	req.Method = http.MethodPost
 	req.URL = "/search"
-	return c.stream(req)
+	resp, r, err := c.streamResponse(req)
+	if err != nil {
+		return "", nil, err
+	}
+	return resp.Header().Get(RequestIDHeader), r, nil
 }
 
 // Search sends a search task to the server and returns a Search interface
@@ -224,6 +245,22 @@ func (c *Connection) Search(ctx context.Context, search SearchRequest, params ma
 	return NewZngSearch(r), nil
 }
 
+// SearchList returns information about the searches running on the server.
+func (c *Connection) SearchList(ctx context.Context) ([]SearchInfo, error) {
+	var res []SearchInfo
+	_, err := c.Request(ctx).
+		SetResult(&res).
+		Get("/search")
+	return res, err
+}
+
+// SearchCancel cancels the running search with the given id, which is the
+// request id of the search request that started it.
+func (c *Connection) SearchCancel(ctx context.Context, id string) error {
+	_, err := c.Request(ctx).Delete(path.Join("/search", url.PathEscape(id)))
+	return err
+}
+
 func (c *Connection) IndexSearch(ctx context.Context, space SpaceID, search IndexSearchRequest, params map[string]string) (Search, error) {
 	req := c.Request(ctx).
 		SetBody(search).
@@ -265,6 +302,21 @@ func (c *Connection) PcapPost(ctx context.Context, space SpaceID, payload PcapPo
 }
 
 func (c *Connection) PcapSearch(ctx context.Context, space SpaceID, payload PcapSearch) (*PcapReadCloser, error) {
+	r, err := c.PcapSearchRaw(ctx, space, payload)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := pcapio.NewReader(r)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &PcapReadCloser{pr, r}, nil
+}
+
+// PcapSearchRaw returns the body of the response to a pcap search, the
+// contents of which are determined by payload.Format.
+func (c *Connection) PcapSearchRaw(ctx context.Context, space SpaceID, payload PcapSearch) (io.ReadCloser, error) {
 	req := c.Request(ctx).
 		SetQueryParamsFromValues(payload.ToQuery())
 	req.Method = http.MethodGet
@@ -276,11 +328,7 @@ func (c *Connection) PcapSearch(ctx context.Context, space SpaceID, payload Pcap
 		}
 		return nil, err
 	}
-	pr, err := pcapio.NewReader(r)
-	if err != nil {
-		return nil, err
-	}
-	return &PcapReadCloser{pr, r}, nil
+	return r, nil
 }
 
 type PcapReadCloser struct {
@@ -310,6 +358,77 @@ func (c *Connection) LogPost(ctx context.Context, space SpaceID, payload LogPost
 	return err
 }
 
+// LogPostFilesStream is like LogPostStream but uploads the local files
+// named by payload.Paths in the request body, so they need not be
+// accessible to zqd.
+func (c *Connection) LogPostFilesStream(ctx context.Context, space SpaceID, payload LogPostRequest) (*Stream, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeLogPostFiles(mw, payload))
+	}()
+	// Closing pr stops writeLogPostFiles if the request fails before
+	// the body is sent.
+	defer pr.Close()
+	req := c.Request(ctx).
+		SetHeader("Content-Type", mw.FormDataContentType()).
+		SetBody(pr)
+	req.Method = http.MethodPost
+	req.URL = path.Join("/space", url.PathEscape(string(space)), "log")
+	r, err := c.stream(req)
+	if err != nil {
+		return nil, err
+	}
+	jsonpipe := NewJSONPipeScanner(r)
+	return NewStream(jsonpipe), nil
+}
+
+func (c *Connection) LogPostFiles(ctx context.Context, space SpaceID, payload LogPostRequest) error {
+	stream, err := c.LogPostFilesStream(ctx, space, payload)
+	if err != nil {
+		return err
+	}
+	_, err = stream.ReadAll()
+	return err
+}
+
+func writeLogPostFiles(mw *multipart.Writer, payload LogPostRequest) error {
+	if payload.StopErr {
+		if err := mw.WriteField(LogPostFieldStopErr, "true"); err != nil {
+			return err
+		}
+	}
+	if payload.JSONTypeConfig != nil {
+		b, err := json.Marshal(payload.JSONTypeConfig)
+		if err != nil {
+			return err
+		}
+		if err := mw.WriteField(LogPostFieldJSONTypeConfig, string(b)); err != nil {
+			return err
+		}
+	}
+	for _, p := range payload.Paths {
+		if err := writeLogPostFile(mw, p); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+func writeLogPostFile(mw *multipart.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := mw.CreateFormFile(LogPostFieldFile, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
+
 type ErrorResponse struct {
 	*resty.Response
 	Err error
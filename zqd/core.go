@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/zqd/search"
 	"github.com/brimsec/zq/zqd/space"
 	"github.com/brimsec/zq/zqd/zeek"
 	"go.uber.org/zap"
@@ -29,6 +30,7 @@ type Core struct {
 	Root         iosrc.URI
 	ZeekLauncher zeek.Launcher
 	spaces       *space.Manager
+	searches     *search.Manager
 	taskCount    int64
 	logger       *zap.Logger
 }
@@ -50,6 +52,7 @@ func NewCore(conf Config) (*Core, error) {
 		Root:         root,
 		ZeekLauncher: conf.ZeekLauncher,
 		spaces:       spaces,
+		searches:     search.NewManager(),
 		logger:       logger,
 	}, nil
 }
@@ -39,6 +39,8 @@ func NewHandler(core *Core, logger *zap.Logger) http.Handler {
 	h.Handle("/space/{space}/archivestat", handleArchiveStat).Methods("GET")
 	h.Handle("/space/{space}/subspace", handleSubspacePost).Methods("POST")
 	h.Handle("/search", handleSearch).Methods("POST")
+	h.Handle("/search", handleSearchList).Methods("GET")
+	h.Handle("/search/{search}", handleSearchDelete).Methods("DELETE")
 	h.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(&Version)
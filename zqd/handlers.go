@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"mime"
 	"net/http"
 	"time"
 
 	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/ctxio"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
 	"github.com/brimsec/zq/zqd/ingest"
@@ -105,12 +109,38 @@ func handleSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ctx, cancelSearch := context.WithCancel(ctx)
+	defer cancelSearch()
+	unregister, err := c.searches.Register(getRequestID(r.Context()), srch, cancelSearch)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	defer unregister()
+
 	w.Header().Set("Content-Type", out.ContentType())
 	if err := srch.Run(ctx, s.Storage(), out); err != nil {
 		c.requestLogger(r).Warn("Error writing response", zap.Error(err))
 	}
 }
 
+func handleSearchList(c *Core, w http.ResponseWriter, r *http.Request) {
+	respond(c, w, r, http.StatusOK, c.searches.List())
+}
+
+func handleSearchDelete(c *Core, w http.ResponseWriter, r *http.Request) {
+	id, ok := mux.Vars(r)["search"]
+	if !ok {
+		respondError(c, w, r, zqe.E(zqe.Invalid, "no search id in path"))
+		return
+	}
+	if err := c.searches.Cancel(id); err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func getSearchOutput(w http.ResponseWriter, r *http.Request) (search.Output, error) {
 	ctrl := true
 	if r.URL.Query().Get("noctrl") != "" {
@@ -165,9 +195,34 @@ func handlePcapSearch(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer reader.Close()
-	w.Header().Set("Content-Type", "application/vnd.tcpdump.pcap")
-	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.pcap", reader.ID()))
-	_, err = ctxio.Copy(ctx, w, reader)
+	if req.Format == "" || req.Format == api.PcapFormatPcap {
+		w.Header().Set("Content-Type", "application/vnd.tcpdump.pcap")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.pcap", reader.ID()))
+		_, err = ctxio.Copy(ctx, w, reader)
+		if err != nil {
+			c.requestLogger(r).Error("Error writing packet response", zap.Error(err))
+		}
+		return
+	}
+	pcapReader, err := pcapio.NewReader(reader)
+	if err != nil {
+		respondError(c, w, r, err)
+		return
+	}
+	switch req.Format {
+	case api.PcapFormatStream:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.bin", reader.ID()))
+		orig := req.Stream != api.PcapStreamResp
+		_, err = ctxio.Copy(ctx, w, pcap.NewStreamReader(pcapReader, orig))
+	case api.PcapFormatZng:
+		w.Header().Set("Content-Type", search.MimeTypeZNG)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.zng", reader.ID()))
+		zw := zngio.NewWriter(ctxio.NewWriter(ctx, w), zio.WriterFlags{})
+		if err = zbuf.Copy(zw, pcap.NewChunkReader(resolver.NewContext(), pcapReader)); err == nil {
+			err = zw.Flush()
+		}
+	}
 	if err != nil {
 		c.requestLogger(r).Error("Error writing packet response", zap.Error(err))
 	}
@@ -325,12 +380,7 @@ func handlePcapPost(c *Core, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	pcapstore := pspace.PcapStore()
-	logstore, ok := s.Storage().(ingest.ClearableStore)
-	if !ok {
-		respondError(c, w, r, zqe.E(zqe.Invalid, "storage does not support pcap import"))
-		return
-	}
-	op, warnings, err := ingest.NewPcapOp(ctx, pcapstore, logstore, req.Path, c.ZeekLauncher)
+	op, warnings, err := ingest.NewPcapOp(ctx, pcapstore, s.Storage(), req.Path, c.ZeekLauncher)
 	if err != nil {
 		respondError(c, w, r, err)
 		return
@@ -417,18 +467,48 @@ func handleLogPost(c *Core, w http.ResponseWriter, r *http.Request) {
 	}
 	defer cancel()
 
-	var req api.LogPostRequest
-	if !request(c, w, r, &req) {
-		return
-	}
-	if len(req.Paths) == 0 {
-		respondError(c, w, r, zqe.E(zqe.Invalid, "empty paths"))
-		return
+	var op *ingest.LogOp
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		mr, err := r.MultipartReader()
+		if err != nil {
+			respondError(c, w, r, zqe.E(zqe.Invalid, err))
+			return
+		}
+		op, err = ingest.NewMultipartLogOp(ctx, s.Storage(), mr, r.ContentLength)
+		if err != nil {
+			respondError(c, w, r, err)
+			return
+		}
+	} else {
+		var req api.LogPostRequest
+		if !request(c, w, r, &req) {
+			return
+		}
+		if len(req.Paths) == 0 {
+			respondError(c, w, r, zqe.E(zqe.Invalid, "empty paths"))
+			return
+		}
+		op, err = ingest.NewLogOp(ctx, s.Storage(), req)
+		if err != nil {
+			respondError(c, w, r, err)
+			return
+		}
 	}
-	op, err := ingest.NewLogOp(ctx, s.Storage(), req)
-	if err != nil {
-		respondError(c, w, r, err)
-		return
+	// An HTTP/1.x handler cannot reliably read the request body once it
+	// has begun its response, so hold any warnings until an upload has
+	// been read.
+	var warnings []string
+wait:
+	for {
+		select {
+		case <-op.Uploaded():
+			break wait
+		case warning, ok := <-op.Status():
+			if !ok {
+				break wait
+			}
+			warnings = append(warnings, warning)
+		}
 	}
 	w.Header().Set("Content-Type", "application/ndjson")
 	w.WriteHeader(http.StatusAccepted)
@@ -438,6 +518,16 @@ func handleLogPost(c *Core, w http.ResponseWriter, r *http.Request) {
 		logger.Warn("error sending payload", zap.Error(err))
 		return
 	}
+	for _, warning := range warnings {
+		err := pipe.Send(api.LogPostWarning{
+			Type:    "LogPostWarning",
+			Warning: warning,
+		})
+		if err != nil {
+			logger.Warn("error sending payload", zap.Error(err))
+			return
+		}
+	}
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 loop:
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -19,14 +20,19 @@ import (
 	"testing"
 
 	"github.com/brimsec/zq/archive"
+	"github.com/brimsec/zq/pcap/pcapio"
 	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/pkg/test"
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zio/ndjsonio"
 	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/pcapstorage"
 	"github.com/brimsec/zq/zqd/storage"
 	"github.com/brimsec/zq/zqd/zeek"
 	"github.com/brimsec/zq/zql"
@@ -175,21 +181,158 @@ func TestSearchError(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
 		assert.IsType(t, &api.Error{}, errResp.Err)
 	})
-	t.Run("ForwardSearchUnsupported", func(t *testing.T) {
-		req := api.SearchRequest{
-			Space: sp.ID,
-			Proc:  proc,
-			Span:  nano.MaxSpan,
-			Dir:   1,
+}
+
+func TestSearchForward(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1;CBrzd94qfowOqJwCHa;]
+0:[conn;3;C8Tful1TvM3Zf5x8fl;]
+0:[conn;2;CJ4IfG1RmHKgm3Ep2e;]
+`
+	expected := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1;CBrzd94qfowOqJwCHa;]
+0:[conn;2;CJ4IfG1RmHKgm3Ep2e;]
+0:[conn;3;C8Tful1TvM3Zf5x8fl;]
+`
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, src)
+	res, _ := searchDir(t, client, sp.ID, "*", 1)
+	require.Equal(t, test.Trim(expected), res)
+}
+
+func TestSearchFollow(t *testing.T) {
+	src1 := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[conn;1;CBrzd94qfowOqJwCHa;]
+0:[conn;2;CJ4IfG1RmHKgm3Ep2e;]
+`
+	src2 := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[http;4;C8Tful1TvM3Zf5x8fl;]
+0:[conn;3;C8Tful1TvM3Zf5x8fl;]
+`
+	test := func(t *testing.T, kind storage.Kind) {
+		_, client, done := newCore(t)
+		defer done()
+		sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+			Name:    "test",
+			Storage: &storage.Config{Kind: kind},
+		})
+		require.NoError(t, err)
+		_ = postSpaceLogs(t, client, sp.ID, nil, src1)
+
+		parsed, err := zql.ParseProc("_path=conn")
+		require.NoError(t, err)
+		proc, err := json.Marshal(parsed)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r, err := client.Search(ctx, api.SearchRequest{
+			Space:  sp.ID,
+			Proc:   proc,
+			Span:   nano.MaxSpan,
+			Dir:    -1,
+			Follow: true,
+		}, nil)
+		require.NoError(t, err)
+		readTs := func() nano.Ts {
+			rec, err := r.Read()
+			require.NoError(t, err)
+			require.NotNil(t, rec)
+			return rec.Ts()
 		}
-		_, err = client.Search(context.Background(), req, nil)
-		require.Error(t, err)
-		errResp := err.(*api.ErrorResponse)
-		assert.Equal(t, http.StatusBadRequest, errResp.StatusCode())
-		assert.IsType(t, &api.Error{}, errResp.Err)
+		assert.Equal(t, nano.Ts(2e9), readTs())
+		assert.Equal(t, nano.Ts(1e9), readTs())
+
+		_ = postSpaceLogs(t, client, sp.ID, nil, src2)
+		assert.Equal(t, nano.Ts(3e9), readTs())
+
+		searches, err := client.SearchList(context.Background())
+		require.NoError(t, err)
+		require.Len(t, searches, 1)
+		require.NoError(t, client.SearchCancel(context.Background(), searches[0].ID))
+		rec, _ := r.Read()
+		require.Nil(t, rec)
+	}
+	t.Run("FileStore", func(t *testing.T) {
+		test(t, storage.FileStore)
+	})
+	t.Run("ArchiveStore", func(t *testing.T) {
+		test(t, storage.ArchiveStore)
 	})
 }
 
+func TestSearchList(t *testing.T) {
+	ctx := context.Background()
+	_, client, done := newCore(t)
+	defer done()
+	searches, err := client.SearchList(ctx)
+	require.NoError(t, err)
+	require.Len(t, searches, 0)
+
+	err = client.SearchCancel(ctx, "nosuchsearch")
+	require.Error(t, err)
+	errResp := err.(*api.ErrorResponse)
+	assert.Equal(t, http.StatusNotFound, errResp.StatusCode())
+}
+
+func TestSearchID(t *testing.T) {
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	_ = postSpaceLogs(t, client, sp.ID, nil, `
+#0:record[_path:string,ts:time]
+0:[conn;1;]
+`)
+	proc, err := json.Marshal(zql.MustParseProc("*"))
+	require.NoError(t, err)
+	req := api.SearchRequest{
+		Space:  sp.ID,
+		Proc:   proc,
+		Span:   nano.MaxSpan,
+		Dir:    -1,
+		Follow: true,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	id, r, err := client.SearchRawWithID(ctx, req, nil)
+	require.NoError(t, err)
+	defer r.Close()
+	require.NotEmpty(t, id)
+	searches, err := client.SearchList(context.Background())
+	require.NoError(t, err)
+	require.Len(t, searches, 1)
+	assert.Equal(t, id, searches[0].ID)
+
+	// A search request reusing the id of a running search is rejected.
+	res, err := client.Request(context.Background()).
+		SetHeader(api.RequestIDHeader, id).
+		SetQueryParam("format", "zng").
+		SetBody(req).
+		Post("/search")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, res.StatusCode())
+
+	require.NoError(t, client.SearchCancel(context.Background(), id))
+	search := api.NewZngSearch(r)
+	for {
+		rec, _ := search.Read()
+		if rec == nil {
+			break
+		}
+	}
+	searches, err = client.SearchList(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, searches, 0)
+}
+
 func TestSpaceList(t *testing.T) {
 	names := []string{"sp1", "sp2", "sp3", "sp4"}
 	var expected []api.SpaceInfo
@@ -458,6 +601,40 @@ func TestPostZngLogs(t *testing.T) {
 	}, info)
 }
 
+func TestPostZngLogsAppend(t *testing.T) {
+	src1 := []string{
+		"#0:record[_path:string,ts:time,uid:bstring]",
+		"0:[conn;1;CBrzd94qfowOqJwCHa;]",
+		"0:[conn;3;CBrzd94qfowOqJwCHa;]",
+	}
+	src2 := []string{
+		"#0:record[_path:string,ts:time,uid:bstring]",
+		"0:[conn;2;CBrzd94qfowOqJwCHa;]",
+	}
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	postSpaceLogs(t, client, sp.ID, nil, strings.Join(src1, "\n"))
+	require.Equal(t, "#0:record[_path:string,ts:time,uid:bstring]\n0:[conn;3;CBrzd94qfowOqJwCHa;]\n0:[conn;1;CBrzd94qfowOqJwCHa;]", strings.TrimSpace(searchTzng(t, client, sp.ID, "*")))
+
+	payloads := postSpaceLogs(t, client, sp.ID, nil, strings.Join(src2, "\n"))
+	taskend := payloads[len(payloads)-1].(*api.TaskEnd)
+	require.Nil(t, taskend.Error)
+	expected := []string{
+		"#0:record[_path:string,ts:time,uid:bstring]",
+		"0:[conn;3;CBrzd94qfowOqJwCHa;]",
+		"0:[conn;2;CBrzd94qfowOqJwCHa;]",
+		"0:[conn;1;CBrzd94qfowOqJwCHa;]",
+	}
+	require.Equal(t, strings.Join(expected, "\n"), strings.TrimSpace(searchTzng(t, client, sp.ID, "*")))
+
+	info, err := client.SpaceInfo(context.Background(), sp.ID)
+	require.NoError(t, err)
+	require.Equal(t, &nano.Span{Ts: 1e9, Dur: 2e9 + 1}, info.Span)
+}
+
 func TestPostZngLogWarning(t *testing.T) {
 	src1 := []string{
 		"undetectableformat",
@@ -619,6 +796,102 @@ func TestPostLogStopErr(t *testing.T) {
 	assert.Regexp(t, ": format detection error.*", err.Error())
 }
 
+func TestPostLogUpload(t *testing.T) {
+	src1 := []string{
+		"#0:record[_path:string,ts:time,uid:bstring]",
+		"0:[conn;1;CBrzd94qfowOqJwCHa;]",
+	}
+	src2 := []string{
+		"#0:record[_path:string,ts:time,uid:bstring]",
+		"0:[conn;2;CBrzd94qfowOqJwCHa;]",
+		"detectablebutbadline",
+	}
+	src3 := []string{
+		"undetectableformat",
+	}
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	var paths []string
+	for _, src := range [][]string{src1, src2, src3} {
+		path := writeTempFile(t, strings.Join(src, "\n"))
+		defer os.Remove(path)
+		paths = append(paths, path)
+	}
+	stream, err := client.LogPostFilesStream(context.Background(), sp.ID, api.LogPostRequest{Paths: paths})
+	require.NoError(t, err)
+	payloads, err := stream.ReadAll()
+	require.NoError(t, err)
+
+	warnings := postPayloads(payloads).LogPostWarnings()
+	require.Len(t, warnings, 2)
+	assert.Regexp(t, ": line 3: bad format$", warnings[0].Warning)
+	assert.Regexp(t, filepath.Base(paths[2])+": format detection error.*", warnings[1].Warning)
+
+	status := payloads[len(payloads)-2].(*api.LogPostStatus)
+	assert.EqualValues(t, 187, status.LogReadSize)
+
+	res := searchTzng(t, client, sp.ID, "*")
+	require.Equal(t, strings.Join(append(src2[:2], src1[1]), "\n"), strings.TrimSpace(res))
+}
+
+func TestPostNDJSONLogUpload(t *testing.T) {
+	const src = `{"ts":"1000","uid":"CXY9a54W2dLZwzPXf1","_path":"http"}`
+	tc := ndjsonio.TypeConfig{
+		Descriptors: map[string][]interface{}{
+			"http_log": []interface{}{
+				map[string]interface{}{
+					"name": "_path",
+					"type": "string",
+				},
+				map[string]interface{}{
+					"name": "ts",
+					"type": "time",
+				},
+				map[string]interface{}{
+					"name": "uid",
+					"type": "bstring",
+				},
+			},
+		},
+		Rules: []ndjsonio.Rule{
+			ndjsonio.Rule{"_path", "http", "http_log"},
+		},
+	}
+	_, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	path := writeTempFile(t, src)
+	defer os.Remove(path)
+	err = client.LogPostFiles(context.Background(), sp.ID, api.LogPostRequest{Paths: []string{path}, JSONTypeConfig: &tc})
+	require.NoError(t, err)
+
+	const expected = "#0:record[_path:string,ts:time,uid:bstring]\n0:[http;1;CXY9a54W2dLZwzPXf1;]"
+	res := searchTzng(t, client, sp.ID, "*")
+	require.Equal(t, expected, strings.TrimSpace(res))
+}
+
+func TestPostLogUploadStopErr(t *testing.T) {
+	src := `
+#0:record[_path:string,ts:time,uid:bstring
+0:[conn;1;CBrzd94qfowOqJwCHa;]`
+	logfile := writeTempFile(t, src)
+	defer os.Remove(logfile)
+	_, client, done := newCore(t)
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	err = client.LogPostFiles(context.Background(), sp.ID, api.LogPostRequest{Paths: []string{logfile}, StopErr: true})
+	require.Error(t, err)
+	assert.Regexp(t, ": format detection error.*", err.Error())
+}
+
 func TestDeleteDuringPcapPost(t *testing.T) {
 	c, client, done := newCore(t)
 	defer done()
@@ -770,11 +1043,188 @@ func TestCreateArchiveSpace(t *testing.T) {
 `
 	res := searchTzng(t, client, sp.ID, "s=harefoot-raucous")
 	require.Equal(t, test.Trim(exptzng), res)
+}
 
-	// Verify pcap post not supported
-	_, err = client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"foo"})
-	require.Error(t, err)
-	assert.Regexp(t, "space does not support pcap import", err.Error())
+func TestArchivePcapPost(t *testing.T) {
+	root := createTempDir(t)
+	c, client, done := newCoreAtDir(t, root)
+	defer done()
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"}))
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name:    "arktest",
+		Storage: &storage.Config{Kind: storage.ArchiveStore},
+	})
+	require.NoError(t, err)
+	pcapuri, err := iosrc.ParseURI("testdata/valid.pcap")
+	require.NoError(t, err)
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{pcapuri.Filepath()})
+	require.NoError(t, err)
+	payloads, err := stream.ReadAll()
+	require.NoError(t, err)
+	taskEnd := payloads[len(payloads)-1].(*api.TaskEnd)
+	require.Nil(t, taskEnd.Error)
+
+	res := searchTzng(t, client, sp.ID, "count()")
+	require.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[1;]`), res)
+
+	info, err := client.SpaceInfo(context.Background(), sp.ID)
+	require.NoError(t, err)
+	assert.True(t, info.PcapSupport)
+	assert.Equal(t, pcapuri, info.PcapPath)
+	assert.Equal(t, int64(4224), info.PcapSize)
+	require.FileExists(t, filepath.Join(root, string(sp.ID), pcapstorage.MetaFile))
+
+	rc, err := client.PcapSearch(context.Background(), sp.ID, api.PcapSearch{
+		Span:    nano.Span{Ts: 1501770877471635000, Dur: 3485852000},
+		Proto:   "tcp",
+		SrcHost: net.ParseIP("192.168.0.5"),
+		SrcPort: 50798,
+		DstHost: net.ParseIP("54.148.114.85"),
+		DstPort: 80,
+	})
+	require.NoError(t, err)
+	defer rc.Close()
+	var n int
+	for {
+		b, typ, err := rc.Read()
+		require.NoError(t, err)
+		if b == nil {
+			break
+		}
+		if typ == pcapio.TypePacket {
+			n++
+		}
+	}
+	assert.NotZero(t, n)
+}
+
+func TestPcapPostFlowLauncher(t *testing.T) {
+	_, client, done := newCoreWithConfig(t, zqd.Config{ZeekLauncher: zeek.FlowLauncher})
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	pcapuri, err := iosrc.ParseURI("testdata/valid.pcap")
+	require.NoError(t, err)
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{pcapuri.Filepath()})
+	require.NoError(t, err)
+	payloads, err := stream.ReadAll()
+	require.NoError(t, err)
+	taskEnd := payloads[len(payloads)-1].(*api.TaskEnd)
+	require.Nil(t, taskEnd.Error)
+
+	res := searchTzng(t, client, sp.ID, "cut _path,id,proto,tcp_flags,orig_pkts,resp_pkts")
+	require.Equal(t, test.Trim(`
+#0:record[_path:string,id:record[orig_h:ip,orig_p:port,resp_h:ip,resp_p:port],proto:string,tcp_flags:string,orig_pkts:uint64,resp_pkts:uint64]
+0:[conn;[192.168.0.5;50798;54.148.114.85;80;]tcp;SFP.;15;12;]`), res)
+}
+
+func TestPcapSearchReassembly(t *testing.T) {
+	_, client, done := newCoreWithConfig(t, zqd.Config{ZeekLauncher: zeek.FlowLauncher})
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+	pcapuri, err := iosrc.ParseURI("testdata/valid.pcap")
+	require.NoError(t, err)
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{pcapuri.Filepath()})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.NoError(t, err)
+
+	req := api.PcapSearch{
+		Span:   nano.Span{Ts: 1501770877471635000, Dur: 3485852000},
+		Filter: "tcp port 80",
+		Format: api.PcapFormatZng,
+	}
+	rc, err := client.PcapSearchRaw(context.Background(), sp.ID, req)
+	require.NoError(t, err)
+	var orig, resp []byte
+	zr := zngio.NewReader(rc, resolver.NewContext())
+	for {
+		rec, err := zr.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.Equal(t, "record[ts:time,id:record[orig_h:ip,orig_p:port,resp_h:ip,resp_p:port],is_orig:bool,offset:uint64,payload:bytes]", rec.Type.String())
+		v, err := rec.ValueByField("payload")
+		require.NoError(t, err)
+		isOrig, err := rec.AccessBool("is_orig")
+		require.NoError(t, err)
+		if isOrig {
+			orig = append(orig, v.Bytes...)
+		} else {
+			resp = append(resp, v.Bytes...)
+		}
+	}
+	rc.Close()
+	assert.True(t, bytes.HasPrefix(orig, []byte("GET /echo?.kl=Y HTTP/1.1\r\n")))
+	assert.True(t, bytes.HasPrefix(resp, []byte("HTTP/1.1 101 ")))
+
+	readStream := func(dir string) []byte {
+		req.Format = api.PcapFormatStream
+		req.Stream = dir
+		rc, err := client.PcapSearchRaw(context.Background(), sp.ID, req)
+		require.NoError(t, err)
+		defer rc.Close()
+		b, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		return b
+	}
+	assert.Equal(t, orig, readStream(""))
+	assert.Equal(t, resp, readStream(api.PcapStreamResp))
+}
+
+func TestArchiveSearchForward(t *testing.T) {
+	thresh := int64(1000)
+	_, client, done := newCore(t)
+	defer done()
+
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{
+		Name: "arktest",
+		Storage: &storage.Config{
+			Kind: storage.ArchiveStore,
+			Archive: &storage.ArchiveConfig{
+				CreateOptions: &storage.ArchiveCreateOptions{
+					LogSizeThreshold: &thresh,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	payload := api.LogPostRequest{Paths: []string{"../tests/suite/data/babble.tzng"}}
+	err = client.LogPost(context.Background(), sp.ID, payload)
+	require.NoError(t, err)
+
+	// Timestamps must be ascending across (and within) the archive's
+	// chunks, and every record must be returned.
+	readTs := func(dir int) []nano.Ts {
+		res, _ := searchDir(t, client, sp.ID, "*", dir)
+		r := tzngio.NewReader(strings.NewReader(res), resolver.NewContext())
+		var ts []nano.Ts
+		for {
+			rec, err := r.Read()
+			require.NoError(t, err)
+			if rec == nil {
+				return ts
+			}
+			ts = append(ts, rec.Ts())
+		}
+	}
+	forward := readTs(1)
+	require.Len(t, forward, 1000)
+	require.True(t, sort.SliceIsSorted(forward, func(i, j int) bool {
+		return forward[i] < forward[j]
+	}))
+	reverse := readTs(-1)
+	require.Len(t, reverse, 1000)
+	require.True(t, sort.SliceIsSorted(reverse, func(i, j int) bool {
+		return reverse[i] > reverse[j]
+	}))
 }
 
 func TestBlankNameSpace(t *testing.T) {
@@ -1053,6 +1503,11 @@ func indexSearch(t *testing.T, client *api.Connection, space api.SpaceID, indexN
 // space, returning the tzng results along with a slice of all control
 // messages that were received.
 func search(t *testing.T, client *api.Connection, space api.SpaceID, prog string) (string, []interface{}) {
+	return searchDir(t, client, space, prog, -1)
+}
+
+// searchDir is like search but runs the search in the time direction dir.
+func searchDir(t *testing.T, client *api.Connection, space api.SpaceID, prog string, dir int) (string, []interface{}) {
 	parsed, err := zql.ParseProc(prog)
 	require.NoError(t, err)
 	proc, err := json.Marshal(parsed)
@@ -1061,7 +1516,7 @@ func search(t *testing.T, client *api.Connection, space api.SpaceID, prog string
 		Space: space,
 		Proc:  proc,
 		Span:  nano.MaxSpan,
-		Dir:   -1,
+		Dir:   dir,
 	}
 	r, err := client.Search(context.Background(), req, nil)
 	require.NoError(t, err)
@@ -1097,11 +1552,15 @@ func newCore(t *testing.T) (*zqd.Core, *api.Connection, func()) {
 }
 
 func newCoreAtDir(t *testing.T, dir string) (*zqd.Core, *api.Connection, func()) {
-	conf := zqd.Config{
-		Root:   dir,
-		Logger: zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel)),
+	return newCoreWithConfig(t, zqd.Config{Root: dir})
+}
+
+func newCoreWithConfig(t *testing.T, conf zqd.Config) (*zqd.Core, *api.Connection, func()) {
+	if conf.Root == "" {
+		conf.Root = createTempDir(t)
 	}
-	require.NoError(t, os.MkdirAll(dir, 0755))
+	conf.Logger = zaptest.NewLogger(t, zaptest.Level(zap.WarnLevel))
+	require.NoError(t, os.MkdirAll(conf.Root, 0755))
 	c, err := zqd.NewCore(conf)
 	require.NoError(t, err)
 	h := zqd.NewHandler(c, conf.Logger)
@@ -1215,3 +1674,96 @@ func writeLogsFn(logs []string) procFn {
 		return err
 	}
 }
+
+func TestPcapPostFailureKeepsEarlierPcaps(t *testing.T) {
+	c, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"}))
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/valid.pcap"})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.NoError(t, err)
+
+	// A failed ingest of a second pcap must not disturb the first.
+	c.ZeekLauncher = testZeekLauncher(nil, func(*testZeekProcess) error {
+		return errors.New("zeek exited with error code: 1")
+	})
+	stream, err = client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/extra.pcapng"})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.EqualError(t, err, "zeek exited with error code: 1")
+
+	res := searchTzng(t, client, sp.ID, "count()")
+	require.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[1;]`), res)
+
+	info, err := client.SpaceInfo(context.Background(), sp.ID)
+	require.NoError(t, err)
+	assert.True(t, info.PcapSupport)
+	pcapuri, err := iosrc.ParseURI("testdata/valid.pcap")
+	require.NoError(t, err)
+	assert.Equal(t, pcapuri, info.PcapPath)
+	assert.Equal(t, int64(4224), info.PcapSize)
+}
+
+func TestPcapPostDuplicate(t *testing.T) {
+	c, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"}))
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/valid.pcap"})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.NoError(t, err)
+
+	// Posting the same pcap again is rejected and leaves the space alone.
+	_, err = client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/valid.pcap"})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.(*api.ErrorResponse).StatusCode())
+
+	res := searchTzng(t, client, sp.ID, "count()")
+	require.Equal(t, test.Trim(`
+#0:record[count:uint64]
+0:[1;]`), res)
+	info, err := client.SpaceInfo(context.Background(), sp.ID)
+	require.NoError(t, err)
+	assert.True(t, info.PcapSupport)
+	assert.Equal(t, int64(4224), info.PcapSize)
+}
+
+func TestPcapPostAppends(t *testing.T) {
+	c, client, done := newCore(t)
+	defer done()
+	sp, err := client.SpacePost(context.Background(), api.SpacePostRequest{Name: "test"})
+	require.NoError(t, err)
+
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/conn.log"}))
+	stream, err := client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/valid.pcap"})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.NoError(t, err)
+
+	src := `
+#0:record[_path:string,ts:time,uid:bstring]
+0:[dns;1;CBrzd94qfowOqJwCHa;]`
+	postSpaceLogs(t, client, sp.ID, nil, src)
+
+	c.ZeekLauncher = testZeekLauncher(nil, writeLogsFn([]string{"./testdata/http.log"}))
+	stream, err = client.PcapPost(context.Background(), sp.ID, api.PcapPostRequest{"./testdata/extra.pcapng"})
+	require.NoError(t, err)
+	_, err = stream.ReadAll()
+	require.NoError(t, err)
+
+	res := searchTzng(t, client, sp.ID, "count() by _path | sort _path")
+	require.Equal(t, test.Trim(`
+#0:record[_path:string,count:uint64]
+0:[conn;1;]
+0:[dns;1;]
+0:[http;1;]`), res)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sync/atomic"
 
@@ -33,15 +34,20 @@ type LogOp struct {
 
 	warningCh chan string
 	zctx      *resolver.Context
+	// uploaded is closed when the request body holding the logs, if
+	// any, has been read.
+	uploaded chan struct{}
 }
 
 // Logs ingests the provided list of files into the provided space.
-// Like ingest.Pcap, this overwrites any existing data in the space.
+// The logs are added to any data already in the space.
 func NewLogOp(ctx context.Context, store storage.Storage, req api.LogPostRequest) (*LogOp, error) {
 	p := &LogOp{
 		warningCh: make(chan string, 5),
 		zctx:      resolver.NewContext(),
+		uploaded:  make(chan struct{}),
 	}
+	close(p.uploaded)
 	cfg := detector.OpenConfig{ZngCheck: true}
 	if req.JSONTypeConfig != nil {
 		cfg.JSONTypeConfig = req.JSONTypeConfig
@@ -77,7 +83,7 @@ func (p *LogOp) openWarning(path string, err error) {
 }
 
 type readCounter struct {
-	f     *os.File
+	f     io.ReadCloser
 	nread int64
 }
 
@@ -154,6 +160,13 @@ func (p *LogOp) Status() <-chan string {
 	return p.warningCh
 }
 
+// Uploaded returns a channel that is closed once the op has read the
+// request body holding the logs it ingests or, if the logs are not
+// uploaded, immediately.
+func (p *LogOp) Uploaded() <-chan struct{} {
+	return p.uploaded
+}
+
 // Error indicates what if any error occurred during import, after the
 // Status channel is closed.  The result is undefined while Status is open.
 func (p *LogOp) Error() error {
@@ -20,9 +20,13 @@ import (
 	"github.com/brimsec/zq/zqd/zeek"
 )
 
-type ClearableStore interface {
+// StagingStore is a storage.Storage that can hold records apart from the
+// data of a space until they are committed to it or discarded.
+type StagingStore interface {
 	storage.Storage
-	Clear(ctx context.Context) error
+	Stage(ctx context.Context, id string, zctx *resolver.Context, zr zbuf.Reader) error
+	Commit(ctx context.Context, id string) error
+	Discard(id string) error
 }
 
 type PcapOp struct {
@@ -30,7 +34,7 @@ type PcapOp struct {
 	PcapSize  int64
 
 	pcapstore    *pcapstorage.Store
-	store        ClearableStore
+	store        storage.Storage
 	snapshots    int32
 	pcapuri      iosrc.URI
 	pcapReadSize int64
@@ -45,7 +49,13 @@ type PcapOp struct {
 // Process instance once zeek log files have started to materialize in a tmp
 // directory. If zeekExec is an empty string, this will attempt to resolve zeek
 // from $PATH.
-func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store ClearableStore, pcap string, zlauncher zeek.Launcher) (*PcapOp, []string, error) {
+//
+// If store is a StagingStore, a snapshot of the logs produced so far is
+// staged at increasing intervals while Zeek runs and the final snapshot is
+// committed once Zeek has finished, so a failed ingest leaves the data of
+// the space as it was.  Otherwise, as for archive stores, the logs are
+// written to store in a single import once Zeek has finished.
+func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store storage.Storage, pcap string, zlauncher zeek.Launcher) (*PcapOp, []string, error) {
 	pcapuri, err := iosrc.ParseURI(pcap)
 	if err != nil {
 		return nil, nil, err
@@ -56,7 +66,7 @@ func NewPcapOp(ctx context.Context, pcapstore *pcapstorage.Store, store Clearabl
 	}
 	warn := make(chan string)
 	go func() {
-		err = pcapstore.Update(pcapuri, warn)
+		err = pcapstore.Add(pcapuri, warn)
 		close(warn)
 	}()
 	var warnings []string
@@ -97,12 +107,17 @@ func (p *PcapOp) run(ctx context.Context) error {
 		close(slurpDone)
 	}()
 
+	// abort rolls back this pcap only: its index is removed from the
+	// pcap store, and the logs of pcaps ingested earlier are left alone.
+	// The pcap store rejects a pcap it already holds, so the index being
+	// removed is always the one added by this op.
+	ss, snapshots := p.store.(StagingStore)
 	abort := func() {
 		os.RemoveAll(p.logdir)
-		// Don't want to use passed context here because a cancelled context
-		// would cause storage not to be cleared.
-		p.pcapstore.Delete()
-		p.store.Clear(context.Background())
+		p.pcapstore.Remove(p.pcapuri)
+		if snapshots {
+			ss.Discard(p.stageID())
+		}
 	}
 
 	ticker := time.NewTicker(time.Second)
@@ -115,7 +130,7 @@ outer:
 		case <-slurpDone:
 			break outer
 		case t := <-ticker.C:
-			if t.After(start.Add(next)) {
+			if snapshots && t.After(start.Add(next)) {
 				if err := p.createSnapshot(ctx); err != nil {
 					abort()
 					return err
@@ -136,6 +151,12 @@ outer:
 		abort()
 		return err
 	}
+	if snapshots {
+		if err := ss.Commit(ctx, p.stageID()); err != nil {
+			abort()
+			return err
+		}
+	}
 	if err := os.RemoveAll(p.logdir); err != nil {
 		abort()
 		return err
@@ -203,13 +224,26 @@ func (p *PcapOp) createSnapshot(ctx context.Context) error {
 		return err
 	}
 	defer zr.Close()
-	if err := p.store.Write(ctx, zctx, zr); err != nil {
+	// Each snapshot holds all of the logs so far, so it replaces the
+	// previously staged one.
+	if ss, ok := p.store.(StagingStore); ok {
+		err = ss.Stage(ctx, p.stageID(), zctx, zr)
+	} else {
+		err = p.store.Write(ctx, zctx, zr)
+	}
+	if err != nil {
 		return err
 	}
 	atomic.AddInt32(&p.snapshots, 1)
 	return nil
 }
 
+// stageID returns the ID under which the snapshots of this ingest are
+// staged.  The log directory is unique to the ingest, so its name serves.
+func (p *PcapOp) stageID() string {
+	return filepath.Base(p.logdir)
+}
+
 func (p *PcapOp) Write(b []byte) (int, error) {
 	n := len(b)
 	atomic.AddInt64(&p.pcapReadSize, int64(n))
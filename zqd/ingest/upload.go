@@ -0,0 +1,155 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"strconv"
+	"sync"
+
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/detector"
+	"github.com/brimsec/zq/zio/ndjsonio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/storage"
+	"github.com/brimsec/zq/zqe"
+)
+
+// NewMultipartLogOp ingests the log files uploaded in the parts of a
+// multipart/form-data request body, as described in the api package, into
+// the provided space.  The files are read as they arrive, in order, and
+// size is the length of the body or -1 if it is unknown.
+func NewMultipartLogOp(ctx context.Context, store storage.Storage, mr *multipart.Reader, size int64) (*LogOp, error) {
+	if size < 0 {
+		size = 0
+	}
+	p := &LogOp{
+		bytesTotal: size,
+		warningCh:  make(chan string, 5),
+		zctx:       resolver.NewContext(),
+		uploaded:   make(chan struct{}),
+	}
+	pr := &partReader{}
+	counter := &readCounter{f: pr}
+	p.readCounters = append(p.readCounters, counter)
+	p.readers = append(p.readers, &multipartReader{
+		op:      p,
+		mr:      mr,
+		cfg:     detector.OpenConfig{ZngCheck: true},
+		pr:      pr,
+		counter: counter,
+	})
+	go p.start(ctx, store)
+	return p, nil
+}
+
+// partReader reads the current part of a multipart body.
+type partReader struct {
+	part *multipart.Part
+}
+
+func (p *partReader) Read(b []byte) (int, error) {
+	return p.part.Read(b)
+}
+
+func (p *partReader) Close() error {
+	return nil
+}
+
+// multipartReader is a zbuf.Reader of the records of the log files in a
+// multipart body.
+type multipartReader struct {
+	op      *LogOp
+	mr      *multipart.Reader
+	cfg     detector.OpenConfig
+	stopErr bool
+	pr      *partReader
+	counter *readCounter
+	zr      zbuf.Reader
+	nfiles  int
+	once    sync.Once
+}
+
+func (m *multipartReader) Read() (*zng.Record, error) {
+	for {
+		if m.zr == nil {
+			if err := m.next(); err != nil {
+				m.done()
+				if err == io.EOF {
+					err = nil
+				}
+				return nil, err
+			}
+		}
+		rec, err := m.zr.Read()
+		if rec != nil || err != nil {
+			return rec, err
+		}
+		m.zr = nil
+	}
+}
+
+func (m *multipartReader) done() {
+	m.once.Do(func() { close(m.op.uploaded) })
+}
+
+// next advances to the next log file in the body, handling any form
+// fields that precede it.
+func (m *multipartReader) next() error {
+	for {
+		part, err := m.mr.NextPart()
+		if err == io.EOF && m.nfiles == 0 {
+			return zqe.E(zqe.Invalid, "no log files in request")
+		}
+		if err != nil {
+			return err
+		}
+		switch part.FormName() {
+		case api.LogPostFieldStopErr:
+			b, err := readField(part)
+			if err != nil {
+				return err
+			}
+			if m.stopErr, err = strconv.ParseBool(string(b)); err != nil {
+				return zqe.E(zqe.Invalid, "%s: %s", api.LogPostFieldStopErr, err)
+			}
+		case api.LogPostFieldJSONTypeConfig:
+			b, err := readField(part)
+			if err != nil {
+				return err
+			}
+			var tc ndjsonio.TypeConfig
+			if err := json.Unmarshal(b, &tc); err != nil {
+				return zqe.E(zqe.Invalid, "%s: %s", api.LogPostFieldJSONTypeConfig, err)
+			}
+			m.cfg.JSONTypeConfig = &tc
+			m.cfg.JSONPathRegex = DefaultJSONPathRegexp
+		case api.LogPostFieldFile:
+			m.nfiles++
+			m.pr.part = part
+			path := part.FileName()
+			sf, err := detector.OpenFromNamedReadCloser(m.op.zctx, m.counter, path, m.cfg)
+			if err != nil {
+				if m.stopErr {
+					return err
+				}
+				m.op.warningCh <- fmt.Sprintf("%s: %s", path, err)
+				continue
+			}
+			m.zr = zbuf.NewWarningReader(sf, m.op.warningCh)
+			return nil
+		default:
+			return zqe.E(zqe.Invalid, "unknown form field %q", part.FormName())
+		}
+	}
+}
+
+func readField(part *multipart.Part) ([]byte, error) {
+	// Form fields are small, so bound their size.
+	return ioutil.ReadAll(io.LimitReader(part, 1<<20))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/brimsec/zq/pkg/nano"
 	"github.com/brimsec/zq/zqd/api"
 	"github.com/brimsec/zq/zqe"
+	"go.uber.org/multierr"
 )
 
 const (
@@ -26,14 +27,22 @@ type Store struct {
 	root   iosrc.URI
 }
 
+// meta is the contents of the pcap.json file.  A store holds any number
+// of pcaps, each with its own packet index.
 type meta struct {
+	Pcaps []pcapMeta
+}
+
+type pcapMeta struct {
 	PcapURI iosrc.URI
 	Span    nano.Span
 	Index   pcap.Index
 }
 
 type Info struct {
-	PcapURI  iosrc.URI
+	// PcapURI is the URI of the most recently added pcap.
+	PcapURI iosrc.URI
+	// PcapSize is the total size of all pcaps in the store.
 	PcapSize int64
 	Span     nano.Span
 }
@@ -51,19 +60,35 @@ func Load(u iosrc.URI) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	var m meta
+	// Stores written before multiple pcaps were supported hold the
+	// fields of a single pcapMeta at the top level.
+	var m struct {
+		meta
+		pcapMeta
+	}
 	if err := json.Unmarshal(b, &m); err != nil {
 		return nil, err
 	}
+	if len(m.Pcaps) == 0 && !m.PcapURI.IsZero() {
+		m.Pcaps = []pcapMeta{m.pcapMeta}
+	}
 	return &Store{
 		root: u,
-		meta: m,
+		meta: m.meta,
 	}, nil
 }
 
-func (s *Store) Update(pcapuri iosrc.URI, warningCh chan<- string) error {
+// Add indexes the pcap at pcapuri and adds it to the store.  Adding a pcap
+// that is already in the store is a zqe.Conflict error, since its logs
+// have already been ingested.
+func (s *Store) Add(pcapuri iosrc.URI, warningCh chan<- string) error {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
+	for _, p := range s.meta.Pcaps {
+		if p.PcapURI == pcapuri {
+			return zqe.E(zqe.Conflict, "%s: pcap already in space", pcapuri)
+		}
+	}
 	pcapfile, err := iosrc.NewReader(pcapuri)
 	if err != nil {
 		return err
@@ -73,11 +98,41 @@ func (s *Store) Update(pcapuri iosrc.URI, warningCh chan<- string) error {
 	if err != nil {
 		return err
 	}
-	m := meta{
+	pm := pcapMeta{
 		PcapURI: pcapuri,
 		Span:    idx.Span(),
 		Index:   idx,
 	}
+	// Keep the most recently added pcap last.
+	var m meta
+	m.Pcaps = append(m.Pcaps, s.meta.Pcaps...)
+	m.Pcaps = append(m.Pcaps, pm)
+	return s.writeMeta(m)
+}
+
+// Remove removes the pcap at pcapuri from the store.  The pcap file itself
+// is left in place.
+func (s *Store) Remove(pcapuri iosrc.URI) error {
+	s.metaMu.Lock()
+	defer s.metaMu.Unlock()
+	var m meta
+	for _, p := range s.meta.Pcaps {
+		if p.PcapURI != pcapuri {
+			m.Pcaps = append(m.Pcaps, p)
+		}
+	}
+	if len(m.Pcaps) == 0 {
+		s.meta = meta{}
+		err := iosrc.Remove(s.root.AppendPath(MetaFile))
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return err
+	}
+	return s.writeMeta(m)
+}
+
+func (s *Store) writeMeta(m meta) error {
 	b, err := json.Marshal(m)
 	if err != nil {
 		return err
@@ -110,27 +165,49 @@ func (s *Store) Update(pcapuri iosrc.URI, warningCh chan<- string) error {
 func (s *Store) Empty() bool {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	return s.meta.PcapURI.IsZero()
+	return len(s.meta.Pcaps) == 0
 }
 
 func (s *Store) Info() (Info, error) {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	fi, err := iosrc.Stat(s.meta.PcapURI)
-	if err != nil {
-		return Info{}, err
+	var info Info
+	for _, p := range s.meta.Pcaps {
+		fi, err := iosrc.Stat(p.PcapURI)
+		if err != nil {
+			return Info{}, err
+		}
+		info.PcapURI = p.PcapURI
+		info.PcapSize += fi.Size()
+		if info.Span.Dur == 0 {
+			info.Span = p.Span
+		} else {
+			info.Span = info.Span.Union(p.Span)
+		}
 	}
-	return Info{
-		PcapURI:  s.meta.PcapURI,
-		PcapSize: fi.Size(),
-		Span:     s.meta.Span,
-	}, nil
+	return info, nil
 }
 
+// PcapURI returns the URI of the most recently added pcap.
 func (s *Store) PcapURI() iosrc.URI {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
-	return s.meta.PcapURI
+	if n := len(s.meta.Pcaps); n > 0 {
+		return s.meta.Pcaps[n-1].PcapURI
+	}
+	return iosrc.URI{}
+}
+
+// PcapURIs returns the URIs of all pcaps in the store in the order in
+// which they were added.
+func (s *Store) PcapURIs() []iosrc.URI {
+	s.metaMu.Lock()
+	defer s.metaMu.Unlock()
+	uris := make([]iosrc.URI, 0, len(s.meta.Pcaps))
+	for _, p := range s.meta.Pcaps {
+		uris = append(uris, p.PcapURI)
+	}
+	return uris
 }
 
 func (s *Store) Delete() error {
@@ -141,49 +218,99 @@ func (s *Store) Delete() error {
 }
 
 type Search struct {
-	*pcap.SearchReader
+	io.Reader
 	io.Closer
+	id string
+}
+
+// ID returns an identifier for the search performed.
+func (s *Search) ID() string {
+	return s.id
 }
 
 // NewSearch returns a *Search that streams all the packets meeting
 // the provided search request. If pcaps are not supported in this Space,
-// ErrPcapOpsNotSupported is returned.
+// ErrPcapOpsNotSupported is returned.  A request with a Filter selects
+// packets with that expression rather than by connection 5-tuple.  If
+// the request spans more than one of the store's pcaps, the matching
+// packets from each are merged in time order into a single pcap-ng
+// stream.
 func (s *Store) NewSearch(ctx context.Context, req api.PcapSearch) (*Search, error) {
 	s.metaMu.Lock()
 	defer s.metaMu.Unlock()
 	var search *pcap.Search
-	switch req.Proto {
-	case "tcp":
+	switch {
+	case req.Filter != "":
+		var err error
+		search, err = pcap.NewFilterSearch(req.Span, req.Filter)
+		if err != nil {
+			return nil, zqe.E(zqe.Invalid, err)
+		}
+	case req.Proto == "tcp":
 		flow := pcap.NewFlow(req.SrcHost, int(req.SrcPort), req.DstHost, int(req.DstPort))
 		search = pcap.NewTCPSearch(req.Span, flow)
-	case "udp":
+	case req.Proto == "udp":
 		flow := pcap.NewFlow(req.SrcHost, int(req.SrcPort), req.DstHost, int(req.DstPort))
 		search = pcap.NewUDPSearch(req.Span, flow)
-	case "icmp":
+	case req.Proto == "icmp":
 		search = pcap.NewICMPSearch(req.Span, req.SrcHost, req.DstHost)
 	default:
 		return nil, fmt.Errorf("unsupported proto type: %s", req.Proto)
 	}
-	f, err := iosrc.NewReader(s.meta.PcapURI)
-	if err != nil {
-		return nil, err
+	var pcaps []pcapMeta
+	for _, p := range s.meta.Pcaps {
+		// Packets may fall on the end of the span of a pcap's
+		// index, so adjacent spans count as overlapping.
+		if p.Span.OverlapsOrAdjacent(req.Span) {
+			pcaps = append(pcaps, p)
+		}
 	}
-	slicer, err := pcap.NewSlicer(f, s.meta.Index, req.Span)
-	if err != nil {
-		f.Close()
-		return nil, err
+	if len(pcaps) == 0 {
+		return nil, pcap.ErrNoPcapsFound
 	}
-	pcapReader, err := pcapio.NewReader(slicer)
-	if err != nil {
-		f.Close()
-		return nil, err
+	var files closers
+	var readers []pcapio.Reader
+	for _, p := range pcaps {
+		f, err := iosrc.NewReader(p.PcapURI)
+		if err != nil {
+			files.Close()
+			return nil, err
+		}
+		files = append(files, f)
+		slicer, err := pcap.NewSlicer(f, p.Index, req.Span)
+		if err != nil {
+			files.Close()
+			return nil, err
+		}
+		pcapReader, err := pcapio.NewReader(slicer)
+		if err != nil {
+			files.Close()
+			return nil, err
+		}
+		readers = append(readers, pcapReader)
+	}
+	var r io.Reader
+	var err error
+	if len(readers) == 1 {
+		r, err = search.Reader(ctx, readers[0])
+	} else {
+		r, err = search.MergeReader(ctx, readers)
 	}
-	r, err := search.Reader(ctx, pcapReader)
 	if err != nil {
-		f.Close()
+		files.Close()
 		return nil, err
 	}
-	return &Search{r, f}, nil
+	return &Search{Reader: r, Closer: files, id: search.ID()}, nil
+}
+
+type closers []io.Closer
+
+func (c closers) Close() error {
+	var err error
+	for _, closer := range c {
+		err = multierr.Append(err, closer.Close())
+	}
+	return err
 }
 
 const metaFileV0 = "packets.idx.json"
@@ -198,9 +325,11 @@ func MigrateV3(u iosrc.URI, pcapuri iosrc.URI) error {
 		return err
 	}
 	m := meta{
-		PcapURI: pcapuri,
-		Span:    idx.Span(),
-		Index:   idx,
+		Pcaps: []pcapMeta{{
+			PcapURI: pcapuri,
+			Span:    idx.Span(),
+			Index:   idx,
+		}},
 	}
 	out, err := json.Marshal(m)
 	if err != nil {
@@ -0,0 +1,196 @@
+package pcapstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brimsec/zq/pcap"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/require"
+)
+
+var testSearch = api.PcapSearch{
+	Span:    nano.Span{Ts: 1501770877471635000, Dur: 3485852000},
+	Proto:   "tcp",
+	SrcHost: net.ParseIP("192.168.0.5"),
+	SrcPort: 50798,
+	DstHost: net.ParseIP("54.148.114.85"),
+	DstPort: 80,
+}
+
+// splitPcap writes the packets of the pcap at src with timestamps before ts
+// to a new pcap at dst1 and the rest to dst2.
+func splitPcap(t *testing.T, src, dst1, dst2 string, ts nano.Ts) {
+	f, err := os.Open(src)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := pcapio.NewReader(f)
+	require.NoError(t, err)
+	var writers []*pcapgo.Writer
+	for _, dst := range []string{dst1, dst2} {
+		out, err := os.Create(dst)
+		require.NoError(t, err)
+		defer out.Close()
+		writers = append(writers, pcapgo.NewWriter(out))
+	}
+	var headers bool
+	for {
+		block, typ, err := r.Read()
+		require.NoError(t, err)
+		if block == nil {
+			return
+		}
+		if typ != pcapio.TypePacket {
+			continue
+		}
+		pkt, pts, linkType, err := r.Packet(block)
+		require.NoError(t, err)
+		if !headers {
+			for _, w := range writers {
+				require.NoError(t, w.WriteFileHeader(65535, linkType))
+			}
+			headers = true
+		}
+		w := writers[0]
+		if pts >= ts {
+			w = writers[1]
+		}
+		ci := gopacket.CaptureInfo{Timestamp: pts.Time(), CaptureLength: len(pkt), Length: len(pkt)}
+		require.NoError(t, w.WritePacket(ci, pkt))
+	}
+}
+
+func searchTs(t *testing.T, s *Store) []nano.Ts {
+	return searchReqTs(t, s, testSearch)
+}
+
+func searchReqTs(t *testing.T, s *Store, req api.PcapSearch) []nano.Ts {
+	search, err := s.NewSearch(context.Background(), req)
+	require.NoError(t, err)
+	defer search.Close()
+	r, err := pcapio.NewReader(search)
+	require.NoError(t, err)
+	var ts []nano.Ts
+	for {
+		block, typ, err := r.Read()
+		if err == io.EOF || block == nil {
+			return ts
+		}
+		require.NoError(t, err)
+		if typ == pcapio.TypePacket {
+			_, pts, _, err := r.Packet(block)
+			require.NoError(t, err)
+			ts = append(ts, pts)
+		}
+	}
+}
+
+func TestMultiplePcaps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pcapstorage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	root, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+
+	valid, err := iosrc.ParseURI("../testdata/valid.pcap")
+	require.NoError(t, err)
+	single := New(root.AppendPath("single"))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "single"), 0700))
+	require.NoError(t, single.Add(valid, nil))
+	expected := searchTs(t, single)
+	require.True(t, len(expected) > 2)
+
+	// Split the pcap in the middle of the flow so that a search must
+	// splice packets from both halves.
+	split := expected[len(expected)/2]
+	first := filepath.Join(dir, "first.pcap")
+	second := filepath.Join(dir, "second.pcap")
+	splitPcap(t, "../testdata/valid.pcap", first, second, split)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "multi"), 0700))
+	multi := New(root.AppendPath("multi"))
+	// Add the later pcap first to verify that packets are merged by time.
+	for _, path := range []string{second, first} {
+		u, err := iosrc.ParseURI(path)
+		require.NoError(t, err)
+		require.NoError(t, multi.Add(u, nil))
+	}
+	require.Len(t, multi.PcapURIs(), 2)
+	require.Equal(t, expected, searchTs(t, multi))
+
+	// Reloading the store restores both pcaps.
+	loaded, err := Load(root.AppendPath("multi"))
+	require.NoError(t, err)
+	require.Equal(t, multi.PcapURIs(), loaded.PcapURIs())
+
+	u, err := iosrc.ParseURI(second)
+	require.NoError(t, err)
+	require.NoError(t, loaded.Remove(u))
+	require.Equal(t, expected[:len(expected)/2], searchTs(t, loaded))
+}
+
+func TestLoadSinglePcapMeta(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pcapstorage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	root, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+
+	valid, err := iosrc.ParseURI("../testdata/valid.pcap")
+	require.NoError(t, err)
+	s := New(root)
+	require.NoError(t, s.Add(valid, nil))
+	expected := searchTs(t, s)
+
+	// Rewrite the meta file in the format used before a store could hold
+	// multiple pcaps.
+	b, err := json.Marshal(s.meta.Pcaps[0])
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, MetaFile), b, 0600))
+
+	loaded, err := Load(root)
+	require.NoError(t, err)
+	require.Equal(t, []iosrc.URI{valid}, loaded.PcapURIs())
+	require.Equal(t, expected, searchTs(t, loaded))
+}
+
+func TestFilterSearch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pcapstorage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	root, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+
+	valid, err := iosrc.ParseURI("../testdata/valid.pcap")
+	require.NoError(t, err)
+	s := New(root)
+	require.NoError(t, s.Add(valid, nil))
+	expected := searchTs(t, s)
+
+	req := api.PcapSearch{
+		Span:   testSearch.Span,
+		Filter: "net 54.148.114.0/24 and tcp port 80",
+	}
+	require.Equal(t, expected, searchReqTs(t, s, req))
+
+	req.Filter = "udp"
+	_, err = s.NewSearch(context.Background(), req)
+	require.Equal(t, pcap.ErrNoPcapsFound, err)
+
+	req.Filter = "host"
+	_, err = s.NewSearch(context.Background(), req)
+	require.True(t, errors.Is(err, zqe.E(zqe.Invalid)))
+}
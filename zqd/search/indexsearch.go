@@ -7,6 +7,7 @@ import (
 	"github.com/brimsec/zq/zbuf"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
 )
 
 type IndexSearcher interface {
@@ -18,7 +19,19 @@ type IndexSearchOp struct {
 }
 
 func NewIndexSearchOp(ctx context.Context, s IndexSearcher, req api.IndexSearchRequest) (*IndexSearchOp, error) {
-	query, err := archive.ParseIndexQuery(req.IndexName, req.Patterns)
+	isRange := len(req.Lo) > 0 || len(req.Hi) > 0
+	var query archive.IndexQuery
+	var err error
+	switch {
+	case isRange && req.Prefix != "":
+		return nil, zqe.E(zqe.Invalid, "range and prefix searches cannot be combined")
+	case isRange:
+		query, err = archive.ParseRangeQuery(req.IndexName, req.Patterns, req.Lo, req.Hi)
+	case req.Prefix != "":
+		query, err = archive.ParsePrefixQuery(req.IndexName, req.Patterns, req.Prefix)
+	default:
+		query, err = archive.ParseIndexQuery(req.IndexName, req.Patterns)
+	}
 	if err != nil {
 		return nil, err
 	}
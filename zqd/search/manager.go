@@ -0,0 +1,80 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+)
+
+// Manager keeps track of the searches running on a zqd instance so that
+// they may be listed and canceled by clients other than the one that
+// started them.
+type Manager struct {
+	mu       sync.Mutex
+	searches map[string]*running
+}
+
+type running struct {
+	op     *SearchOp
+	cancel context.CancelFunc
+}
+
+func NewManager() *Manager {
+	return &Manager{searches: make(map[string]*running)}
+}
+
+// Register adds the search op under the given id, which is the request id
+// of the search request that started it.  The search is canceled by
+// calling cancel.  The returned function must be called to remove the
+// search once it has completed.
+func (m *Manager) Register(id string, op *SearchOp, cancel context.CancelFunc) (func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.searches[id]; ok {
+		return nil, zqe.E(zqe.Conflict, "search %s already running", id)
+	}
+	m.searches[id] = &running{op: op, cancel: cancel}
+	return func() {
+		m.mu.Lock()
+		delete(m.searches, id)
+		m.mu.Unlock()
+	}, nil
+}
+
+// List returns information about each running search, sorted by id.
+func (m *Manager) List() []api.SearchInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	infos := []api.SearchInfo{}
+	for id, r := range m.searches {
+		q := r.op.Query()
+		start, stats := r.op.Stats()
+		infos = append(infos, api.SearchInfo{
+			ID:           id,
+			Space:        q.Space,
+			Span:         q.Span,
+			Dir:          q.Dir,
+			StartTime:    start,
+			ScannerStats: stats,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// Cancel cancels the running search with the given id.
+func (m *Manager) Cancel(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.searches[id]
+	if !ok {
+		return zqe.E(zqe.NotFound, "search %s not found", id)
+	}
+	r.cancel()
+	return nil
+}
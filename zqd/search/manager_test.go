@@ -0,0 +1,38 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager(t *testing.T) {
+	m := NewManager()
+	op := &SearchOp{query: &Query{Space: "sp", Dir: -1, Span: nano.MaxSpan}}
+	ctx, cancel := context.WithCancel(context.Background())
+	const id, id2 = "1", "2"
+	unregister, err := m.Register(id, op, cancel)
+	require.NoError(t, err)
+	_, err = m.Register(id, op, cancel)
+	assert.True(t, errors.Is(err, zqe.E(zqe.Conflict)))
+	unregister2, err := m.Register(id2, op, cancel)
+	require.NoError(t, err)
+	unregister2()
+
+	infos := m.List()
+	require.Len(t, infos, 1)
+	assert.Equal(t, api.SearchInfo{ID: id, Space: "sp", Span: nano.MaxSpan, Dir: -1}, infos[0])
+
+	assert.True(t, errors.Is(m.Cancel(id2), zqe.E(zqe.NotFound)))
+	require.NoError(t, m.Cancel(id))
+	assert.Error(t, ctx.Err())
+
+	unregister()
+	assert.Len(t, m.List(), 0)
+}
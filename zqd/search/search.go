@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/brimsec/zq/ast"
@@ -33,6 +34,10 @@ const (
 
 type SearchOp struct {
 	query *Query
+
+	mu        sync.Mutex
+	startTime nano.Ts
+	stats     api.ScannerStats
 }
 
 func NewSearchOp(req api.SearchRequest) (*SearchOp, error) {
@@ -42,12 +47,7 @@ func NewSearchOp(req api.SearchRequest) (*SearchOp, error) {
 	if req.Span.Dur < 0 {
 		return nil, errors.New("time span must have non-negative duration")
 	}
-	// XXX zqd only supports backwards searches, remove once this has been
-	// fixed.
-	if req.Dir == 1 {
-		return nil, zqe.E(zqe.Invalid, "forward searches not yet supported")
-	}
-	if req.Dir != -1 {
+	if req.Dir != 1 && req.Dir != -1 {
 		return nil, zqe.E(zqe.Invalid, "time direction must be 1 or -1")
 	}
 	query, err := UnpackQuery(req)
@@ -58,9 +58,13 @@ func NewSearchOp(req api.SearchRequest) (*SearchOp, error) {
 }
 
 func (s *SearchOp) Run(ctx context.Context, store storage.Storage, output Output) (err error) {
+	s.mu.Lock()
+	s.startTime = nano.Now()
+	s.mu.Unlock()
 	d := &searchdriver{
+		op:        s,
 		output:    output,
-		startTime: nano.Now(),
+		startTime: s.startTime,
 	}
 	d.start(0)
 	defer func() {
@@ -74,39 +78,70 @@ func (s *SearchOp) Run(ctx context.Context, store storage.Storage, output Output
 	statsTicker := time.NewTicker(StatsInterval)
 	defer statsTicker.Stop()
 	zctx := resolver.NewContext()
+	dir := zbuf.DirTimeReverse
+	if s.query.Dir > 0 {
+		dir = zbuf.DirTimeForward
+	}
 
 	switch st := store.(type) {
 	case *archivestore.Storage:
-		return driver.MultiRun(ctx, d, s.query.Proc, zctx, st.MultiSource(), driver.MultiConfig{
+		msrc := st.MultiSource(dir)
+		if s.query.Follow {
+			msrc = st.FollowMultiSource(ctx, zctx, dir)
+		}
+		return driver.MultiRun(ctx, d, s.query.Proc, zctx, msrc, driver.MultiConfig{
 			Span:      s.query.Span,
 			StatsTick: statsTicker.C,
 		})
 	case *filestore.Storage:
-		rc, err := st.Open(ctx, zctx, s.query.Span)
+		open := st.Open
+		if s.query.Follow {
+			open = st.Follow
+		}
+		rc, err := open(ctx, zctx, s.query.Span, dir)
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		return driver.Run(ctx, d, s.query.Proc, zctx, rc, driver.Config{
-			ReaderSortKey:     "ts",
-			ReaderSortReverse: true,
-			Span:              s.query.Span,
-			StatsTick:         statsTicker.C,
-		})
+		cfg := driver.Config{
+			Span:      s.query.Span,
+			StatsTick: statsTicker.C,
+		}
+		// The records added to a followed space are not sorted with
+		// respect to those before them.
+		if !s.query.Follow {
+			cfg.ReaderSortKey = "ts"
+			cfg.ReaderSortReverse = dir == zbuf.DirTimeReverse
+		}
+		return driver.Run(ctx, d, s.query.Proc, zctx, rc, cfg)
 	default:
 		return fmt.Errorf("unknown storage type %T", st)
 	}
 }
 
+// Query returns the query run by the search.
+func (s *SearchOp) Query() *Query {
+	return s.query
+}
+
+// Stats returns the time the search started running and the most recent
+// scanner statistics reported while running it.
+func (s *SearchOp) Stats() (nano.Ts, api.ScannerStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.startTime, s.stats
+}
+
 // A Query is the internal representation of search query describing a source
 // of tuples, a "search" applied to the tuples producing a set of matched
 // tuples, and a proc to the process the tuples
 type Query struct {
-	Space api.SpaceID
-	Dir   int
-	Span  nano.Span
-	Proc  ast.Proc
+	Space  api.SpaceID
+	Dir    int
+	Span   nano.Span
+	Proc   ast.Proc
+	Follow bool
 }
 
 // UnpackQuery transforms a api.SearchRequest into a Query.
@@ -116,15 +151,17 @@ func UnpackQuery(req api.SearchRequest) (*Query, error) {
 		return nil, err
 	}
 	return &Query{
-		Space: req.Space,
-		Dir:   req.Dir,
-		Span:  req.Span,
-		Proc:  proc,
+		Space:  req.Space,
+		Dir:    req.Dir,
+		Span:   req.Span,
+		Proc:   proc,
+		Follow: req.Follow,
 	}, nil
 }
 
 // searchdriver implements driver.Driver.
 type searchdriver struct {
+	op        *SearchOp
 	output    Output
 	startTime nano.Ts
 }
@@ -155,6 +192,9 @@ func (d *searchdriver) Write(cid int, batch zbuf.Batch) error {
 }
 
 func (d *searchdriver) Stats(stats api.ScannerStats) error {
+	d.op.mu.Lock()
+	d.op.stats = stats
+	d.op.mu.Unlock()
 	v := api.SearchStats{
 		Type:         "SearchStats",
 		StartTime:    d.startTime,
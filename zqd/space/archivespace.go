@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/zqd/api"
+	"github.com/brimsec/zq/zqd/pcapstorage"
 	"github.com/brimsec/zq/zqd/storage"
 	"github.com/brimsec/zq/zqd/storage/archivestore"
 	"github.com/brimsec/zq/zqe"
@@ -33,6 +34,12 @@ func (s *archiveSpace) Info(ctx context.Context) (api.SpaceInfo, error) {
 	return si, nil
 }
 
+// PcapStore returns the store for pcaps ingested into the space, whose
+// metadata and indexes are kept in the archive's root directory.
+func (s *archiveSpace) PcapStore() *pcapstorage.Store {
+	return s.pcapstore
+}
+
 func (s *archiveSpace) Name() string {
 	s.confMu.Lock()
 	defer s.confMu.Unlock()
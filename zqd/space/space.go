@@ -47,9 +47,8 @@ type Space interface {
 }
 
 // PcapSpace denotes that a space is capable of storing pcap files and
-// indexes.
-// XXX Temporary. The should be removed once archive spaces are enabled to allow
-// pcap ingest.
+// indexes.  File and archive spaces are pcap spaces; archive subspaces are
+// not.
 type PcapSpace interface {
 	PcapStore() *pcapstorage.Store
 }
@@ -146,8 +145,12 @@ func loadSpaces(p iosrc.URI, conf config, logger *zap.Logger) ([]Space, error) {
 		if err != nil {
 			return nil, err
 		}
+		pcapstore, err := loadPcapStore(datapath)
+		if err != nil {
+			return nil, err
+		}
 		parent := &archiveSpace{
-			spaceBase: spaceBase{id, store, nil, newGuard(), logger},
+			spaceBase: spaceBase{id, store, pcapstore, newGuard(), logger},
 			path:      p,
 			conf:      conf,
 		}
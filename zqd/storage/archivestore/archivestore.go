@@ -2,17 +2,27 @@ package archivestore
 
 import (
 	"context"
+	"errors"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/brimsec/zq/archive"
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
 	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/brimsec/zq/zqd/storage"
+	"github.com/brimsec/zq/zqe"
 )
 
+// followInterval is how often a follow source checks the archive for new
+// imports once it has returned the records of those it knows of.
+const followInterval = time.Second
+
 func Load(path iosrc.URI, cfg *storage.ArchiveConfig) (*Storage, error) {
 	co := &archive.CreateOptions{}
 	if cfg != nil && cfg.CreateOptions != nil {
@@ -46,8 +56,130 @@ func (s *Storage) NativeDirection() zbuf.Direction {
 	return s.ark.DataSortDirection
 }
 
-func (s *Storage) MultiSource() driver.MultiSource {
-	return archive.NewMultiSource(s.ark, nil)
+// MultiSource returns a driver.MultiSource that sends a source for each
+// chunk of the archive, ordered by time in the direction dir.
+func (s *Storage) MultiSource(dir zbuf.Direction) driver.MultiSource {
+	return archive.NewDirectionalMultiSource(s.ark, dir)
+}
+
+// FollowMultiSource is like MultiSource but, after the chunks of the
+// archive, it sends a source that returns the records of each import
+// committed to the archive's journal, by this store or any other writer,
+// as the journal is found to have new entries, until ctx is canceled.
+// The chunks are those of the archive when FollowMultiSource is called
+// and the imports are those committed after them.
+func (s *Storage) FollowMultiSource(ctx context.Context, zctx *resolver.Context, dir zbuf.Direction) driver.MultiSource {
+	spans, commit, err := archive.CommittedSpans(s.ark)
+	return &followSource{
+		MultiSource: archive.NewSpansMultiSource(s.ark, spans, dir),
+		ark:         s.ark,
+		commit:      commit,
+		err:         err,
+	}
+}
+
+type followSource struct {
+	driver.MultiSource
+	ark    *archive.Archive
+	commit int
+	err    error
+}
+
+// OrderInfo reports no order since records written to the store are
+// returned after the chunks of the archive.
+func (f *followSource) OrderInfo() (string, bool) {
+	return "", false
+}
+
+func (f *followSource) SendSources(ctx context.Context, zctx *resolver.Context, sf driver.SourceFilter, c chan driver.SourceOpener) error {
+	if f.err != nil {
+		return f.err
+	}
+	if err := f.MultiSource.SendSources(ctx, zctx, sf, c); err != nil {
+		return err
+	}
+	opener := func() (driver.ScannerCloser, error) {
+		fr := &followReader{
+			ctx:    ctx,
+			zctx:   zctx,
+			ark:    f.ark,
+			commit: f.commit,
+		}
+		sn := scanner.NewSequenceScanner(ctx, fr.next, sf.Filter, sf.FilterExpr, sf.Span)
+		return struct {
+			scanner.Scanner
+			io.Closer
+		}{sn, fr}, nil
+	}
+	select {
+	case c <- opener:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// followReader returns readers of the files holding the records of the
+// imports committed to an archive after commit, checking the archive for
+// new imports every followInterval.
+type followReader struct {
+	ctx    context.Context
+	zctx   *resolver.Context
+	ark    *archive.Archive
+	commit int
+	update int
+	uris   []iosrc.URI
+	cur    io.Closer
+}
+
+// next closes the current reader and returns a reader of the next file,
+// waiting for an import if necessary.  The files of an import that has
+// been rewritten by a later change to the archive are skipped.
+func (f *followReader) next() (zbuf.Reader, error) {
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	for {
+		if len(f.uris) > 0 {
+			u := f.uris[0]
+			f.uris = f.uris[1:]
+			r, err := iosrc.NewReader(u)
+			if errors.Is(err, zqe.E(zqe.NotFound)) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			f.cur = r
+			return zngio.NewReader(r, f.zctx), nil
+		}
+		update, err := f.ark.UpdateCheck()
+		if err != nil {
+			return nil, err
+		}
+		if update != f.update {
+			f.update = update
+			f.uris, f.commit, err = archive.Imported(f.ark, f.commit)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		select {
+		case <-time.After(followInterval):
+		case <-f.ctx.Done():
+			return nil, f.ctx.Err()
+		}
+	}
+}
+
+func (f *followReader) Close() error {
+	if f.cur == nil {
+		return nil
+	}
+	err := f.cur.Close()
+	f.cur = nil
+	return err
 }
 
 func (s *Storage) Summary(_ context.Context) (storage.Summary, error) {
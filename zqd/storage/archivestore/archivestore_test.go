@@ -0,0 +1,129 @@
+package archivestore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/brimsec/zq/driver"
+	"github.com/brimsec/zq/pkg/iosrc"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/tzngio"
+	"github.com/brimsec/zq/zng/resolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTzng(t *testing.T, s *Storage, src string) {
+	zctx := resolver.NewContext()
+	zr := tzngio.NewReader(strings.NewReader(strings.TrimSpace(src)), zctx)
+	require.NoError(t, s.Write(context.Background(), zctx, zr))
+}
+
+// pullTs returns the timestamps of the records of sn until it has read n
+// records or reached its end.
+func pullTs(t *testing.T, sn driver.ScannerCloser, n int) []nano.Ts {
+	var ts []nano.Ts
+	for len(ts) < n {
+		batch, err := sn.Pull()
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		for i := 0; i < batch.Length(); i++ {
+			ts = append(ts, batch.Index(i).Ts())
+		}
+		batch.Unref()
+	}
+	return ts
+}
+
+func loadTemp(t *testing.T) (*Storage, iosrc.URI, func()) {
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	u, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+	s, err := Load(u, nil)
+	require.NoError(t, err)
+	return s, u, func() { os.RemoveAll(dir) }
+}
+
+func TestFollowWriteBeforeSend(t *testing.T) {
+	s, _, done := loadTemp(t)
+	defer done()
+	writeTzng(t, s, `
+#0:record[ts:time]
+0:[1;]
+`)
+
+	// A write that completes after FollowMultiSource but before the
+	// sources are sent is returned by the follow source only.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	zctx := resolver.NewContext()
+	ms := s.FollowMultiSource(ctx, zctx, zbuf.DirTimeForward)
+	writeTzng(t, s, `
+#0:record[ts:time]
+0:[2;]
+`)
+	c := make(chan driver.SourceOpener, 10)
+	sf := driver.SourceFilter{Span: nano.MaxSpan}
+	require.NoError(t, ms.SendSources(ctx, zctx, sf, c))
+	require.Len(t, c, 2)
+
+	sn, err := (<-c)()
+	require.NoError(t, err)
+	assert.Equal(t, []nano.Ts{1e9}, pullTs(t, sn, 2))
+	require.NoError(t, sn.Close())
+
+	sn, err = (<-c)()
+	require.NoError(t, err)
+	assert.Equal(t, []nano.Ts{2e9}, pullTs(t, sn, 1))
+	cancel()
+	sn.Close()
+}
+
+func TestFollowOtherWriter(t *testing.T) {
+	s, u, done := loadTemp(t)
+	defer done()
+	writeTzng(t, s, `
+#0:record[ts:time]
+0:[1;]
+0:[3;]
+`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	zctx := resolver.NewContext()
+	ms := s.FollowMultiSource(ctx, zctx, zbuf.DirTimeForward)
+	c := make(chan driver.SourceOpener, 10)
+	sf := driver.SourceFilter{Span: nano.MaxSpan}
+	require.NoError(t, ms.SendSources(ctx, zctx, sf, c))
+	require.Len(t, c, 2)
+	sn, err := (<-c)()
+	require.NoError(t, err)
+	assert.Equal(t, []nano.Ts{1e9, 3e9}, pullTs(t, sn, 3))
+	require.NoError(t, sn.Close())
+
+	// Records written by another writer to the archive are returned, and
+	// those merged into an existing chunk are returned without the
+	// records of the chunk.
+	other, err := Load(u, nil)
+	require.NoError(t, err)
+	writeTzng(t, other, `
+#0:record[ts:time]
+0:[2;]
+`)
+	writeTzng(t, other, `
+#0:record[ts:time]
+0:[4;]
+`)
+	sn, err = (<-c)()
+	require.NoError(t, err)
+	assert.Equal(t, []nano.Ts{2e9, 4e9}, pullTs(t, sn, 2))
+	cancel()
+	sn.Close()
+}
@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/brimsec/zq/ast"
+	"github.com/brimsec/zq/filter"
+	"github.com/brimsec/zq/pkg/fs"
+	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/scanner"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// A Feed delivers the records written to a store to the searches that
+// follow it.  The zero value is an empty Feed ready to use.
+type Feed struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// Subscribe returns a Subscription to the records published to f from now
+// on.  The subscription is closed when ctx is canceled.
+func (f *Feed) Subscribe(ctx context.Context, zctx *resolver.Context) *Subscription {
+	s := &Subscription{
+		feed:  f,
+		ctx:   ctx,
+		zctx:  zctx,
+		ready: make(chan struct{}, 1),
+	}
+	f.mu.Lock()
+	if f.subs == nil {
+		f.subs = make(map[*Subscription]struct{})
+	}
+	f.subs[s] = struct{}{}
+	f.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		s.Close()
+	}()
+	return s
+}
+
+// Followed returns true if f has any subscriptions.
+func (f *Feed) Followed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.subs) > 0
+}
+
+// Publish sends the records of the zng file at path to each subscription.
+// The subscriptions hold the file open until they have read it, so it may
+// be removed once Publish returns.
+func (f *Feed) Publish(path string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for s := range f.subs {
+		file, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		s.push(file)
+	}
+	return nil
+}
+
+// A Subscription queues the files published to a Feed until they are
+// read.
+type Subscription struct {
+	feed  *Feed
+	ctx   context.Context
+	zctx  *resolver.Context
+	ready chan struct{}
+
+	mu     sync.Mutex
+	files  []*os.File
+	closed bool
+}
+
+func (s *Subscription) push(f *os.File) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		f.Close()
+		return
+	}
+	s.files = append(s.files, f)
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next returns a reader of the next published file, waiting for one to be
+// published if necessary.
+func (s *Subscription) next() (zbuf.ReadCloser, error) {
+	for {
+		s.mu.Lock()
+		if len(s.files) > 0 {
+			f := s.files[0]
+			s.files = s.files[1:]
+			s.mu.Unlock()
+			return zbuf.NewReadCloser(zngio.NewReader(f, s.zctx), f), nil
+		}
+		s.mu.Unlock()
+		select {
+		case <-s.ready:
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+}
+
+// Close removes s from its Feed and closes any files it has not read.
+func (s *Subscription) Close() error {
+	s.feed.mu.Lock()
+	delete(s.feed.subs, s)
+	s.feed.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.files {
+		f.Close()
+	}
+	s.files = nil
+	s.closed = true
+	return nil
+}
+
+type followReader struct {
+	first zbuf.ReadCloser
+	cur   zbuf.ReadCloser
+	sub   *Subscription
+}
+
+var _ scanner.ScannerAble = (*followReader)(nil)
+
+// NewFollowReader returns a reader of the records of zr, if it is not nil,
+// followed by those published to sub, as they are published, until the
+// context of sub is canceled.  Closing the reader closes zr and sub.
+func NewFollowReader(zr zbuf.ReadCloser, sub *Subscription) zbuf.ReadCloser {
+	return &followReader{first: zr, sub: sub}
+}
+
+func (f *followReader) Read() (*zng.Record, error) {
+	for {
+		if f.cur == nil {
+			if _, err := f.next(); err != nil {
+				return nil, err
+			}
+		}
+		rec, err := f.cur.Read()
+		if rec != nil || err != nil {
+			return rec, err
+		}
+		err = f.cur.Close()
+		f.cur = nil
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// next closes the current reader and returns the next one.
+func (f *followReader) next() (zbuf.Reader, error) {
+	if f.cur != nil {
+		err := f.cur.Close()
+		f.cur = nil
+		if err != nil {
+			return nil, err
+		}
+	}
+	if f.first != nil {
+		f.cur, f.first = f.first, nil
+		return f.cur, nil
+	}
+	zr, err := f.sub.next()
+	if err != nil {
+		return nil, err
+	}
+	f.cur = zr
+	return zr, nil
+}
+
+func (f *followReader) NewScanner(ctx context.Context, filt filter.Filter, filterExpr ast.BooleanExpr, s nano.Span) (scanner.Scanner, error) {
+	return scanner.NewSequenceScanner(ctx, f.next, filt, filterExpr, s), nil
+}
+
+func (f *followReader) Close() error {
+	var err error
+	for _, zr := range []zbuf.ReadCloser{f.first, f.cur} {
+		if zr != nil {
+			if closeErr := zr.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}
+	f.first, f.cur = nil, nil
+	if closeErr := f.sub.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/brimsec/zq/driver"
 	"github.com/brimsec/zq/pkg/fs"
@@ -27,6 +28,7 @@ import (
 const (
 	allZngFile = "all.zng"
 	infoFile   = "info.json"
+	tempPrefix = ".tmp-" + allZngFile
 
 	defaultStreamSize = 5000
 )
@@ -46,19 +48,38 @@ func Load(path iosrc.URI) (*Storage, error) {
 		index:      zngio.NewTimeIndex(),
 		streamsize: defaultStreamSize,
 		wsem:       semaphore.NewWeighted(1),
+		staged:     make(map[string]stagedFile),
+	}
+	if err := s.removeTempFiles(); err != nil {
+		return nil, err
 	}
 	return s, s.readInfoFile()
 }
 
-// Storage stores data as a single zng file; this is the default
-// storage choice for Brim, where its intended to be a write-once
-// import of data.
+// Storage stores data as a single zng file sorted by time; this is the
+// default storage choice for Brim.  Each write merges its records with
+// those already in the file, so logs may be added to a space over time.
+//
+// Records may also be staged apart from the data file, as a pcap ingest
+// does with its snapshots.  Staged records are read along with the data
+// file until they are committed to it or discarded.
 type Storage struct {
 	path       string
-	span       nano.Span
-	index      *zngio.TimeIndex
 	streamsize int
 	wsem       *semaphore.Weighted
+	feed       storage.Feed
+
+	// mu guards the data file and its index, which are replaced
+	// together when records are written, and the staged files.
+	mu     sync.Mutex
+	span   nano.Span
+	index  *zngio.TimeIndex
+	staged map[string]stagedFile
+}
+
+type stagedFile struct {
+	path string
+	span nano.Span
 }
 
 func (s *Storage) NativeDirection() zbuf.Direction {
@@ -70,33 +91,123 @@ func (s *Storage) join(args ...string) string {
 	return filepath.Join(args...)
 }
 
-func (s *Storage) Open(_ context.Context, zctx *resolver.Context, span nano.Span) (zbuf.ReadCloser, error) {
-	f, err := fs.Open(s.join(allZngFile))
+func (s *Storage) bzngFile() string {
+	return strings.TrimSuffix(allZngFile, filepath.Ext(allZngFile)) + ".bzng"
+}
+
+// Open returns a reader for the records of the space that fall within span,
+// sorted by time in the direction dir.  Staged records are included.
+func (s *Storage) Open(_ context.Context, zctx *resolver.Context, span nano.Span, dir zbuf.Direction) (zbuf.ReadCloser, error) {
+	s.mu.Lock()
+	f, err := s.openDataFile()
+	index := s.index
+	var staged []*os.File
+	if err == nil {
+		staged, err = s.openStagedFiles()
+	}
+	s.mu.Unlock()
 	if err != nil {
-		if !os.IsNotExist(err) {
+		return nil, err
+	}
+	zr, err := s.open(f, index, zctx, span, dir)
+	if err != nil || len(staged) == 0 {
+		return zr, err
+	}
+	readers := []zbuf.Reader{zr}
+	for k, f := range staged {
+		r, err := s.open(f, zngio.NewTimeIndex(), zctx, span, dir)
+		if err != nil {
+			for _, f := range staged[k:] {
+				f.Close()
+			}
+			zbuf.NewCombiner(readers, nil).Close()
 			return nil, err
 		}
+		readers = append(readers, r)
+	}
+	return zbuf.NewCombiner(readers, zbuf.RecordCompare(dir)), nil
+}
+
+// Follow is like Open but the returned reader, after the records of the
+// space within span, waits for records to be written to the space and
+// returns them as they are written, until ctx is canceled.  The records
+// of each write are sorted in the native direction of the space.  Staged
+// records are not included until they are committed.
+func (s *Storage) Follow(ctx context.Context, zctx *resolver.Context, span nano.Span, dir zbuf.Direction) (zbuf.ReadCloser, error) {
+	// Subscribe while holding the lock so that each write is either
+	// in the data file or published to the subscription but not both.
+	s.mu.Lock()
+	f, err := s.openDataFile()
+	index := s.index
+	var sub *storage.Subscription
+	if err == nil {
+		sub = s.feed.Subscribe(ctx, zctx)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	zr, err := s.open(f, index, zctx, span, dir)
+	if err != nil {
+		sub.Close()
+		return nil, err
+	}
+	return storage.NewFollowReader(zr, sub), nil
+}
+
+func (s *Storage) open(f *os.File, index *zngio.TimeIndex, zctx *resolver.Context, span nano.Span, dir zbuf.Direction) (zbuf.ReadCloser, error) {
+	if f == nil {
+		r := zngio.NewReader(strings.NewReader(""), zctx)
+		return zbuf.NopReadCloser(r), nil
+	}
+	if dir != s.NativeDirection() {
+		return index.NewReverseReader(f, zctx, span)
+	}
+	return index.NewReader(f, zctx, span)
+}
+
+// openDataFile opens the data file of the space, or returns a nil file if
+// there is none.
+func (s *Storage) openDataFile() (*os.File, error) {
+	f, err := fs.Open(s.join(allZngFile))
+	if err == nil || !os.IsNotExist(err) {
+		return f, err
+	}
+	// Couldn't read all.zng, check for an old space with all.bzng
+	f, err = fs.Open(s.join(s.bzngFile()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return f, nil
+}
 
-		// Couldn't read all.zng, check for an old space with all.bzng
-		bzngFile := strings.TrimSuffix(allZngFile, filepath.Ext(allZngFile)) + ".bzng"
-		f, err = fs.Open(s.join(bzngFile))
+// openStagedFiles opens the staged files of the space.  s.mu must be held.
+func (s *Storage) openStagedFiles() ([]*os.File, error) {
+	var files []*os.File
+	for _, sf := range s.staged {
+		f, err := fs.Open(sf.path)
 		if err != nil {
-			if !os.IsNotExist(err) {
-				return nil, err
+			for _, f := range files {
+				f.Close()
 			}
-			r := zngio.NewReader(strings.NewReader(""), zctx)
-			return zbuf.NopReadCloser(r), nil
+			return nil, err
 		}
+		files = append(files, f)
 	}
-	return s.index.NewReader(f, zctx, span)
+	return files, nil
 }
 
 type spanWriter struct {
 	span   nano.Span
 	writes bool
+	count  int
 }
 
 func (w *spanWriter) Write(rec *zng.Record) error {
+	w.count++
 	if rec.Ts() == 0 {
 		return nil
 	}
@@ -111,39 +222,211 @@ func (w *spanWriter) Write(rec *zng.Record) error {
 	return nil
 }
 
+// Write adds the records of zr to the space.  The records are sorted and
+// then merged with any records already in the space, and the span of the
+// space is extended to cover them.  The records are also published to
+// any readers following the space.
 func (s *Storage) Write(ctx context.Context, zctx *resolver.Context, zr zbuf.Reader) error {
 	if !s.wsem.TryAcquire(1) {
 		return zqe.E(zqe.Conflict, ErrWriteInProgress)
 	}
 	defer s.wsem.Release(1)
+	return s.add(ctx, zctx, zr, "")
+}
 
+// add writes the records of zr to the space as described for Write and,
+// when the new data file is in place, discards the records staged under
+// id.  The caller must hold s.wsem.
+func (s *Storage) add(ctx context.Context, zctx *resolver.Context, zr zbuf.Reader, id string) error {
 	spanWriter := &spanWriter{}
-	if err := fs.ReplaceFile(s.join(allZngFile), 0600, func(w io.Writer) error {
-		fileWriter := zngio.NewWriter(w, zio.WriterFlags{
-			StreamRecordsMax: s.streamsize,
-			ZngLZ4BlockSize:  zio.DefaultZngLZ4BlockSize,
-		})
-		zw := zbuf.MultiWriter(fileWriter, spanWriter)
-		if err := s.write(ctx, zw, zctx, zr); err != nil {
+	sorted, err := s.writeTempFile(func(zw zbuf.Writer) error {
+		return s.write(ctx, zbuf.MultiWriter(zw, spanWriter), zctx, zr)
+	})
+	if err != nil {
+		return err
+	}
+	defer os.Remove(sorted)
+	if spanWriter.count == 0 {
+		return s.Discard(id)
+	}
+
+	s.mu.Lock()
+	f, err := s.openDataFile()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	merged := sorted
+	if f != nil {
+		merged, err = s.merge(ctx, zctx, f, sorted)
+		f.Close()
+		if err != nil {
 			return err
 		}
-		return fileWriter.Flush()
-	}); err != nil {
+		defer os.Remove(merged)
+	}
+	if err := s.replaceDataFile(merged, sorted, id); err != nil {
 		return err
 	}
-
 	if !spanWriter.writes {
 		return nil
 	}
-
 	return s.extendSpan(spanWriter.span)
 }
 
+// Stage replaces the records staged under id with the records of zr.
+// Staged records are returned by Open but are not added to the space
+// until Commit is called.
+func (s *Storage) Stage(ctx context.Context, id string, zctx *resolver.Context, zr zbuf.Reader) error {
+	spanWriter := &spanWriter{}
+	path, err := s.writeTempFile(func(zw zbuf.Writer) error {
+		return s.write(ctx, zbuf.MultiWriter(zw, spanWriter), zctx, zr)
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.discard(id); err != nil {
+		os.Remove(path)
+		return err
+	}
+	s.staged[id] = stagedFile{path: path, span: spanWriter.span}
+	return nil
+}
+
+// Commit adds the records staged under id to the space as Write does.
+// Unlike Write, it waits for any ongoing write to complete.
+func (s *Storage) Commit(ctx context.Context, id string) error {
+	if err := s.wsem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer s.wsem.Release(1)
+	s.mu.Lock()
+	sf, ok := s.staged[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	f, err := fs.Open(sf.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zctx := resolver.NewContext()
+	return s.add(ctx, zctx, zngio.NewReader(f, zctx), id)
+}
+
+// Discard drops the records staged under id.
+func (s *Storage) Discard(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.discard(id)
+}
+
+func (s *Storage) discard(id string) error {
+	sf, ok := s.staged[id]
+	if !ok {
+		return nil
+	}
+	delete(s.staged, id)
+	return os.Remove(sf.path)
+}
+
 func (s *Storage) write(ctx context.Context, zw zbuf.Writer, zctx *resolver.Context, zr zbuf.Reader) error {
 	d := &zngdriver{zw}
 	return driver.Run(ctx, d, zngWriteProc, zctx, zr, driver.Config{})
 }
 
+// merge writes the records of the open data file f and the sorted file at
+// path to a new temporary file, preserving their sort order, and returns
+// the path of the new file.
+func (s *Storage) merge(ctx context.Context, zctx *resolver.Context, f *os.File, path string) (string, error) {
+	sorted, err := fs.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer sorted.Close()
+	readers := []zbuf.Reader{
+		zngio.NewReader(f, zctx),
+		zngio.NewReader(sorted, zctx),
+	}
+	combiner := zbuf.NewCombiner(readers, zbuf.RecordCompare(s.NativeDirection()))
+	return s.writeTempFile(func(zw zbuf.Writer) error {
+		return zbuf.CopyWithContext(ctx, zw, combiner)
+	})
+}
+
+// writeTempFile calls fn to write records to a new temporary file in the
+// storage directory and returns the path of the file.  The file is removed
+// if fn fails.
+func (s *Storage) writeTempFile(fn func(zbuf.Writer) error) (string, error) {
+	f, err := ioutil.TempFile(s.path, tempPrefix)
+	if err != nil {
+		return "", err
+	}
+	w := zngio.NewWriter(f, zio.WriterFlags{
+		StreamRecordsMax: s.streamsize,
+		ZngLZ4BlockSize:  zio.DefaultZngLZ4BlockSize,
+	})
+	err = fn(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// removeTempFiles removes the temporary files left in the storage
+// directory by a process that exited before it could commit, discard, or
+// rename them.  Staged records do not survive a restart, so any such file
+// is stale when the space is loaded.
+func (s *Storage) removeTempFiles() error {
+	paths, err := filepath.Glob(s.join(tempPrefix + "*"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceDataFile renames the file at path to the data file of the space
+// and resets the time index so that it is rebuilt for the new file.  The
+// records staged under id, which the new file now holds, are discarded.
+// Added is the path of a file holding the records added to the space,
+// which are published to the followers of the space.
+func (s *Storage) replaceDataFile(path, added, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Rename(path, s.join(allZngFile)); err != nil {
+		return err
+	}
+	s.index = zngio.NewTimeIndex()
+	if err := s.discard(id); err != nil {
+		return err
+	}
+	if added == path {
+		added = s.join(allZngFile)
+	}
+	if err := s.feed.Publish(added); err != nil {
+		return err
+	}
+	if err := os.Remove(s.join(s.bzngFile())); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // Clear wipes all data from storage. Will wait for any ongoing write operations
 // are complete before doing this.
 func (s *Storage) Clear(ctx context.Context) error {
@@ -151,26 +434,42 @@ func (s *Storage) Clear(ctx context.Context) error {
 		return err
 	}
 	defer s.wsem.Release(1)
-	if err := os.Remove(s.join(allZngFile)); err != nil && !os.IsNotExist(err) {
+	s.mu.Lock()
+	err := os.Remove(s.join(allZngFile))
+	s.index = zngio.NewTimeIndex()
+	for id := range s.staged {
+		if e := s.discard(id); e != nil && err == nil {
+			err = e
+		}
+	}
+	s.mu.Unlock()
+	if err != nil && !os.IsNotExist(err) {
 		return err
 	}
 	return s.SetSpan(nano.Span{})
 }
 
 func (s *Storage) extendSpan(span nano.Span) error {
-	// XXX This is not thread safe and it should be.
-	first := s.span == nano.Span{}
-	if first {
-		s.span = span
-	} else {
-		s.span = s.span.Union(span)
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.span = unionSpan(s.span, span)
 	return s.syncInfoFile()
+}
 
+// unionSpan returns the union of a and b, treating a zero span as empty.
+func unionSpan(a, b nano.Span) nano.Span {
+	if a == (nano.Span{}) {
+		return b
+	}
+	if b == (nano.Span{}) {
+		return a
+	}
+	return a.Union(b)
 }
 
 func (s *Storage) SetSpan(span nano.Span) error {
-	// XXX This is not thread safe and it should be.
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.span = span
 	return s.syncInfoFile()
 }
@@ -185,8 +484,15 @@ func (s *Storage) Summary(_ context.Context) (storage.Summary, error) {
 	} else {
 		sum.DataBytes = f.Size()
 	}
-	// XXX This is not thread safe and it should be.
+	s.mu.Lock()
 	sum.Span = s.span
+	for _, sf := range s.staged {
+		sum.Span = unionSpan(sum.Span, sf.span)
+		if fi, err := os.Stat(sf.path); err == nil {
+			sum.DataBytes += fi.Size()
+		}
+	}
+	s.mu.Unlock()
 	sum.Kind = storage.FileStore
 	return sum, nil
 }
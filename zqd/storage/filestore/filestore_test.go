@@ -5,12 +5,16 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/brimsec/zq/pkg/iosrc"
 	"github.com/brimsec/zq/pkg/nano"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio/tzngio"
 	"github.com/brimsec/zq/zng"
 	"github.com/brimsec/zq/zng/resolver"
 	"github.com/stretchr/testify/require"
@@ -78,3 +82,146 @@ func TestWriteNoRecords(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sp, sum.Span)
 }
+
+func writeTzng(t *testing.T, store *Storage, zctx *resolver.Context, lines ...string) {
+	r := tzngio.NewReader(strings.NewReader(strings.Join(lines, "\n")), zctx)
+	require.NoError(t, store.Write(context.Background(), zctx, r))
+}
+
+// readTimes returns the timestamps in seconds of the records in store.
+func readTimes(t *testing.T, store *Storage, dir zbuf.Direction) []int64 {
+	r, err := store.Open(context.Background(), resolver.NewContext(), nano.MaxSpan, dir)
+	require.NoError(t, err)
+	defer r.Close()
+	var ts []int64
+	for {
+		rec, err := r.Read()
+		require.NoError(t, err)
+		if rec == nil {
+			return ts
+		}
+		ts = append(ts, int64(rec.Ts()/1e9))
+	}
+}
+
+func TestWriteAppends(t *testing.T) {
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+	u, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+	store, err := Load(u)
+	require.NoError(t, err)
+	store.streamsize = 2
+
+	zctx := resolver.NewContext()
+	writeTzng(t, store, zctx,
+		"#0:record[ts:time,v:int64]",
+		"0:[2;1;]",
+		"0:[4;2;]",
+		"0:[6;3;]",
+	)
+	// Read the space so that its time index is built.
+	require.Equal(t, []int64{6, 4, 2}, readTimes(t, store, zbuf.DirTimeReverse))
+	require.Equal(t, []int64{2, 4, 6}, readTimes(t, store, zbuf.DirTimeForward))
+
+	writeTzng(t, store, resolver.NewContext(),
+		"#0:record[ts:time,s:string]",
+		"0:[7;a;]",
+		"0:[1;b;]",
+		"0:[5;c;]",
+	)
+	require.Equal(t, []int64{7, 6, 5, 4, 2, 1}, readTimes(t, store, zbuf.DirTimeReverse))
+	require.Equal(t, []int64{1, 2, 4, 5, 6, 7}, readTimes(t, store, zbuf.DirTimeForward))
+
+	sum, err := store.Summary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, nano.NewSpanTs(1e9, 7e9+1), sum.Span)
+
+	files, err := filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func stageTzng(t *testing.T, store *Storage, id string, lines ...string) {
+	zctx := resolver.NewContext()
+	r := tzngio.NewReader(strings.NewReader(strings.Join(lines, "\n")), zctx)
+	require.NoError(t, store.Stage(context.Background(), id, zctx, r))
+}
+
+func TestStage(t *testing.T) {
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+	u, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+	store, err := Load(u)
+	require.NoError(t, err)
+
+	writeTzng(t, store, resolver.NewContext(),
+		"#0:record[ts:time]",
+		"0:[2;]",
+		"0:[4;]",
+	)
+
+	// Staged records are read with the space, and each stage under an
+	// ID replaces the previous one.
+	stageTzng(t, store, "a", "#0:record[ts:time]", "0:[3;]")
+	stageTzng(t, store, "a", "#0:record[ts:time]", "0:[3;]", "0:[5;]")
+	stageTzng(t, store, "b", "#0:record[ts:time]", "0:[1;]")
+	require.Equal(t, []int64{5, 4, 3, 2, 1}, readTimes(t, store, zbuf.DirTimeReverse))
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, readTimes(t, store, zbuf.DirTimeForward))
+	sum, err := store.Summary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, nano.NewSpanTs(1e9, 5e9+1), sum.Span)
+
+	require.NoError(t, store.Discard("b"))
+	require.Equal(t, []int64{5, 4, 3, 2}, readTimes(t, store, zbuf.DirTimeReverse))
+
+	require.NoError(t, store.Commit(context.Background(), "a"))
+	require.Equal(t, []int64{5, 4, 3, 2}, readTimes(t, store, zbuf.DirTimeReverse))
+	sum, err = store.Summary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, nano.NewSpanTs(2e9, 5e9+1), sum.Span)
+
+	// Nothing remains staged.
+	require.NoError(t, store.Discard("a"))
+	require.Equal(t, []int64{5, 4, 3, 2}, readTimes(t, store, zbuf.DirTimeReverse))
+	files, err := filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestLoadRemovesStaged(t *testing.T) {
+	dir, err := ioutil.TempDir("", t.Name())
+	require.NoError(t, err)
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+	u, err := iosrc.ParseURI(dir)
+	require.NoError(t, err)
+	store, err := Load(u)
+	require.NoError(t, err)
+
+	writeTzng(t, store, resolver.NewContext(),
+		"#0:record[ts:time]",
+		"0:[2;]",
+	)
+	// Records staged by a process that exits without committing or
+	// discarding them are left behind until the space is loaded again.
+	stageTzng(t, store, "a", "#0:record[ts:time]", "0:[3;]")
+	files, err := filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	store, err = Load(u)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, readTimes(t, store, zbuf.DirTimeReverse))
+	files, err = filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -0,0 +1,69 @@
+package zeek
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/brimsec/zq/pcap/flows"
+	"github.com/brimsec/zq/pcap/pcapio"
+	"github.com/brimsec/zq/pkg/ctxio"
+	"github.com/brimsec/zq/zbuf"
+	"github.com/brimsec/zq/zio"
+	"github.com/brimsec/zq/zio/zngio"
+	"github.com/brimsec/zq/zng/resolver"
+)
+
+// FlowLauncher is a Launcher that, rather than running zeek, summarizes the
+// pcap into conn-like flow records using package flows.  The records are
+// written as ZNG to conn.log in the output directory, which appears only
+// once the entire pcap has been read.
+func FlowLauncher(ctx context.Context, r io.Reader, dir string) (Process, error) {
+	p := &flowProcess{done: make(chan struct{})}
+	go func() {
+		p.err = writeFlows(ctx, r, dir)
+		close(p.done)
+	}()
+	return p, nil
+}
+
+type flowProcess struct {
+	done chan struct{}
+	err  error
+}
+
+func (p *flowProcess) Wait() error {
+	<-p.done
+	return p.err
+}
+
+func writeFlows(ctx context.Context, r io.Reader, dir string) error {
+	reader, err := pcapio.NewReader(ctxio.NewReader(ctx, r))
+	if err != nil {
+		return err
+	}
+	// Write to a name that the ingest process does not pick up and
+	// rename once complete so a partial log is never read.
+	tmp := filepath.Join(dir, "conn.log.tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := zngio.NewWriter(f, zio.WriterFlags{ZngLZ4BlockSize: zio.DefaultZngLZ4BlockSize})
+	if err := zbuf.Copy(w, flows.NewReader(resolver.NewContext(), reader, 0)); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, "conn.log"))
+}
@@ -125,11 +125,12 @@ func parseInt(v interface{}) interface{} {
 
 func parseFloat(v interface{}) interface{} {
 	num := v.(string)
-	if f, err := strconv.ParseFloat(num, 10); err != nil {
-		return f
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return nil
 	}
 
-	return nil
+	return f
 }
 
 func OR(a, b interface{}) interface{} {
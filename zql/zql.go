@@ -79,33 +79,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 18, col: 5, offset: 358},
+						pos: position{line: 18, col: 5, offset: 357},
 						run: (*parser).callonquery5,
 						expr: &seqExpr{
-							pos: position{line: 18, col: 5, offset: 358},
+							pos: position{line: 18, col: 5, offset: 357},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 18, col: 5, offset: 358},
+									pos:   position{line: 18, col: 5, offset: 357},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 18, col: 7, offset: 360},
+										pos:  position{line: 18, col: 7, offset: 359},
 										name: "search",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 18, col: 14, offset: 367},
+									pos: position{line: 18, col: 14, offset: 366},
 									expr: &ruleRefExpr{
-										pos:  position{line: 18, col: 14, offset: 367},
+										pos:  position{line: 18, col: 14, offset: 366},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 18, col: 17, offset: 370},
+									pos:   position{line: 18, col: 17, offset: 369},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 18, col: 22, offset: 375},
+										pos: position{line: 18, col: 22, offset: 374},
 										expr: &ruleRefExpr{
-											pos:  position{line: 18, col: 22, offset: 375},
+											pos:  position{line: 18, col: 22, offset: 374},
 											name: "chainedProc",
 										},
 									},
@@ -114,13 +114,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 25, col: 5, offset: 623},
+						pos: position{line: 25, col: 5, offset: 621},
 						run: (*parser).callonquery14,
 						expr: &labeledExpr{
-							pos:   position{line: 25, col: 5, offset: 623},
+							pos:   position{line: 25, col: 5, offset: 621},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 25, col: 7, offset: 625},
+								pos:  position{line: 25, col: 7, offset: 623},
 								name: "search",
 							},
 						},
@@ -130,28 +130,28 @@ var g = &grammar{
 		},
 		{
 			name: "procChain",
-			pos:  position{line: 29, col: 1, offset: 733},
+			pos:  position{line: 29, col: 1, offset: 731},
 			expr: &actionExpr{
-				pos: position{line: 30, col: 5, offset: 747},
+				pos: position{line: 30, col: 5, offset: 745},
 				run: (*parser).callonprocChain1,
 				expr: &seqExpr{
-					pos: position{line: 30, col: 5, offset: 747},
+					pos: position{line: 30, col: 5, offset: 745},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 30, col: 5, offset: 747},
+							pos:   position{line: 30, col: 5, offset: 745},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 30, col: 11, offset: 753},
+								pos:  position{line: 30, col: 11, offset: 751},
 								name: "proc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 30, col: 16, offset: 758},
+							pos:   position{line: 30, col: 16, offset: 756},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 30, col: 21, offset: 763},
+								pos: position{line: 30, col: 21, offset: 761},
 								expr: &ruleRefExpr{
-									pos:  position{line: 30, col: 21, offset: 763},
+									pos:  position{line: 30, col: 21, offset: 761},
 									name: "chainedProc",
 								},
 							},
@@ -162,37 +162,37 @@ var g = &grammar{
 		},
 		{
 			name: "chainedProc",
-			pos:  position{line: 38, col: 1, offset: 949},
+			pos:  position{line: 38, col: 1, offset: 946},
 			expr: &actionExpr{
-				pos: position{line: 38, col: 15, offset: 963},
+				pos: position{line: 38, col: 15, offset: 960},
 				run: (*parser).callonchainedProc1,
 				expr: &seqExpr{
-					pos: position{line: 38, col: 15, offset: 963},
+					pos: position{line: 38, col: 15, offset: 960},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 38, col: 15, offset: 963},
+							pos: position{line: 38, col: 15, offset: 960},
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 15, offset: 963},
+								pos:  position{line: 38, col: 15, offset: 960},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 38, col: 18, offset: 966},
+							pos:        position{line: 38, col: 18, offset: 963},
 							val:        "|",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 38, col: 22, offset: 970},
+							pos: position{line: 38, col: 22, offset: 967},
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 22, offset: 970},
+								pos:  position{line: 38, col: 22, offset: 967},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 38, col: 25, offset: 973},
+							pos:   position{line: 38, col: 25, offset: 970},
 							label: "p",
 							expr: &ruleRefExpr{
-								pos:  position{line: 38, col: 27, offset: 975},
+								pos:  position{line: 38, col: 27, offset: 972},
 								name: "proc",
 							},
 						},
@@ -202,15 +202,15 @@ var g = &grammar{
 		},
 		{
 			name: "search",
-			pos:  position{line: 40, col: 1, offset: 999},
+			pos:  position{line: 40, col: 1, offset: 996},
 			expr: &actionExpr{
-				pos: position{line: 41, col: 5, offset: 1010},
+				pos: position{line: 41, col: 5, offset: 1007},
 				run: (*parser).callonsearch1,
 				expr: &labeledExpr{
-					pos:   position{line: 41, col: 5, offset: 1010},
+					pos:   position{line: 41, col: 5, offset: 1007},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 41, col: 10, offset: 1015},
+						pos:  position{line: 41, col: 10, offset: 1012},
 						name: "searchExpr",
 					},
 				},
@@ -218,28 +218,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchExpr",
-			pos:  position{line: 45, col: 1, offset: 1112},
+			pos:  position{line: 45, col: 1, offset: 1109},
 			expr: &actionExpr{
-				pos: position{line: 46, col: 5, offset: 1127},
+				pos: position{line: 46, col: 5, offset: 1124},
 				run: (*parser).callonsearchExpr1,
 				expr: &seqExpr{
-					pos: position{line: 46, col: 5, offset: 1127},
+					pos: position{line: 46, col: 5, offset: 1124},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 46, col: 5, offset: 1127},
+							pos:   position{line: 46, col: 5, offset: 1124},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 46, col: 11, offset: 1133},
+								pos:  position{line: 46, col: 11, offset: 1130},
 								name: "searchTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 46, col: 22, offset: 1144},
+							pos:   position{line: 46, col: 22, offset: 1141},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 46, col: 27, offset: 1149},
+								pos: position{line: 46, col: 27, offset: 1146},
 								expr: &ruleRefExpr{
-									pos:  position{line: 46, col: 27, offset: 1149},
+									pos:  position{line: 46, col: 27, offset: 1146},
 									name: "oredSearchTerm",
 								},
 							},
@@ -250,30 +250,30 @@ var g = &grammar{
 		},
 		{
 			name: "oredSearchTerm",
-			pos:  position{line: 50, col: 1, offset: 1228},
+			pos:  position{line: 50, col: 1, offset: 1225},
 			expr: &actionExpr{
-				pos: position{line: 50, col: 18, offset: 1245},
+				pos: position{line: 50, col: 18, offset: 1242},
 				run: (*parser).callonoredSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 50, col: 18, offset: 1245},
+					pos: position{line: 50, col: 18, offset: 1242},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 18, offset: 1245},
+							pos:  position{line: 50, col: 18, offset: 1242},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 20, offset: 1247},
+							pos:  position{line: 50, col: 20, offset: 1244},
 							name: "orToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 28, offset: 1255},
+							pos:  position{line: 50, col: 28, offset: 1252},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 50, col: 30, offset: 1257},
+							pos:   position{line: 50, col: 30, offset: 1254},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 50, col: 32, offset: 1259},
+								pos:  position{line: 50, col: 32, offset: 1256},
 								name: "searchTerm",
 							},
 						},
@@ -283,28 +283,28 @@ var g = &grammar{
 		},
 		{
 			name: "searchTerm",
-			pos:  position{line: 52, col: 1, offset: 1289},
+			pos:  position{line: 52, col: 1, offset: 1286},
 			expr: &actionExpr{
-				pos: position{line: 53, col: 5, offset: 1304},
+				pos: position{line: 53, col: 5, offset: 1301},
 				run: (*parser).callonsearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 53, col: 5, offset: 1304},
+					pos: position{line: 53, col: 5, offset: 1301},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 53, col: 5, offset: 1304},
+							pos:   position{line: 53, col: 5, offset: 1301},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 53, col: 11, offset: 1310},
+								pos:  position{line: 53, col: 11, offset: 1307},
 								name: "searchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 53, col: 24, offset: 1323},
+							pos:   position{line: 53, col: 24, offset: 1320},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 53, col: 29, offset: 1328},
+								pos: position{line: 53, col: 29, offset: 1325},
 								expr: &ruleRefExpr{
-									pos:  position{line: 53, col: 29, offset: 1328},
+									pos:  position{line: 53, col: 29, offset: 1325},
 									name: "andedSearchTerm",
 								},
 							},
@@ -315,38 +315,38 @@ var g = &grammar{
 		},
 		{
 			name: "andedSearchTerm",
-			pos:  position{line: 57, col: 1, offset: 1409},
+			pos:  position{line: 57, col: 1, offset: 1406},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 19, offset: 1427},
+				pos: position{line: 57, col: 19, offset: 1424},
 				run: (*parser).callonandedSearchTerm1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 19, offset: 1427},
+					pos: position{line: 57, col: 19, offset: 1424},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 19, offset: 1427},
+							pos:  position{line: 57, col: 19, offset: 1424},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 57, col: 21, offset: 1429},
+							pos: position{line: 57, col: 21, offset: 1426},
 							expr: &seqExpr{
-								pos: position{line: 57, col: 22, offset: 1430},
+								pos: position{line: 57, col: 22, offset: 1427},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 57, col: 22, offset: 1430},
+										pos:  position{line: 57, col: 22, offset: 1427},
 										name: "andToken",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 57, col: 31, offset: 1439},
+										pos:  position{line: 57, col: 31, offset: 1436},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 35, offset: 1443},
+							pos:   position{line: 57, col: 35, offset: 1440},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 37, offset: 1445},
+								pos:  position{line: 57, col: 37, offset: 1442},
 								name: "searchFactor",
 							},
 						},
@@ -356,44 +356,44 @@ var g = &grammar{
 		},
 		{
 			name: "searchFactor",
-			pos:  position{line: 59, col: 1, offset: 1477},
+			pos:  position{line: 59, col: 1, offset: 1474},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 5, offset: 1494},
+				pos: position{line: 60, col: 5, offset: 1491},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 60, col: 5, offset: 1494},
+						pos: position{line: 60, col: 5, offset: 1491},
 						run: (*parser).callonsearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 60, col: 5, offset: 1494},
+							pos: position{line: 60, col: 5, offset: 1491},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 60, col: 6, offset: 1495},
+									pos: position{line: 60, col: 6, offset: 1492},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 60, col: 6, offset: 1495},
+											pos: position{line: 60, col: 6, offset: 1492},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 60, col: 6, offset: 1495},
+													pos:  position{line: 60, col: 6, offset: 1492},
 													name: "notToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 60, col: 15, offset: 1504},
+													pos:  position{line: 60, col: 15, offset: 1501},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 60, col: 19, offset: 1508},
+											pos: position{line: 60, col: 19, offset: 1505},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 60, col: 19, offset: 1508},
+													pos:        position{line: 60, col: 19, offset: 1505},
 													val:        "!",
 													ignoreCase: false,
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 60, col: 23, offset: 1512},
+													pos: position{line: 60, col: 23, offset: 1509},
 													expr: &ruleRefExpr{
-														pos:  position{line: 60, col: 23, offset: 1512},
+														pos:  position{line: 60, col: 23, offset: 1509},
 														name: "_",
 													},
 												},
@@ -402,10 +402,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 60, col: 27, offset: 1516},
+									pos:   position{line: 60, col: 27, offset: 1513},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 60, col: 29, offset: 1518},
+										pos:  position{line: 60, col: 29, offset: 1515},
 										name: "searchExpr",
 									},
 								},
@@ -413,24 +413,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 63, col: 5, offset: 1613},
+						pos: position{line: 63, col: 5, offset: 1610},
 						run: (*parser).callonsearchFactor14,
 						expr: &seqExpr{
-							pos: position{line: 63, col: 5, offset: 1613},
+							pos: position{line: 63, col: 5, offset: 1610},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 63, col: 5, offset: 1613},
+									pos: position{line: 63, col: 5, offset: 1610},
 									expr: &litMatcher{
-										pos:        position{line: 63, col: 7, offset: 1615},
+										pos:        position{line: 63, col: 7, offset: 1612},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 63, col: 12, offset: 1620},
+									pos:   position{line: 63, col: 12, offset: 1617},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 63, col: 14, offset: 1622},
+										pos:  position{line: 63, col: 14, offset: 1619},
 										name: "searchPred",
 									},
 								},
@@ -438,40 +438,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 64, col: 5, offset: 1655},
+						pos: position{line: 64, col: 5, offset: 1652},
 						run: (*parser).callonsearchFactor20,
 						expr: &seqExpr{
-							pos: position{line: 64, col: 5, offset: 1655},
+							pos: position{line: 64, col: 5, offset: 1652},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 64, col: 5, offset: 1655},
+									pos:        position{line: 64, col: 5, offset: 1652},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 64, col: 9, offset: 1659},
+									pos: position{line: 64, col: 9, offset: 1656},
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 9, offset: 1659},
+										pos:  position{line: 64, col: 9, offset: 1656},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 64, col: 12, offset: 1662},
+									pos:   position{line: 64, col: 12, offset: 1659},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 17, offset: 1667},
+										pos:  position{line: 64, col: 17, offset: 1664},
 										name: "searchExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 64, col: 28, offset: 1678},
+									pos: position{line: 64, col: 28, offset: 1675},
 									expr: &ruleRefExpr{
-										pos:  position{line: 64, col: 28, offset: 1678},
+										pos:  position{line: 64, col: 28, offset: 1675},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 64, col: 31, offset: 1681},
+									pos:        position{line: 64, col: 31, offset: 1678},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -483,48 +483,48 @@ var g = &grammar{
 		},
 		{
 			name: "searchPred",
-			pos:  position{line: 66, col: 1, offset: 1707},
+			pos:  position{line: 66, col: 1, offset: 1704},
 			expr: &choiceExpr{
-				pos: position{line: 67, col: 5, offset: 1722},
+				pos: position{line: 67, col: 5, offset: 1719},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 67, col: 5, offset: 1722},
+						pos: position{line: 67, col: 5, offset: 1719},
 						run: (*parser).callonsearchPred2,
 						expr: &seqExpr{
-							pos: position{line: 67, col: 5, offset: 1722},
+							pos: position{line: 67, col: 5, offset: 1719},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 67, col: 5, offset: 1722},
+									pos:        position{line: 67, col: 5, offset: 1719},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 67, col: 9, offset: 1726},
+									pos: position{line: 67, col: 9, offset: 1723},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 9, offset: 1726},
+										pos:  position{line: 67, col: 9, offset: 1723},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 12, offset: 1729},
+									pos:   position{line: 67, col: 12, offset: 1726},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 17, offset: 1734},
+										pos:  position{line: 67, col: 17, offset: 1731},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 67, col: 31, offset: 1748},
+									pos: position{line: 67, col: 31, offset: 1745},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 31, offset: 1748},
+										pos:  position{line: 67, col: 31, offset: 1745},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 34, offset: 1751},
+									pos:   position{line: 67, col: 34, offset: 1748},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 36, offset: 1753},
+										pos:  position{line: 67, col: 36, offset: 1750},
 										name: "searchValue",
 									},
 								},
@@ -532,43 +532,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 70, col: 5, offset: 1890},
+						pos: position{line: 70, col: 5, offset: 1887},
 						run: (*parser).callonsearchPred13,
 						expr: &seqExpr{
-							pos: position{line: 70, col: 5, offset: 1890},
+							pos: position{line: 70, col: 5, offset: 1887},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 70, col: 5, offset: 1890},
+									pos:        position{line: 70, col: 5, offset: 1887},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 70, col: 10, offset: 1895},
+									pos: position{line: 70, col: 10, offset: 1892},
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 10, offset: 1895},
+										pos:  position{line: 70, col: 10, offset: 1892},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 70, col: 13, offset: 1898},
+									pos:   position{line: 70, col: 13, offset: 1895},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 18, offset: 1903},
+										pos:  position{line: 70, col: 18, offset: 1900},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 70, col: 32, offset: 1917},
+									pos: position{line: 70, col: 32, offset: 1914},
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 32, offset: 1917},
+										pos:  position{line: 70, col: 32, offset: 1914},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 70, col: 35, offset: 1920},
+									pos:   position{line: 70, col: 35, offset: 1917},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 70, col: 37, offset: 1922},
+										pos:  position{line: 70, col: 37, offset: 1919},
 										name: "searchValue",
 									},
 								},
@@ -576,46 +576,46 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 2058},
+						pos: position{line: 73, col: 5, offset: 2055},
 						run: (*parser).callonsearchPred24,
 						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 2058},
+							pos: position{line: 73, col: 5, offset: 2055},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 73, col: 5, offset: 2058},
+									pos:   position{line: 73, col: 5, offset: 2055},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 7, offset: 2060},
+										pos:  position{line: 73, col: 7, offset: 2057},
 										name: "fieldExpr",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 73, col: 17, offset: 2070},
+									pos: position{line: 73, col: 17, offset: 2067},
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 17, offset: 2070},
+										pos:  position{line: 73, col: 17, offset: 2067},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 20, offset: 2073},
+									pos:   position{line: 73, col: 20, offset: 2070},
 									label: "comp",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 25, offset: 2078},
+										pos:  position{line: 73, col: 25, offset: 2075},
 										name: "equalityToken",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 73, col: 39, offset: 2092},
+									pos: position{line: 73, col: 39, offset: 2089},
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 39, offset: 2092},
+										pos:  position{line: 73, col: 39, offset: 2089},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 42, offset: 2095},
+									pos:   position{line: 73, col: 42, offset: 2092},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 73, col: 44, offset: 2097},
+										pos:  position{line: 73, col: 44, offset: 2094},
 										name: "searchValue",
 									},
 								},
@@ -623,39 +623,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 76, col: 5, offset: 2228},
+						pos: position{line: 76, col: 5, offset: 2225},
 						run: (*parser).callonsearchPred36,
 						expr: &seqExpr{
-							pos: position{line: 76, col: 5, offset: 2228},
+							pos: position{line: 76, col: 5, offset: 2225},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 76, col: 5, offset: 2228},
+									pos:   position{line: 76, col: 5, offset: 2225},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 7, offset: 2230},
+										pos:  position{line: 76, col: 7, offset: 2227},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 19, offset: 2242},
+									pos: position{line: 76, col: 19, offset: 2239},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 19, offset: 2242},
+										pos:  position{line: 76, col: 19, offset: 2239},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 22, offset: 2245},
+									pos:  position{line: 76, col: 22, offset: 2242},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 76, col: 30, offset: 2253},
+									pos: position{line: 76, col: 30, offset: 2250},
 									expr: &ruleRefExpr{
-										pos:  position{line: 76, col: 30, offset: 2253},
+										pos:  position{line: 76, col: 30, offset: 2250},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 76, col: 33, offset: 2256},
+									pos:        position{line: 76, col: 33, offset: 2253},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -663,42 +663,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 79, col: 5, offset: 2385},
+						pos: position{line: 79, col: 5, offset: 2382},
 						run: (*parser).callonsearchPred46,
 						expr: &seqExpr{
-							pos: position{line: 79, col: 5, offset: 2385},
+							pos: position{line: 79, col: 5, offset: 2382},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 79, col: 5, offset: 2385},
+									pos:   position{line: 79, col: 5, offset: 2382},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 7, offset: 2387},
+										pos:  position{line: 79, col: 7, offset: 2384},
 										name: "searchValue",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 19, offset: 2399},
+									pos: position{line: 79, col: 19, offset: 2396},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 19, offset: 2399},
+										pos:  position{line: 79, col: 19, offset: 2396},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 79, col: 22, offset: 2402},
+									pos:  position{line: 79, col: 22, offset: 2399},
 									name: "inToken",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 79, col: 30, offset: 2410},
+									pos: position{line: 79, col: 30, offset: 2407},
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 30, offset: 2410},
+										pos:  position{line: 79, col: 30, offset: 2407},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 79, col: 33, offset: 2413},
+									pos:   position{line: 79, col: 33, offset: 2410},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 79, col: 35, offset: 2415},
+										pos:  position{line: 79, col: 35, offset: 2412},
 										name: "fieldReference",
 									},
 								},
@@ -706,44 +706,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 82, col: 5, offset: 2549},
+						pos: position{line: 82, col: 5, offset: 2546},
 						run: (*parser).callonsearchPred57,
 						expr: &labeledExpr{
-							pos:   position{line: 82, col: 5, offset: 2549},
+							pos:   position{line: 82, col: 5, offset: 2546},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 82, col: 7, offset: 2551},
+								pos:  position{line: 82, col: 7, offset: 2548},
 								name: "searchLiteral",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 85, col: 5, offset: 2670},
+						pos: position{line: 85, col: 5, offset: 2667},
 						run: (*parser).callonsearchPred60,
 						expr: &seqExpr{
-							pos: position{line: 85, col: 5, offset: 2670},
+							pos: position{line: 85, col: 5, offset: 2667},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 85, col: 5, offset: 2670},
+									pos: position{line: 85, col: 5, offset: 2667},
 									expr: &seqExpr{
-										pos: position{line: 85, col: 7, offset: 2672},
+										pos: position{line: 85, col: 7, offset: 2669},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 8, offset: 2673},
+												pos:  position{line: 85, col: 8, offset: 2670},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 24, offset: 2689},
+												pos:  position{line: 85, col: 24, offset: 2686},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 85, col: 28, offset: 2693},
+									pos:   position{line: 85, col: 28, offset: 2690},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 85, col: 30, offset: 2695},
+										pos:  position{line: 85, col: 30, offset: 2692},
 										name: "searchWord",
 									},
 								},
@@ -755,52 +755,52 @@ var g = &grammar{
 		},
 		{
 			name: "searchLiteral",
-			pos:  position{line: 98, col: 1, offset: 3147},
+			pos:  position{line: 98, col: 1, offset: 3144},
 			expr: &choiceExpr{
-				pos: position{line: 99, col: 5, offset: 3165},
+				pos: position{line: 99, col: 5, offset: 3162},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 99, col: 5, offset: 3165},
+						pos:  position{line: 99, col: 5, offset: 3162},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 100, col: 5, offset: 3183},
+						pos:  position{line: 100, col: 5, offset: 3180},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 101, col: 5, offset: 3201},
+						pos:  position{line: 101, col: 5, offset: 3198},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 102, col: 5, offset: 3217},
+						pos:  position{line: 102, col: 5, offset: 3214},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 103, col: 5, offset: 3235},
+						pos:  position{line: 103, col: 5, offset: 3232},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 104, col: 5, offset: 3254},
+						pos:  position{line: 104, col: 5, offset: 3251},
 						name: "FloatLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 108, col: 5, offset: 3421},
+						pos: position{line: 108, col: 5, offset: 3271},
 						run: (*parser).callonsearchLiteral8,
 						expr: &seqExpr{
-							pos: position{line: 108, col: 5, offset: 3421},
+							pos: position{line: 108, col: 5, offset: 3271},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 108, col: 5, offset: 3421},
+									pos:   position{line: 108, col: 5, offset: 3271},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 108, col: 7, offset: 3423},
+										pos:  position{line: 108, col: 7, offset: 3273},
 										name: "IntegerLiteral",
 									},
 								},
 								&notExpr{
-									pos: position{line: 108, col: 22, offset: 3438},
+									pos: position{line: 108, col: 22, offset: 3288},
 									expr: &ruleRefExpr{
-										pos:  position{line: 108, col: 23, offset: 3439},
+										pos:  position{line: 108, col: 23, offset: 3289},
 										name: "searchWord",
 									},
 								},
@@ -808,32 +808,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 3473},
+						pos: position{line: 110, col: 5, offset: 3323},
 						run: (*parser).callonsearchLiteral14,
 						expr: &seqExpr{
-							pos: position{line: 110, col: 5, offset: 3473},
+							pos: position{line: 110, col: 5, offset: 3323},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 110, col: 5, offset: 3473},
+									pos: position{line: 110, col: 5, offset: 3323},
 									expr: &seqExpr{
-										pos: position{line: 110, col: 7, offset: 3475},
+										pos: position{line: 110, col: 7, offset: 3325},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 110, col: 7, offset: 3475},
+												pos:  position{line: 110, col: 7, offset: 3325},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 110, col: 22, offset: 3490},
+												pos:  position{line: 110, col: 22, offset: 3340},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 110, col: 25, offset: 3493},
+									pos:   position{line: 110, col: 25, offset: 3343},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 110, col: 27, offset: 3495},
+										pos:  position{line: 110, col: 27, offset: 3345},
 										name: "BooleanLiteral",
 									},
 								},
@@ -841,32 +841,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 111, col: 5, offset: 3532},
+						pos: position{line: 111, col: 5, offset: 3382},
 						run: (*parser).callonsearchLiteral22,
 						expr: &seqExpr{
-							pos: position{line: 111, col: 5, offset: 3532},
+							pos: position{line: 111, col: 5, offset: 3382},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 111, col: 5, offset: 3532},
+									pos: position{line: 111, col: 5, offset: 3382},
 									expr: &seqExpr{
-										pos: position{line: 111, col: 7, offset: 3534},
+										pos: position{line: 111, col: 7, offset: 3384},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 111, col: 7, offset: 3534},
+												pos:  position{line: 111, col: 7, offset: 3384},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 111, col: 22, offset: 3549},
+												pos:  position{line: 111, col: 22, offset: 3399},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 111, col: 25, offset: 3552},
+									pos:   position{line: 111, col: 25, offset: 3402},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 111, col: 27, offset: 3554},
+										pos:  position{line: 111, col: 27, offset: 3404},
 										name: "NullLiteral",
 									},
 								},
@@ -878,41 +878,41 @@ var g = &grammar{
 		},
 		{
 			name: "searchValue",
-			pos:  position{line: 114, col: 1, offset: 3586},
+			pos:  position{line: 114, col: 1, offset: 3436},
 			expr: &choiceExpr{
-				pos: position{line: 115, col: 5, offset: 3602},
+				pos: position{line: 115, col: 5, offset: 3452},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 115, col: 5, offset: 3602},
+						pos:  position{line: 115, col: 5, offset: 3452},
 						name: "searchLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 116, col: 5, offset: 3620},
+						pos: position{line: 116, col: 5, offset: 3470},
 						run: (*parser).callonsearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 116, col: 5, offset: 3620},
+							pos: position{line: 116, col: 5, offset: 3470},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 116, col: 5, offset: 3620},
+									pos: position{line: 116, col: 5, offset: 3470},
 									expr: &seqExpr{
-										pos: position{line: 116, col: 7, offset: 3622},
+										pos: position{line: 116, col: 7, offset: 3472},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 116, col: 8, offset: 3623},
+												pos:  position{line: 116, col: 8, offset: 3473},
 												name: "searchKeywords",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 116, col: 24, offset: 3639},
+												pos:  position{line: 116, col: 24, offset: 3489},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 116, col: 27, offset: 3642},
+									pos:   position{line: 116, col: 27, offset: 3492},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 116, col: 29, offset: 3644},
+										pos:  position{line: 116, col: 29, offset: 3494},
 										name: "searchWord",
 									},
 								},
@@ -924,15 +924,15 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 120, col: 1, offset: 3752},
+			pos:  position{line: 120, col: 1, offset: 3602},
 			expr: &actionExpr{
-				pos: position{line: 121, col: 5, offset: 3770},
+				pos: position{line: 121, col: 5, offset: 3620},
 				run: (*parser).callonStringLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 121, col: 5, offset: 3770},
+					pos:   position{line: 121, col: 5, offset: 3620},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 121, col: 7, offset: 3772},
+						pos:  position{line: 121, col: 7, offset: 3622},
 						name: "quotedString",
 					},
 				},
@@ -940,15 +940,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpLiteral",
-			pos:  position{line: 125, col: 1, offset: 3882},
+			pos:  position{line: 125, col: 1, offset: 3732},
 			expr: &actionExpr{
-				pos: position{line: 126, col: 5, offset: 3900},
+				pos: position{line: 126, col: 5, offset: 3750},
 				run: (*parser).callonRegexpLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 126, col: 5, offset: 3900},
+					pos:   position{line: 126, col: 5, offset: 3750},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 126, col: 7, offset: 3902},
+						pos:  position{line: 126, col: 7, offset: 3752},
 						name: "reString",
 					},
 				},
@@ -956,15 +956,15 @@ var g = &grammar{
 		},
 		{
 			name: "PortLiteral",
-			pos:  position{line: 130, col: 1, offset: 4008},
+			pos:  position{line: 130, col: 1, offset: 3858},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 5, offset: 4024},
+				pos: position{line: 131, col: 5, offset: 3874},
 				run: (*parser).callonPortLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 131, col: 5, offset: 4024},
+					pos:   position{line: 131, col: 5, offset: 3874},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 131, col: 7, offset: 4026},
+						pos:  position{line: 131, col: 7, offset: 3876},
 						name: "port",
 					},
 				},
@@ -972,30 +972,30 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 135, col: 1, offset: 4126},
+			pos:  position{line: 135, col: 1, offset: 3976},
 			expr: &choiceExpr{
-				pos: position{line: 136, col: 5, offset: 4144},
+				pos: position{line: 136, col: 5, offset: 3994},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 4144},
+						pos: position{line: 136, col: 5, offset: 3994},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 136, col: 5, offset: 4144},
+							pos:   position{line: 136, col: 5, offset: 3994},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 136, col: 7, offset: 4146},
+								pos:  position{line: 136, col: 7, offset: 3996},
 								name: "ip6subnet",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 139, col: 5, offset: 4253},
+						pos: position{line: 139, col: 5, offset: 4103},
 						run: (*parser).callonSubnetLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 139, col: 5, offset: 4253},
+							pos:   position{line: 139, col: 5, offset: 4103},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 139, col: 7, offset: 4255},
+								pos:  position{line: 139, col: 7, offset: 4105},
 								name: "subnet",
 							},
 						},
@@ -1005,30 +1005,30 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 143, col: 1, offset: 4356},
+			pos:  position{line: 143, col: 1, offset: 4206},
 			expr: &choiceExpr{
-				pos: position{line: 144, col: 5, offset: 4375},
+				pos: position{line: 144, col: 5, offset: 4225},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 144, col: 5, offset: 4375},
+						pos: position{line: 144, col: 5, offset: 4225},
 						run: (*parser).callonAddressLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 144, col: 5, offset: 4375},
+							pos:   position{line: 144, col: 5, offset: 4225},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 144, col: 7, offset: 4377},
+								pos:  position{line: 144, col: 7, offset: 4227},
 								name: "ip6addr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 147, col: 5, offset: 4481},
+						pos: position{line: 147, col: 5, offset: 4331},
 						run: (*parser).callonAddressLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 147, col: 5, offset: 4481},
+							pos:   position{line: 147, col: 5, offset: 4331},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 147, col: 7, offset: 4483},
+								pos:  position{line: 147, col: 7, offset: 4333},
 								name: "addr",
 							},
 						},
@@ -1038,15 +1038,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 151, col: 1, offset: 4581},
+			pos:  position{line: 151, col: 1, offset: 4431},
 			expr: &actionExpr{
-				pos: position{line: 152, col: 5, offset: 4598},
+				pos: position{line: 152, col: 5, offset: 4448},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 152, col: 5, offset: 4598},
+					pos:   position{line: 152, col: 5, offset: 4448},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 152, col: 7, offset: 4600},
+						pos:  position{line: 152, col: 7, offset: 4450},
 						name: "sdouble",
 					},
 				},
@@ -1054,15 +1054,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 156, col: 1, offset: 4706},
+			pos:  position{line: 156, col: 1, offset: 4556},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 5, offset: 4725},
+				pos: position{line: 157, col: 5, offset: 4575},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 157, col: 5, offset: 4725},
+					pos:   position{line: 157, col: 5, offset: 4575},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 157, col: 7, offset: 4727},
+						pos:  position{line: 157, col: 7, offset: 4577},
 						name: "sinteger",
 					},
 				},
@@ -1070,24 +1070,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 161, col: 1, offset: 4832},
+			pos:  position{line: 161, col: 1, offset: 4682},
 			expr: &choiceExpr{
-				pos: position{line: 162, col: 5, offset: 4851},
+				pos: position{line: 162, col: 5, offset: 4701},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 162, col: 5, offset: 4851},
+						pos: position{line: 162, col: 5, offset: 4701},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 162, col: 5, offset: 4851},
+							pos:        position{line: 162, col: 5, offset: 4701},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 163, col: 5, offset: 4961},
+						pos: position{line: 163, col: 5, offset: 4801},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 163, col: 5, offset: 4961},
+							pos:        position{line: 163, col: 5, offset: 4801},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -1097,12 +1097,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 165, col: 1, offset: 5069},
+			pos:  position{line: 165, col: 1, offset: 4900},
 			expr: &actionExpr{
-				pos: position{line: 166, col: 5, offset: 5085},
+				pos: position{line: 166, col: 5, offset: 4916},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 166, col: 5, offset: 5085},
+					pos:        position{line: 166, col: 5, offset: 4916},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -1110,20 +1110,20 @@ var g = &grammar{
 		},
 		{
 			name: "searchKeywords",
-			pos:  position{line: 168, col: 1, offset: 5175},
+			pos:  position{line: 168, col: 1, offset: 4996},
 			expr: &choiceExpr{
-				pos: position{line: 169, col: 5, offset: 5194},
+				pos: position{line: 169, col: 5, offset: 5015},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 169, col: 5, offset: 5194},
+						pos:  position{line: 169, col: 5, offset: 5015},
 						name: "andToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 170, col: 5, offset: 5207},
+						pos:  position{line: 170, col: 5, offset: 5028},
 						name: "orToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 171, col: 5, offset: 5219},
+						pos:  position{line: 171, col: 5, offset: 5040},
 						name: "inToken",
 					},
 				},
@@ -1131,28 +1131,28 @@ var g = &grammar{
 		},
 		{
 			name: "procList",
-			pos:  position{line: 173, col: 1, offset: 5228},
+			pos:  position{line: 173, col: 1, offset: 5049},
 			expr: &actionExpr{
-				pos: position{line: 174, col: 5, offset: 5241},
+				pos: position{line: 174, col: 5, offset: 5062},
 				run: (*parser).callonprocList1,
 				expr: &seqExpr{
-					pos: position{line: 174, col: 5, offset: 5241},
+					pos: position{line: 174, col: 5, offset: 5062},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 174, col: 5, offset: 5241},
+							pos:   position{line: 174, col: 5, offset: 5062},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 174, col: 11, offset: 5247},
+								pos:  position{line: 174, col: 11, offset: 5068},
 								name: "procChain",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 174, col: 21, offset: 5257},
+							pos:   position{line: 174, col: 21, offset: 5078},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 174, col: 26, offset: 5262},
+								pos: position{line: 174, col: 26, offset: 5083},
 								expr: &ruleRefExpr{
-									pos:  position{line: 174, col: 26, offset: 5262},
+									pos:  position{line: 174, col: 26, offset: 5083},
 									name: "parallelChain",
 								},
 							},
@@ -1163,37 +1163,37 @@ var g = &grammar{
 		},
 		{
 			name: "parallelChain",
-			pos:  position{line: 183, col: 1, offset: 5562},
+			pos:  position{line: 183, col: 1, offset: 5382},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 5, offset: 5580},
+				pos: position{line: 184, col: 5, offset: 5400},
 				run: (*parser).callonparallelChain1,
 				expr: &seqExpr{
-					pos: position{line: 184, col: 5, offset: 5580},
+					pos: position{line: 184, col: 5, offset: 5400},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 184, col: 5, offset: 5580},
+							pos: position{line: 184, col: 5, offset: 5400},
 							expr: &ruleRefExpr{
-								pos:  position{line: 184, col: 5, offset: 5580},
+								pos:  position{line: 184, col: 5, offset: 5400},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 184, col: 8, offset: 5583},
+							pos:        position{line: 184, col: 8, offset: 5403},
 							val:        ";",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 184, col: 12, offset: 5587},
+							pos: position{line: 184, col: 12, offset: 5407},
 							expr: &ruleRefExpr{
-								pos:  position{line: 184, col: 12, offset: 5587},
+								pos:  position{line: 184, col: 12, offset: 5407},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 184, col: 15, offset: 5590},
+							pos:   position{line: 184, col: 15, offset: 5410},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 184, col: 18, offset: 5593},
+								pos:  position{line: 184, col: 18, offset: 5413},
 								name: "procChain",
 							},
 						},
@@ -1203,53 +1203,53 @@ var g = &grammar{
 		},
 		{
 			name: "proc",
-			pos:  position{line: 186, col: 1, offset: 5680},
+			pos:  position{line: 186, col: 1, offset: 5500},
 			expr: &choiceExpr{
-				pos: position{line: 187, col: 5, offset: 5689},
+				pos: position{line: 187, col: 5, offset: 5509},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 187, col: 5, offset: 5689},
+						pos:  position{line: 187, col: 5, offset: 5509},
 						name: "simpleProc",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 188, col: 5, offset: 5704},
+						pos:  position{line: 188, col: 5, offset: 5524},
 						name: "groupByProc",
 					},
 					&actionExpr{
-						pos: position{line: 189, col: 5, offset: 5720},
+						pos: position{line: 189, col: 5, offset: 5540},
 						run: (*parser).callonproc4,
 						expr: &seqExpr{
-							pos: position{line: 189, col: 5, offset: 5720},
+							pos: position{line: 189, col: 5, offset: 5540},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 189, col: 5, offset: 5720},
+									pos:        position{line: 189, col: 5, offset: 5540},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 189, col: 9, offset: 5724},
+									pos: position{line: 189, col: 9, offset: 5544},
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 9, offset: 5724},
+										pos:  position{line: 189, col: 9, offset: 5544},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 189, col: 12, offset: 5727},
+									pos:   position{line: 189, col: 12, offset: 5547},
 									label: "proc",
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 17, offset: 5732},
+										pos:  position{line: 189, col: 17, offset: 5552},
 										name: "procList",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 189, col: 26, offset: 5741},
+									pos: position{line: 189, col: 26, offset: 5561},
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 26, offset: 5741},
+										pos:  position{line: 189, col: 26, offset: 5561},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 189, col: 29, offset: 5744},
+									pos:        position{line: 189, col: 29, offset: 5564},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1261,59 +1261,59 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKeys",
-			pos:  position{line: 193, col: 1, offset: 5780},
+			pos:  position{line: 193, col: 1, offset: 5600},
 			expr: &actionExpr{
-				pos: position{line: 194, col: 5, offset: 5796},
+				pos: position{line: 194, col: 5, offset: 5616},
 				run: (*parser).callongroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 194, col: 5, offset: 5796},
+					pos: position{line: 194, col: 5, offset: 5616},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 194, col: 5, offset: 5796},
+							pos:        position{line: 194, col: 5, offset: 5616},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 194, col: 11, offset: 5802},
+							pos:  position{line: 194, col: 11, offset: 5622},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 194, col: 13, offset: 5804},
+							pos:   position{line: 194, col: 13, offset: 5624},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 194, col: 19, offset: 5810},
+								pos:  position{line: 194, col: 19, offset: 5630},
 								name: "groupByKey",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 194, col: 30, offset: 5821},
+							pos:   position{line: 194, col: 30, offset: 5641},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 194, col: 35, offset: 5826},
+								pos: position{line: 194, col: 35, offset: 5646},
 								expr: &actionExpr{
-									pos: position{line: 194, col: 36, offset: 5827},
+									pos: position{line: 194, col: 36, offset: 5647},
 									run: (*parser).callongroupByKeys9,
 									expr: &seqExpr{
-										pos: position{line: 194, col: 36, offset: 5827},
+										pos: position{line: 194, col: 36, offset: 5647},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 194, col: 36, offset: 5827},
+												pos:  position{line: 194, col: 36, offset: 5647},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 194, col: 39, offset: 5830},
+												pos:        position{line: 194, col: 39, offset: 5650},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 194, col: 43, offset: 5834},
+												pos:  position{line: 194, col: 43, offset: 5654},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 194, col: 46, offset: 5837},
+												pos:   position{line: 194, col: 46, offset: 5657},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 194, col: 49, offset: 5840},
+													pos:  position{line: 194, col: 49, offset: 5660},
 													name: "groupByKey",
 												},
 											},
@@ -1328,22 +1328,22 @@ var g = &grammar{
 		},
 		{
 			name: "groupByKey",
-			pos:  position{line: 199, col: 1, offset: 5957},
+			pos:  position{line: 199, col: 1, offset: 5776},
 			expr: &choiceExpr{
-				pos: position{line: 200, col: 5, offset: 5972},
+				pos: position{line: 200, col: 5, offset: 5791},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 200, col: 5, offset: 5972},
+						pos:  position{line: 200, col: 5, offset: 5791},
 						name: "ExpressionAssignment",
 					},
 					&actionExpr{
-						pos: position{line: 201, col: 5, offset: 5997},
+						pos: position{line: 201, col: 5, offset: 5816},
 						run: (*parser).callongroupByKey3,
 						expr: &labeledExpr{
-							pos:   position{line: 201, col: 5, offset: 5997},
+							pos:   position{line: 201, col: 5, offset: 5816},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 201, col: 11, offset: 6003},
+								pos:  position{line: 201, col: 11, offset: 5822},
 								name: "fieldExpr",
 							},
 						},
@@ -1353,27 +1353,27 @@ var g = &grammar{
 		},
 		{
 			name: "everyDur",
-			pos:  position{line: 204, col: 1, offset: 6131},
+			pos:  position{line: 204, col: 1, offset: 5950},
 			expr: &actionExpr{
-				pos: position{line: 205, col: 5, offset: 6144},
+				pos: position{line: 205, col: 5, offset: 5963},
 				run: (*parser).calloneveryDur1,
 				expr: &seqExpr{
-					pos: position{line: 205, col: 5, offset: 6144},
+					pos: position{line: 205, col: 5, offset: 5963},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 205, col: 5, offset: 6144},
+							pos:        position{line: 205, col: 5, offset: 5963},
 							val:        "every",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 205, col: 14, offset: 6153},
+							pos:  position{line: 205, col: 14, offset: 5972},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 205, col: 16, offset: 6155},
+							pos:   position{line: 205, col: 16, offset: 5974},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 205, col: 20, offset: 6159},
+								pos:  position{line: 205, col: 20, offset: 5978},
 								name: "duration",
 							},
 						},
@@ -1383,16 +1383,16 @@ var g = &grammar{
 		},
 		{
 			name: "equalityToken",
-			pos:  position{line: 207, col: 1, offset: 6189},
+			pos:  position{line: 207, col: 1, offset: 6008},
 			expr: &choiceExpr{
-				pos: position{line: 208, col: 5, offset: 6207},
+				pos: position{line: 208, col: 5, offset: 6026},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 208, col: 5, offset: 6207},
+						pos:  position{line: 208, col: 5, offset: 6026},
 						name: "EqualityOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 208, col: 24, offset: 6226},
+						pos:  position{line: 208, col: 24, offset: 6045},
 						name: "RelativeOperator",
 					},
 				},
@@ -1400,12 +1400,12 @@ var g = &grammar{
 		},
 		{
 			name: "andToken",
-			pos:  position{line: 210, col: 1, offset: 6244},
+			pos:  position{line: 210, col: 1, offset: 6063},
 			expr: &actionExpr{
-				pos: position{line: 210, col: 12, offset: 6255},
+				pos: position{line: 210, col: 12, offset: 6074},
 				run: (*parser).callonandToken1,
 				expr: &litMatcher{
-					pos:        position{line: 210, col: 12, offset: 6255},
+					pos:        position{line: 210, col: 12, offset: 6074},
 					val:        "and",
 					ignoreCase: true,
 				},
@@ -1413,12 +1413,12 @@ var g = &grammar{
 		},
 		{
 			name: "orToken",
-			pos:  position{line: 211, col: 1, offset: 6293},
+			pos:  position{line: 211, col: 1, offset: 6112},
 			expr: &actionExpr{
-				pos: position{line: 211, col: 11, offset: 6303},
+				pos: position{line: 211, col: 11, offset: 6122},
 				run: (*parser).callonorToken1,
 				expr: &litMatcher{
-					pos:        position{line: 211, col: 11, offset: 6303},
+					pos:        position{line: 211, col: 11, offset: 6122},
 					val:        "or",
 					ignoreCase: true,
 				},
@@ -1426,12 +1426,12 @@ var g = &grammar{
 		},
 		{
 			name: "inToken",
-			pos:  position{line: 212, col: 1, offset: 6340},
+			pos:  position{line: 212, col: 1, offset: 6159},
 			expr: &actionExpr{
-				pos: position{line: 212, col: 11, offset: 6350},
+				pos: position{line: 212, col: 11, offset: 6169},
 				run: (*parser).calloninToken1,
 				expr: &litMatcher{
-					pos:        position{line: 212, col: 11, offset: 6350},
+					pos:        position{line: 212, col: 11, offset: 6169},
 					val:        "in",
 					ignoreCase: true,
 				},
@@ -1439,12 +1439,12 @@ var g = &grammar{
 		},
 		{
 			name: "notToken",
-			pos:  position{line: 213, col: 1, offset: 6387},
+			pos:  position{line: 213, col: 1, offset: 6206},
 			expr: &actionExpr{
-				pos: position{line: 213, col: 12, offset: 6398},
+				pos: position{line: 213, col: 12, offset: 6217},
 				run: (*parser).callonnotToken1,
 				expr: &litMatcher{
-					pos:        position{line: 213, col: 12, offset: 6398},
+					pos:        position{line: 213, col: 12, offset: 6217},
 					val:        "not",
 					ignoreCase: true,
 				},
@@ -1452,21 +1452,21 @@ var g = &grammar{
 		},
 		{
 			name: "fieldName",
-			pos:  position{line: 215, col: 1, offset: 6437},
+			pos:  position{line: 215, col: 1, offset: 6256},
 			expr: &actionExpr{
-				pos: position{line: 215, col: 13, offset: 6449},
+				pos: position{line: 215, col: 13, offset: 6268},
 				run: (*parser).callonfieldName1,
 				expr: &seqExpr{
-					pos: position{line: 215, col: 13, offset: 6449},
+					pos: position{line: 215, col: 13, offset: 6268},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 215, col: 13, offset: 6449},
+							pos:  position{line: 215, col: 13, offset: 6268},
 							name: "fieldNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 215, col: 28, offset: 6464},
+							pos: position{line: 215, col: 28, offset: 6283},
 							expr: &ruleRefExpr{
-								pos:  position{line: 215, col: 28, offset: 6464},
+								pos:  position{line: 215, col: 28, offset: 6283},
 								name: "fieldNameRest",
 							},
 						},
@@ -1476,9 +1476,9 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameStart",
-			pos:  position{line: 217, col: 1, offset: 6511},
+			pos:  position{line: 217, col: 1, offset: 6330},
 			expr: &charClassMatcher{
-				pos:        position{line: 217, col: 18, offset: 6528},
+				pos:        position{line: 217, col: 18, offset: 6347},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1488,16 +1488,16 @@ var g = &grammar{
 		},
 		{
 			name: "fieldNameRest",
-			pos:  position{line: 218, col: 1, offset: 6539},
+			pos:  position{line: 218, col: 1, offset: 6358},
 			expr: &choiceExpr{
-				pos: position{line: 218, col: 17, offset: 6555},
+				pos: position{line: 218, col: 17, offset: 6374},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 218, col: 17, offset: 6555},
+						pos:  position{line: 218, col: 17, offset: 6374},
 						name: "fieldNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 218, col: 34, offset: 6572},
+						pos:        position{line: 218, col: 34, offset: 6391},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1508,45 +1508,45 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReference",
-			pos:  position{line: 220, col: 1, offset: 6579},
+			pos:  position{line: 220, col: 1, offset: 6398},
 			expr: &actionExpr{
-				pos: position{line: 221, col: 4, offset: 6597},
+				pos: position{line: 221, col: 4, offset: 6416},
 				run: (*parser).callonfieldReference1,
 				expr: &seqExpr{
-					pos: position{line: 221, col: 4, offset: 6597},
+					pos: position{line: 221, col: 4, offset: 6416},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 221, col: 4, offset: 6597},
+							pos:   position{line: 221, col: 4, offset: 6416},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 221, col: 9, offset: 6602},
+								pos:  position{line: 221, col: 9, offset: 6421},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 19, offset: 6612},
+							pos:   position{line: 221, col: 19, offset: 6431},
 							label: "ds",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 221, col: 22, offset: 6615},
+								pos: position{line: 221, col: 22, offset: 6434},
 								expr: &choiceExpr{
-									pos: position{line: 222, col: 8, offset: 6624},
+									pos: position{line: 222, col: 8, offset: 6443},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 222, col: 8, offset: 6624},
+											pos: position{line: 222, col: 8, offset: 6443},
 											run: (*parser).callonfieldReference8,
 											expr: &seqExpr{
-												pos: position{line: 222, col: 8, offset: 6624},
+												pos: position{line: 222, col: 8, offset: 6443},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 222, col: 8, offset: 6624},
+														pos:        position{line: 222, col: 8, offset: 6443},
 														val:        ".",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 222, col: 12, offset: 6628},
+														pos:   position{line: 222, col: 12, offset: 6447},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 222, col: 18, offset: 6634},
+															pos:  position{line: 222, col: 18, offset: 6453},
 															name: "fieldName",
 														},
 													},
@@ -1554,26 +1554,53 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 223, col: 8, offset: 6767},
+											pos: position{line: 223, col: 8, offset: 6583},
 											run: (*parser).callonfieldReference13,
 											expr: &seqExpr{
-												pos: position{line: 223, col: 8, offset: 6767},
+												pos: position{line: 223, col: 8, offset: 6583},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 223, col: 8, offset: 6767},
+														pos:        position{line: 223, col: 8, offset: 6583},
 														val:        "[",
 														ignoreCase: false,
 													},
 													&labeledExpr{
-														pos:   position{line: 223, col: 12, offset: 6771},
+														pos:   position{line: 223, col: 12, offset: 6587},
 														label: "index",
 														expr: &ruleRefExpr{
-															pos:  position{line: 223, col: 18, offset: 6777},
+															pos:  position{line: 223, col: 18, offset: 6593},
 															name: "suint",
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 223, col: 24, offset: 6783},
+														pos:        position{line: 223, col: 24, offset: 6599},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+										&actionExpr{
+											pos: position{line: 224, col: 8, offset: 6713},
+											run: (*parser).callonfieldReference19,
+											expr: &seqExpr{
+												pos: position{line: 224, col: 8, offset: 6713},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 224, col: 8, offset: 6713},
+														val:        "[",
+														ignoreCase: false,
+													},
+													&labeledExpr{
+														pos:   position{line: 224, col: 12, offset: 6717},
+														label: "key",
+														expr: &ruleRefExpr{
+															pos:  position{line: 224, col: 16, offset: 6721},
+															name: "quotedString",
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 224, col: 29, offset: 6734},
 														val:        "]",
 														ignoreCase: false,
 													},
@@ -1590,60 +1617,60 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExpr",
-			pos:  position{line: 234, col: 1, offset: 7146},
+			pos:  position{line: 235, col: 1, offset: 7096},
 			expr: &choiceExpr{
-				pos: position{line: 235, col: 5, offset: 7160},
+				pos: position{line: 236, col: 5, offset: 7110},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 235, col: 5, offset: 7160},
+						pos: position{line: 236, col: 5, offset: 7110},
 						run: (*parser).callonfieldExpr2,
 						expr: &seqExpr{
-							pos: position{line: 235, col: 5, offset: 7160},
+							pos: position{line: 236, col: 5, offset: 7110},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 235, col: 5, offset: 7160},
+									pos:   position{line: 236, col: 5, offset: 7110},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 235, col: 8, offset: 7163},
+										pos:  position{line: 236, col: 8, offset: 7113},
 										name: "fieldOp",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 235, col: 16, offset: 7171},
+									pos: position{line: 236, col: 16, offset: 7121},
 									expr: &ruleRefExpr{
-										pos:  position{line: 235, col: 16, offset: 7171},
+										pos:  position{line: 236, col: 16, offset: 7121},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 235, col: 19, offset: 7174},
+									pos:        position{line: 236, col: 19, offset: 7124},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 235, col: 23, offset: 7178},
+									pos: position{line: 236, col: 23, offset: 7128},
 									expr: &ruleRefExpr{
-										pos:  position{line: 235, col: 23, offset: 7178},
+										pos:  position{line: 236, col: 23, offset: 7128},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 235, col: 26, offset: 7181},
+									pos:   position{line: 236, col: 26, offset: 7131},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 235, col: 32, offset: 7187},
+										pos:  position{line: 236, col: 32, offset: 7137},
 										name: "fieldReference",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 235, col: 47, offset: 7202},
+									pos: position{line: 236, col: 47, offset: 7152},
 									expr: &ruleRefExpr{
-										pos:  position{line: 235, col: 47, offset: 7202},
+										pos:  position{line: 236, col: 47, offset: 7152},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 235, col: 50, offset: 7205},
+									pos:        position{line: 236, col: 50, offset: 7155},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1651,7 +1678,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 238, col: 5, offset: 7321},
+						pos:  position{line: 239, col: 5, offset: 7271},
 						name: "fieldReference",
 					},
 				},
@@ -1659,12 +1686,12 @@ var g = &grammar{
 		},
 		{
 			name: "fieldOp",
-			pos:  position{line: 240, col: 1, offset: 7337},
+			pos:  position{line: 241, col: 1, offset: 7287},
 			expr: &actionExpr{
-				pos: position{line: 241, col: 5, offset: 7349},
+				pos: position{line: 242, col: 5, offset: 7299},
 				run: (*parser).callonfieldOp1,
 				expr: &litMatcher{
-					pos:        position{line: 241, col: 5, offset: 7349},
+					pos:        position{line: 242, col: 5, offset: 7299},
 					val:        "len",
 					ignoreCase: true,
 				},
@@ -1672,50 +1699,50 @@ var g = &grammar{
 		},
 		{
 			name: "fieldExprList",
-			pos:  position{line: 243, col: 1, offset: 7379},
+			pos:  position{line: 244, col: 1, offset: 7329},
 			expr: &actionExpr{
-				pos: position{line: 244, col: 5, offset: 7397},
+				pos: position{line: 245, col: 5, offset: 7347},
 				run: (*parser).callonfieldExprList1,
 				expr: &seqExpr{
-					pos: position{line: 244, col: 5, offset: 7397},
+					pos: position{line: 245, col: 5, offset: 7347},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 244, col: 5, offset: 7397},
+							pos:   position{line: 245, col: 5, offset: 7347},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 244, col: 11, offset: 7403},
+								pos:  position{line: 245, col: 11, offset: 7353},
 								name: "fieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 244, col: 21, offset: 7413},
+							pos:   position{line: 245, col: 21, offset: 7363},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 244, col: 26, offset: 7418},
+								pos: position{line: 245, col: 26, offset: 7368},
 								expr: &seqExpr{
-									pos: position{line: 244, col: 27, offset: 7419},
+									pos: position{line: 245, col: 27, offset: 7369},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 244, col: 27, offset: 7419},
+											pos: position{line: 245, col: 27, offset: 7369},
 											expr: &ruleRefExpr{
-												pos:  position{line: 244, col: 27, offset: 7419},
+												pos:  position{line: 245, col: 27, offset: 7369},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 244, col: 30, offset: 7422},
+											pos:        position{line: 245, col: 30, offset: 7372},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 244, col: 34, offset: 7426},
+											pos: position{line: 245, col: 34, offset: 7376},
 											expr: &ruleRefExpr{
-												pos:  position{line: 244, col: 34, offset: 7426},
+												pos:  position{line: 245, col: 34, offset: 7376},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 244, col: 37, offset: 7429},
+											pos:  position{line: 245, col: 37, offset: 7379},
 											name: "fieldExpr",
 										},
 									},
@@ -1728,39 +1755,39 @@ var g = &grammar{
 		},
 		{
 			name: "fieldRefDotOnly",
-			pos:  position{line: 254, col: 1, offset: 7626},
+			pos:  position{line: 255, col: 1, offset: 7574},
 			expr: &actionExpr{
-				pos: position{line: 255, col: 5, offset: 7646},
+				pos: position{line: 256, col: 5, offset: 7594},
 				run: (*parser).callonfieldRefDotOnly1,
 				expr: &seqExpr{
-					pos: position{line: 255, col: 5, offset: 7646},
+					pos: position{line: 256, col: 5, offset: 7594},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 255, col: 5, offset: 7646},
+							pos:   position{line: 256, col: 5, offset: 7594},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 255, col: 10, offset: 7651},
+								pos:  position{line: 256, col: 10, offset: 7599},
 								name: "fieldName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 255, col: 20, offset: 7661},
+							pos:   position{line: 256, col: 20, offset: 7609},
 							label: "refs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 255, col: 25, offset: 7666},
+								pos: position{line: 256, col: 25, offset: 7614},
 								expr: &seqExpr{
-									pos: position{line: 255, col: 26, offset: 7667},
+									pos: position{line: 256, col: 26, offset: 7615},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 255, col: 26, offset: 7667},
+											pos:        position{line: 256, col: 26, offset: 7615},
 											val:        ".",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 255, col: 30, offset: 7671},
+											pos:   position{line: 256, col: 30, offset: 7619},
 											label: "field",
 											expr: &ruleRefExpr{
-												pos:  position{line: 255, col: 36, offset: 7677},
+												pos:  position{line: 256, col: 36, offset: 7625},
 												name: "fieldName",
 											},
 										},
@@ -1774,12 +1801,12 @@ var g = &grammar{
 		},
 		{
 			name: "countOp",
-			pos:  position{line: 257, col: 1, offset: 7721},
+			pos:  position{line: 258, col: 1, offset: 7669},
 			expr: &actionExpr{
-				pos: position{line: 258, col: 5, offset: 7733},
+				pos: position{line: 259, col: 5, offset: 7681},
 				run: (*parser).calloncountOp1,
 				expr: &litMatcher{
-					pos:        position{line: 258, col: 5, offset: 7733},
+					pos:        position{line: 259, col: 5, offset: 7681},
 					val:        "count",
 					ignoreCase: true,
 				},
@@ -1787,140 +1814,212 @@ var g = &grammar{
 		},
 		{
 			name: "fieldReducerOp",
-			pos:  position{line: 260, col: 1, offset: 7767},
+			pos:  position{line: 261, col: 1, offset: 7715},
 			expr: &choiceExpr{
-				pos: position{line: 261, col: 5, offset: 7786},
+				pos: position{line: 262, col: 5, offset: 7734},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 7786},
+						pos: position{line: 262, col: 5, offset: 7734},
 						run: (*parser).callonfieldReducerOp2,
 						expr: &litMatcher{
-							pos:        position{line: 261, col: 5, offset: 7786},
+							pos:        position{line: 262, col: 5, offset: 7734},
 							val:        "sum",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 262, col: 5, offset: 7820},
+						pos: position{line: 263, col: 5, offset: 7767},
 						run: (*parser).callonfieldReducerOp4,
 						expr: &litMatcher{
-							pos:        position{line: 262, col: 5, offset: 7820},
+							pos:        position{line: 263, col: 5, offset: 7767},
 							val:        "avg",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 263, col: 5, offset: 7854},
+						pos: position{line: 264, col: 5, offset: 7800},
 						run: (*parser).callonfieldReducerOp6,
 						expr: &litMatcher{
-							pos:        position{line: 263, col: 5, offset: 7854},
+							pos:        position{line: 264, col: 5, offset: 7800},
 							val:        "stdev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 7891},
+						pos: position{line: 265, col: 5, offset: 7837},
 						run: (*parser).callonfieldReducerOp8,
 						expr: &litMatcher{
-							pos:        position{line: 264, col: 5, offset: 7891},
-							val:        "sd",
+							pos:        position{line: 265, col: 5, offset: 7837},
+							val:        "stddev",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 265, col: 5, offset: 7927},
+						pos: position{line: 266, col: 5, offset: 7875},
 						run: (*parser).callonfieldReducerOp10,
 						expr: &litMatcher{
-							pos:        position{line: 265, col: 5, offset: 7927},
-							val:        "var",
+							pos:        position{line: 266, col: 5, offset: 7875},
+							val:        "sd",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 266, col: 5, offset: 7961},
+						pos: position{line: 267, col: 5, offset: 7909},
 						run: (*parser).callonfieldReducerOp12,
 						expr: &litMatcher{
-							pos:        position{line: 266, col: 5, offset: 7961},
-							val:        "entropy",
+							pos:        position{line: 267, col: 5, offset: 7909},
+							val:        "variance",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 267, col: 5, offset: 8002},
+						pos: position{line: 268, col: 5, offset: 7947},
 						run: (*parser).callonfieldReducerOp14,
 						expr: &litMatcher{
-							pos:        position{line: 267, col: 5, offset: 8002},
-							val:        "min",
+							pos:        position{line: 268, col: 5, offset: 7947},
+							val:        "var",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 268, col: 5, offset: 8036},
+						pos: position{line: 269, col: 5, offset: 7980},
 						run: (*parser).callonfieldReducerOp16,
 						expr: &litMatcher{
-							pos:        position{line: 268, col: 5, offset: 8036},
-							val:        "max",
+							pos:        position{line: 269, col: 5, offset: 7980},
+							val:        "entropy",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 269, col: 5, offset: 8070},
+						pos: position{line: 270, col: 5, offset: 8021},
 						run: (*parser).callonfieldReducerOp18,
 						expr: &litMatcher{
-							pos:        position{line: 269, col: 5, offset: 8070},
-							val:        "first",
+							pos:        position{line: 270, col: 5, offset: 8021},
+							val:        "min",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 270, col: 5, offset: 8108},
+						pos: position{line: 271, col: 5, offset: 8054},
 						run: (*parser).callonfieldReducerOp20,
 						expr: &litMatcher{
-							pos:        position{line: 270, col: 5, offset: 8108},
-							val:        "last",
+							pos:        position{line: 271, col: 5, offset: 8054},
+							val:        "max",
 							ignoreCase: true,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 271, col: 5, offset: 8144},
+						pos: position{line: 272, col: 5, offset: 8087},
 						run: (*parser).callonfieldReducerOp22,
 						expr: &litMatcher{
-							pos:        position{line: 271, col: 5, offset: 8144},
+							pos:        position{line: 272, col: 5, offset: 8087},
+							val:        "first",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 273, col: 5, offset: 8124},
+						run: (*parser).callonfieldReducerOp24,
+						expr: &litMatcher{
+							pos:        position{line: 273, col: 5, offset: 8124},
+							val:        "last",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 274, col: 5, offset: 8159},
+						run: (*parser).callonfieldReducerOp26,
+						expr: &litMatcher{
+							pos:        position{line: 274, col: 5, offset: 8159},
 							val:        "countdistinct",
 							ignoreCase: true,
 						},
 					},
+					&actionExpr{
+						pos: position{line: 275, col: 5, offset: 8212},
+						run: (*parser).callonfieldReducerOp28,
+						expr: &litMatcher{
+							pos:        position{line: 275, col: 5, offset: 8212},
+							val:        "median",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 276, col: 5, offset: 8251},
+						run: (*parser).callonfieldReducerOp30,
+						expr: &litMatcher{
+							pos:        position{line: 276, col: 5, offset: 8251},
+							val:        "collect",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 277, col: 5, offset: 8292},
+						run: (*parser).callonfieldReducerOp32,
+						expr: &litMatcher{
+							pos:        position{line: 277, col: 5, offset: 8292},
+							val:        "union",
+							ignoreCase: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "paramReducerOp",
+			pos:  position{line: 279, col: 1, offset: 8326},
+			expr: &choiceExpr{
+				pos: position{line: 280, col: 5, offset: 8345},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 280, col: 5, offset: 8345},
+						run: (*parser).callonparamReducerOp2,
+						expr: &litMatcher{
+							pos:        position{line: 280, col: 5, offset: 8345},
+							val:        "percentile",
+							ignoreCase: true,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 281, col: 5, offset: 8392},
+						run: (*parser).callonparamReducerOp4,
+						expr: &litMatcher{
+							pos:        position{line: 281, col: 5, offset: 8392},
+							val:        "quantile",
+							ignoreCase: true,
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "paddedFieldExpr",
-			pos:  position{line: 273, col: 1, offset: 8194},
+			pos:  position{line: 283, col: 1, offset: 8432},
 			expr: &actionExpr{
-				pos: position{line: 273, col: 19, offset: 8212},
+				pos: position{line: 283, col: 19, offset: 8450},
 				run: (*parser).callonpaddedFieldExpr1,
 				expr: &seqExpr{
-					pos: position{line: 273, col: 19, offset: 8212},
+					pos: position{line: 283, col: 19, offset: 8450},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 273, col: 19, offset: 8212},
+							pos: position{line: 283, col: 19, offset: 8450},
 							expr: &ruleRefExpr{
-								pos:  position{line: 273, col: 19, offset: 8212},
+								pos:  position{line: 283, col: 19, offset: 8450},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 273, col: 22, offset: 8215},
+							pos:   position{line: 283, col: 22, offset: 8453},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 273, col: 28, offset: 8221},
+								pos:  position{line: 283, col: 28, offset: 8459},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 273, col: 38, offset: 8231},
+							pos: position{line: 283, col: 38, offset: 8469},
 							expr: &ruleRefExpr{
-								pos:  position{line: 273, col: 38, offset: 8231},
+								pos:  position{line: 283, col: 38, offset: 8469},
 								name: "_",
 							},
 						},
@@ -1930,53 +2029,53 @@ var g = &grammar{
 		},
 		{
 			name: "countReducer",
-			pos:  position{line: 275, col: 1, offset: 8257},
+			pos:  position{line: 285, col: 1, offset: 8495},
 			expr: &actionExpr{
-				pos: position{line: 276, col: 5, offset: 8274},
+				pos: position{line: 286, col: 5, offset: 8512},
 				run: (*parser).calloncountReducer1,
 				expr: &seqExpr{
-					pos: position{line: 276, col: 5, offset: 8274},
+					pos: position{line: 286, col: 5, offset: 8512},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 276, col: 5, offset: 8274},
+							pos:   position{line: 286, col: 5, offset: 8512},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 8, offset: 8277},
+								pos:  position{line: 286, col: 8, offset: 8515},
 								name: "countOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 16, offset: 8285},
+							pos: position{line: 286, col: 16, offset: 8523},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 16, offset: 8285},
+								pos:  position{line: 286, col: 16, offset: 8523},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 276, col: 19, offset: 8288},
+							pos:        position{line: 286, col: 19, offset: 8526},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 276, col: 23, offset: 8292},
+							pos:   position{line: 286, col: 23, offset: 8530},
 							label: "field",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 276, col: 29, offset: 8298},
+								pos: position{line: 286, col: 29, offset: 8536},
 								expr: &ruleRefExpr{
-									pos:  position{line: 276, col: 29, offset: 8298},
+									pos:  position{line: 286, col: 29, offset: 8536},
 									name: "paddedFieldExpr",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 276, col: 47, offset: 8316},
+							pos: position{line: 286, col: 46, offset: 8553},
 							expr: &ruleRefExpr{
-								pos:  position{line: 276, col: 47, offset: 8316},
+								pos:  position{line: 286, col: 46, offset: 8553},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 276, col: 50, offset: 8319},
+							pos:        position{line: 286, col: 49, offset: 8556},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -1984,59 +2083,171 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "fieldReducerFunc",
+			pos:  position{line: 296, col: 1, offset: 8701},
+			expr: &actionExpr{
+				pos: position{line: 297, col: 5, offset: 8722},
+				run: (*parser).callonfieldReducerFunc1,
+				expr: &labeledExpr{
+					pos:   position{line: 297, col: 5, offset: 8722},
+					label: "op",
+					expr: &ruleRefExpr{
+						pos:  position{line: 297, col: 8, offset: 8725},
+						name: "fieldReducerOp",
+					},
+				},
+			},
+		},
 		{
 			name: "fieldReducer",
-			pos:  position{line: 284, col: 1, offset: 8462},
+			pos:  position{line: 301, col: 1, offset: 8832},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 5, offset: 8479},
+				pos: position{line: 302, col: 5, offset: 8849},
 				run: (*parser).callonfieldReducer1,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 5, offset: 8479},
+					pos: position{line: 302, col: 5, offset: 8849},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 302, col: 5, offset: 8849},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 8, offset: 8852},
+								name: "fieldReducerFunc",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 302, col: 25, offset: 8869},
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 25, offset: 8869},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 302, col: 28, offset: 8872},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 302, col: 32, offset: 8876},
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 32, offset: 8876},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 302, col: 35, offset: 8879},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 41, offset: 8885},
+								name: "fieldExpr",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 302, col: 51, offset: 8895},
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 51, offset: 8895},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 302, col: 54, offset: 8898},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "paramReducer",
+			pos:  position{line: 310, col: 1, offset: 9099},
+			expr: &actionExpr{
+				pos: position{line: 311, col: 5, offset: 9116},
+				run: (*parser).callonparamReducer1,
+				expr: &seqExpr{
+					pos: position{line: 311, col: 5, offset: 9116},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 285, col: 5, offset: 8479},
+							pos:   position{line: 311, col: 5, offset: 9116},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 8, offset: 8482},
-								name: "fieldReducerOp",
+								pos:  position{line: 311, col: 8, offset: 9119},
+								name: "paramReducerOp",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 285, col: 23, offset: 8497},
+							pos: position{line: 311, col: 23, offset: 9134},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 23, offset: 8497},
+								pos:  position{line: 311, col: 23, offset: 9134},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 285, col: 26, offset: 8500},
+							pos:        position{line: 311, col: 26, offset: 9137},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 285, col: 30, offset: 8504},
+							pos: position{line: 311, col: 30, offset: 9141},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 30, offset: 8504},
+								pos:  position{line: 311, col: 30, offset: 9141},
 								name: "_",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 285, col: 33, offset: 8507},
+							pos:   position{line: 311, col: 33, offset: 9144},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 39, offset: 8513},
+								pos:  position{line: 311, col: 39, offset: 9150},
 								name: "fieldExpr",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 285, col: 50, offset: 8524},
+							pos: position{line: 311, col: 49, offset: 9160},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 50, offset: 8524},
+								pos:  position{line: 311, col: 49, offset: 9160},
 								name: "_",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 285, col: 53, offset: 8527},
+							pos:        position{line: 311, col: 52, offset: 9163},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 311, col: 56, offset: 9167},
+							expr: &ruleRefExpr{
+								pos:  position{line: 311, col: 56, offset: 9167},
+								name: "_",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 311, col: 59, offset: 9170},
+							label: "param",
+							expr: &choiceExpr{
+								pos: position{line: 311, col: 66, offset: 9177},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 311, col: 66, offset: 9177},
+										name: "double",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 311, col: 75, offset: 9186},
+										name: "unsignedInteger",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 311, col: 92, offset: 9203},
+							expr: &ruleRefExpr{
+								pos:  position{line: 311, col: 92, offset: 9203},
+								name: "_",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 311, col: 95, offset: 9206},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2046,27 +2257,27 @@ var g = &grammar{
 		},
 		{
 			name: "groupByProc",
-			pos:  position{line: 293, col: 1, offset: 8678},
+			pos:  position{line: 315, col: 1, offset: 9322},
 			expr: &actionExpr{
-				pos: position{line: 294, col: 5, offset: 8694},
+				pos: position{line: 316, col: 5, offset: 9338},
 				run: (*parser).callongroupByProc1,
 				expr: &seqExpr{
-					pos: position{line: 294, col: 5, offset: 8694},
+					pos: position{line: 316, col: 5, offset: 9338},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 294, col: 5, offset: 8694},
+							pos:   position{line: 316, col: 5, offset: 9338},
 							label: "every",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 294, col: 11, offset: 8700},
+								pos: position{line: 316, col: 11, offset: 9344},
 								expr: &seqExpr{
-									pos: position{line: 294, col: 12, offset: 8701},
+									pos: position{line: 316, col: 12, offset: 9345},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 294, col: 12, offset: 8701},
+											pos:  position{line: 316, col: 12, offset: 9345},
 											name: "everyDur",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 294, col: 21, offset: 8710},
+											pos:  position{line: 316, col: 21, offset: 9354},
 											name: "_",
 										},
 									},
@@ -2074,27 +2285,27 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 294, col: 25, offset: 8714},
+							pos:   position{line: 316, col: 25, offset: 9358},
 							label: "reducers",
 							expr: &ruleRefExpr{
-								pos:  position{line: 294, col: 34, offset: 8723},
+								pos:  position{line: 316, col: 34, offset: 9367},
 								name: "reducerList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 294, col: 46, offset: 8735},
+							pos:   position{line: 316, col: 46, offset: 9379},
 							label: "keys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 294, col: 51, offset: 8740},
+								pos: position{line: 316, col: 51, offset: 9384},
 								expr: &seqExpr{
-									pos: position{line: 294, col: 52, offset: 8741},
+									pos: position{line: 316, col: 52, offset: 9385},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 294, col: 52, offset: 8741},
+											pos:  position{line: 316, col: 52, offset: 9385},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 294, col: 54, offset: 8743},
+											pos:  position{line: 316, col: 54, offset: 9387},
 											name: "groupByKeys",
 										},
 									},
@@ -2102,12 +2313,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 294, col: 68, offset: 8757},
+							pos:   position{line: 316, col: 68, offset: 9401},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 294, col: 74, offset: 8763},
+								pos: position{line: 316, col: 74, offset: 9407},
 								expr: &ruleRefExpr{
-									pos:  position{line: 294, col: 74, offset: 8763},
+									pos:  position{line: 316, col: 74, offset: 9407},
 									name: "procLimitArg",
 								},
 							},
@@ -2118,48 +2329,48 @@ var g = &grammar{
 		},
 		{
 			name: "reducerExpr",
-			pos:  position{line: 311, col: 1, offset: 9228},
+			pos:  position{line: 333, col: 1, offset: 9872},
 			expr: &choiceExpr{
-				pos: position{line: 312, col: 5, offset: 9244},
+				pos: position{line: 334, col: 5, offset: 9888},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 312, col: 5, offset: 9244},
+						pos: position{line: 334, col: 5, offset: 9888},
 						run: (*parser).callonreducerExpr2,
 						expr: &seqExpr{
-							pos: position{line: 312, col: 5, offset: 9244},
+							pos: position{line: 334, col: 5, offset: 9888},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 312, col: 5, offset: 9244},
+									pos:   position{line: 334, col: 5, offset: 9888},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 11, offset: 9250},
+										pos:  position{line: 334, col: 11, offset: 9894},
 										name: "fieldName",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 312, col: 21, offset: 9260},
+									pos: position{line: 334, col: 21, offset: 9904},
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 21, offset: 9260},
+										pos:  position{line: 334, col: 21, offset: 9904},
 										name: "_",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 312, col: 24, offset: 9263},
+									pos:        position{line: 334, col: 24, offset: 9907},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 312, col: 28, offset: 9267},
+									pos: position{line: 334, col: 28, offset: 9911},
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 28, offset: 9267},
+										pos:  position{line: 334, col: 28, offset: 9911},
 										name: "_",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 312, col: 31, offset: 9270},
+									pos:   position{line: 334, col: 31, offset: 9914},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 312, col: 33, offset: 9272},
+										pos:  position{line: 334, col: 33, offset: 9916},
 										name: "reducer",
 									},
 								},
@@ -2167,7 +2378,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 317, col: 5, offset: 9372},
+						pos:  position{line: 339, col: 5, offset: 10012},
 						name: "reducer",
 					},
 				},
@@ -2175,16 +2386,20 @@ var g = &grammar{
 		},
 		{
 			name: "reducer",
-			pos:  position{line: 319, col: 1, offset: 9381},
+			pos:  position{line: 341, col: 1, offset: 10021},
 			expr: &choiceExpr{
-				pos: position{line: 320, col: 5, offset: 9393},
+				pos: position{line: 342, col: 5, offset: 10033},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 320, col: 5, offset: 9393},
+						pos:  position{line: 342, col: 5, offset: 10033},
 						name: "countReducer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 321, col: 5, offset: 9410},
+						pos:  position{line: 343, col: 5, offset: 10050},
+						name: "paramReducer",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 344, col: 5, offset: 10067},
 						name: "fieldReducer",
 					},
 				},
@@ -2192,50 +2407,50 @@ var g = &grammar{
 		},
 		{
 			name: "reducerList",
-			pos:  position{line: 323, col: 1, offset: 9424},
+			pos:  position{line: 346, col: 1, offset: 10081},
 			expr: &actionExpr{
-				pos: position{line: 324, col: 5, offset: 9440},
+				pos: position{line: 347, col: 5, offset: 10097},
 				run: (*parser).callonreducerList1,
 				expr: &seqExpr{
-					pos: position{line: 324, col: 5, offset: 9440},
+					pos: position{line: 347, col: 5, offset: 10097},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 324, col: 5, offset: 9440},
+							pos:   position{line: 347, col: 5, offset: 10097},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 324, col: 11, offset: 9446},
+								pos:  position{line: 347, col: 11, offset: 10103},
 								name: "reducerExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 324, col: 23, offset: 9458},
+							pos:   position{line: 347, col: 23, offset: 10115},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 324, col: 28, offset: 9463},
+								pos: position{line: 347, col: 28, offset: 10120},
 								expr: &seqExpr{
-									pos: position{line: 324, col: 29, offset: 9464},
+									pos: position{line: 347, col: 29, offset: 10121},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 324, col: 29, offset: 9464},
+											pos: position{line: 347, col: 29, offset: 10121},
 											expr: &ruleRefExpr{
-												pos:  position{line: 324, col: 29, offset: 9464},
+												pos:  position{line: 347, col: 29, offset: 10121},
 												name: "_",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 324, col: 32, offset: 9467},
+											pos:        position{line: 347, col: 32, offset: 10124},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 324, col: 36, offset: 9471},
+											pos: position{line: 347, col: 36, offset: 10128},
 											expr: &ruleRefExpr{
-												pos:  position{line: 324, col: 36, offset: 9471},
+												pos:  position{line: 347, col: 36, offset: 10128},
 												name: "_",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 324, col: 39, offset: 9474},
+											pos:  position{line: 347, col: 39, offset: 10131},
 											name: "reducerExpr",
 										},
 									},
@@ -2248,91 +2463,95 @@ var g = &grammar{
 		},
 		{
 			name: "simpleProc",
-			pos:  position{line: 332, col: 1, offset: 9673},
+			pos:  position{line: 355, col: 1, offset: 10328},
 			expr: &choiceExpr{
-				pos: position{line: 333, col: 5, offset: 9688},
+				pos: position{line: 356, col: 5, offset: 10343},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 5, offset: 9688},
+						pos:  position{line: 356, col: 5, offset: 10343},
 						name: "sort",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 5, offset: 9697},
+						pos:  position{line: 357, col: 5, offset: 10352},
 						name: "top",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 5, offset: 9705},
+						pos:  position{line: 358, col: 5, offset: 10360},
 						name: "cut",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 336, col: 5, offset: 9713},
+						pos:  position{line: 359, col: 5, offset: 10368},
 						name: "head",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 337, col: 5, offset: 9722},
+						pos:  position{line: 360, col: 5, offset: 10377},
 						name: "tail",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 338, col: 5, offset: 9731},
+						pos:  position{line: 361, col: 5, offset: 10386},
 						name: "filter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 5, offset: 9742},
+						pos:  position{line: 362, col: 5, offset: 10397},
 						name: "uniq",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 340, col: 5, offset: 9751},
+						pos:  position{line: 363, col: 5, offset: 10406},
 						name: "put",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 341, col: 5, offset: 9759},
+						pos:  position{line: 364, col: 5, offset: 10414},
 						name: "rename",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 365, col: 5, offset: 10425},
+						name: "join",
+					},
 				},
 			},
 		},
 		{
 			name: "sort",
-			pos:  position{line: 343, col: 1, offset: 9767},
+			pos:  position{line: 367, col: 1, offset: 10431},
 			expr: &actionExpr{
-				pos: position{line: 344, col: 5, offset: 9776},
+				pos: position{line: 368, col: 5, offset: 10440},
 				run: (*parser).callonsort1,
 				expr: &seqExpr{
-					pos: position{line: 344, col: 5, offset: 9776},
+					pos: position{line: 368, col: 5, offset: 10440},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 344, col: 5, offset: 9776},
+							pos:        position{line: 368, col: 5, offset: 10440},
 							val:        "sort",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 344, col: 13, offset: 9784},
+							pos:   position{line: 368, col: 13, offset: 10448},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 344, col: 18, offset: 9789},
+								pos:  position{line: 368, col: 18, offset: 10453},
 								name: "sortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 344, col: 27, offset: 9798},
+							pos:   position{line: 368, col: 27, offset: 10462},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 344, col: 32, offset: 9803},
+								pos: position{line: 368, col: 32, offset: 10467},
 								expr: &actionExpr{
-									pos: position{line: 344, col: 33, offset: 9804},
+									pos: position{line: 368, col: 33, offset: 10468},
 									run: (*parser).callonsort8,
 									expr: &seqExpr{
-										pos: position{line: 344, col: 33, offset: 9804},
+										pos: position{line: 368, col: 33, offset: 10468},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 344, col: 33, offset: 9804},
+												pos:  position{line: 368, col: 33, offset: 10468},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 344, col: 35, offset: 9806},
+												pos:   position{line: 368, col: 35, offset: 10470},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 344, col: 37, offset: 9808},
+													pos:  position{line: 368, col: 37, offset: 10472},
 													name: "fieldExprList",
 												},
 											},
@@ -2347,30 +2566,30 @@ var g = &grammar{
 		},
 		{
 			name: "sortArgs",
-			pos:  position{line: 358, col: 1, offset: 10211},
+			pos:  position{line: 382, col: 1, offset: 10873},
 			expr: &actionExpr{
-				pos: position{line: 358, col: 12, offset: 10222},
+				pos: position{line: 382, col: 12, offset: 10884},
 				run: (*parser).callonsortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 358, col: 12, offset: 10222},
+					pos:   position{line: 382, col: 12, offset: 10884},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 358, col: 17, offset: 10227},
+						pos: position{line: 382, col: 17, offset: 10889},
 						expr: &actionExpr{
-							pos: position{line: 358, col: 18, offset: 10228},
+							pos: position{line: 382, col: 18, offset: 10890},
 							run: (*parser).callonsortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 358, col: 18, offset: 10228},
+								pos: position{line: 382, col: 18, offset: 10890},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 358, col: 18, offset: 10228},
+										pos:  position{line: 382, col: 18, offset: 10890},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 358, col: 20, offset: 10230},
+										pos:   position{line: 382, col: 20, offset: 10892},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 358, col: 22, offset: 10232},
+											pos:  position{line: 382, col: 22, offset: 10894},
 											name: "sortArg",
 										},
 									},
@@ -2383,50 +2602,50 @@ var g = &grammar{
 		},
 		{
 			name: "sortArg",
-			pos:  position{line: 362, col: 1, offset: 10292},
+			pos:  position{line: 386, col: 1, offset: 10954},
 			expr: &choiceExpr{
-				pos: position{line: 363, col: 5, offset: 10304},
+				pos: position{line: 387, col: 5, offset: 10966},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 363, col: 5, offset: 10304},
+						pos: position{line: 387, col: 5, offset: 10966},
 						run: (*parser).callonsortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 363, col: 5, offset: 10304},
+							pos:        position{line: 387, col: 5, offset: 10966},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 364, col: 5, offset: 10379},
+						pos: position{line: 388, col: 5, offset: 11041},
 						run: (*parser).callonsortArg4,
 						expr: &seqExpr{
-							pos: position{line: 364, col: 5, offset: 10379},
+							pos: position{line: 388, col: 5, offset: 11041},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 364, col: 5, offset: 10379},
+									pos:        position{line: 388, col: 5, offset: 11041},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 364, col: 14, offset: 10388},
+									pos:  position{line: 388, col: 14, offset: 11050},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 364, col: 16, offset: 10390},
+									pos:   position{line: 388, col: 16, offset: 11052},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 364, col: 23, offset: 10397},
+										pos: position{line: 388, col: 23, offset: 11059},
 										run: (*parser).callonsortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 364, col: 24, offset: 10398},
+											pos: position{line: 388, col: 24, offset: 11060},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 364, col: 24, offset: 10398},
+													pos:        position{line: 388, col: 24, offset: 11060},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 364, col: 34, offset: 10408},
+													pos:        position{line: 388, col: 34, offset: 11070},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2442,38 +2661,38 @@ var g = &grammar{
 		},
 		{
 			name: "top",
-			pos:  position{line: 366, col: 1, offset: 10522},
+			pos:  position{line: 390, col: 1, offset: 11184},
 			expr: &actionExpr{
-				pos: position{line: 367, col: 5, offset: 10530},
+				pos: position{line: 391, col: 5, offset: 11192},
 				run: (*parser).callontop1,
 				expr: &seqExpr{
-					pos: position{line: 367, col: 5, offset: 10530},
+					pos: position{line: 391, col: 5, offset: 11192},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 367, col: 5, offset: 10530},
+							pos:        position{line: 391, col: 5, offset: 11192},
 							val:        "top",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 367, col: 12, offset: 10537},
+							pos:   position{line: 391, col: 12, offset: 11199},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 367, col: 18, offset: 10543},
+								pos: position{line: 391, col: 18, offset: 11205},
 								expr: &actionExpr{
-									pos: position{line: 367, col: 19, offset: 10544},
+									pos: position{line: 391, col: 19, offset: 11206},
 									run: (*parser).callontop6,
 									expr: &seqExpr{
-										pos: position{line: 367, col: 19, offset: 10544},
+										pos: position{line: 391, col: 19, offset: 11206},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 367, col: 19, offset: 10544},
+												pos:  position{line: 391, col: 19, offset: 11206},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 367, col: 21, offset: 10546},
+												pos:   position{line: 391, col: 21, offset: 11208},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 367, col: 23, offset: 10548},
+													pos:  position{line: 391, col: 23, offset: 11210},
 													name: "unsignedInteger",
 												},
 											},
@@ -2483,19 +2702,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 367, col: 58, offset: 10583},
+							pos:   position{line: 391, col: 58, offset: 11245},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 367, col: 64, offset: 10589},
+								pos: position{line: 391, col: 64, offset: 11251},
 								expr: &seqExpr{
-									pos: position{line: 367, col: 65, offset: 10590},
+									pos: position{line: 391, col: 65, offset: 11252},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 367, col: 65, offset: 10590},
+											pos:  position{line: 391, col: 65, offset: 11252},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 367, col: 67, offset: 10592},
+											pos:        position{line: 391, col: 67, offset: 11254},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2504,25 +2723,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 367, col: 78, offset: 10603},
+							pos:   position{line: 391, col: 78, offset: 11265},
 							label: "fields",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 367, col: 85, offset: 10610},
+								pos: position{line: 391, col: 85, offset: 11272},
 								expr: &actionExpr{
-									pos: position{line: 367, col: 86, offset: 10611},
+									pos: position{line: 391, col: 86, offset: 11273},
 									run: (*parser).callontop18,
 									expr: &seqExpr{
-										pos: position{line: 367, col: 86, offset: 10611},
+										pos: position{line: 391, col: 86, offset: 11273},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 367, col: 86, offset: 10611},
+												pos:  position{line: 391, col: 86, offset: 11273},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 367, col: 88, offset: 10613},
+												pos:   position{line: 391, col: 88, offset: 11275},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 367, col: 90, offset: 10615},
+													pos:  position{line: 391, col: 90, offset: 11277},
 													name: "fieldExprList",
 												},
 											},
@@ -2537,31 +2756,31 @@ var g = &grammar{
 		},
 		{
 			name: "procLimitArg",
-			pos:  position{line: 381, col: 1, offset: 10902},
+			pos:  position{line: 405, col: 1, offset: 11564},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 5, offset: 10919},
+				pos: position{line: 406, col: 5, offset: 11581},
 				run: (*parser).callonprocLimitArg1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 5, offset: 10919},
+					pos: position{line: 406, col: 5, offset: 11581},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 382, col: 5, offset: 10919},
+							pos:  position{line: 406, col: 5, offset: 11581},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 382, col: 7, offset: 10921},
+							pos:        position{line: 406, col: 7, offset: 11583},
 							val:        "-limit",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 382, col: 16, offset: 10930},
+							pos:  position{line: 406, col: 16, offset: 11592},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 382, col: 18, offset: 10932},
+							pos:   position{line: 406, col: 18, offset: 11594},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 382, col: 24, offset: 10938},
+								pos:  position{line: 406, col: 24, offset: 11600},
 								name: "unsignedInteger",
 							},
 						},
@@ -2571,27 +2790,27 @@ var g = &grammar{
 		},
 		{
 			name: "cutArgs",
-			pos:  position{line: 384, col: 1, offset: 10977},
+			pos:  position{line: 408, col: 1, offset: 11639},
 			expr: &actionExpr{
-				pos: position{line: 385, col: 5, offset: 10989},
+				pos: position{line: 409, col: 5, offset: 11651},
 				run: (*parser).calloncutArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 385, col: 5, offset: 10989},
+					pos:   position{line: 409, col: 5, offset: 11651},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 385, col: 10, offset: 10994},
+						pos: position{line: 409, col: 10, offset: 11656},
 						expr: &actionExpr{
-							pos: position{line: 385, col: 11, offset: 10995},
+							pos: position{line: 409, col: 11, offset: 11657},
 							run: (*parser).calloncutArgs4,
 							expr: &seqExpr{
-								pos: position{line: 385, col: 11, offset: 10995},
+								pos: position{line: 409, col: 11, offset: 11657},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 385, col: 11, offset: 10995},
+										pos:  position{line: 409, col: 11, offset: 11657},
 										name: "_",
 									},
 									&litMatcher{
-										pos:        position{line: 385, col: 13, offset: 10997},
+										pos:        position{line: 409, col: 13, offset: 11659},
 										val:        "-c",
 										ignoreCase: false,
 									},
@@ -2604,22 +2823,22 @@ var g = &grammar{
 		},
 		{
 			name: "cutAssignment",
-			pos:  position{line: 389, col: 1, offset: 11105},
+			pos:  position{line: 413, col: 1, offset: 11767},
 			expr: &choiceExpr{
-				pos: position{line: 390, col: 5, offset: 11123},
+				pos: position{line: 414, col: 5, offset: 11785},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 390, col: 5, offset: 11123},
+						pos:  position{line: 414, col: 5, offset: 11785},
 						name: "FieldAssignment",
 					},
 					&actionExpr{
-						pos: position{line: 391, col: 5, offset: 11143},
+						pos: position{line: 415, col: 5, offset: 11805},
 						run: (*parser).calloncutAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 391, col: 5, offset: 11143},
+							pos:   position{line: 415, col: 5, offset: 11805},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 391, col: 11, offset: 11149},
+								pos:  position{line: 415, col: 11, offset: 11811},
 								name: "fieldRefDotOnly",
 							},
 						},
@@ -2629,67 +2848,67 @@ var g = &grammar{
 		},
 		{
 			name: "cut",
-			pos:  position{line: 395, col: 1, offset: 11242},
+			pos:  position{line: 419, col: 1, offset: 11904},
 			expr: &actionExpr{
-				pos: position{line: 396, col: 5, offset: 11250},
+				pos: position{line: 420, col: 5, offset: 11912},
 				run: (*parser).calloncut1,
 				expr: &seqExpr{
-					pos: position{line: 396, col: 5, offset: 11250},
+					pos: position{line: 420, col: 5, offset: 11912},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 396, col: 5, offset: 11250},
+							pos:        position{line: 420, col: 5, offset: 11912},
 							val:        "cut",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 396, col: 12, offset: 11257},
+							pos:   position{line: 420, col: 12, offset: 11919},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 396, col: 17, offset: 11262},
+								pos:  position{line: 420, col: 17, offset: 11924},
 								name: "cutArgs",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 396, col: 25, offset: 11270},
+							pos:  position{line: 420, col: 25, offset: 11932},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 396, col: 27, offset: 11272},
+							pos:   position{line: 420, col: 27, offset: 11934},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 396, col: 33, offset: 11278},
+								pos:  position{line: 420, col: 33, offset: 11940},
 								name: "cutAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 396, col: 47, offset: 11292},
+							pos:   position{line: 420, col: 47, offset: 11954},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 396, col: 52, offset: 11297},
+								pos: position{line: 420, col: 52, offset: 11959},
 								expr: &actionExpr{
-									pos: position{line: 396, col: 53, offset: 11298},
+									pos: position{line: 420, col: 53, offset: 11960},
 									run: (*parser).calloncut11,
 									expr: &seqExpr{
-										pos: position{line: 396, col: 53, offset: 11298},
+										pos: position{line: 420, col: 53, offset: 11960},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 396, col: 53, offset: 11298},
+												pos:  position{line: 420, col: 53, offset: 11960},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 396, col: 56, offset: 11301},
+												pos:        position{line: 420, col: 56, offset: 11963},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 396, col: 60, offset: 11305},
+												pos:  position{line: 420, col: 60, offset: 11967},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 396, col: 63, offset: 11308},
+												pos:   position{line: 420, col: 63, offset: 11970},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 396, col: 66, offset: 11311},
+													pos:  position{line: 420, col: 66, offset: 11973},
 													name: "cutAssignment",
 												},
 											},
@@ -2704,30 +2923,30 @@ var g = &grammar{
 		},
 		{
 			name: "head",
-			pos:  position{line: 404, col: 1, offset: 11634},
+			pos:  position{line: 428, col: 1, offset: 12293},
 			expr: &choiceExpr{
-				pos: position{line: 405, col: 5, offset: 11643},
+				pos: position{line: 429, col: 5, offset: 12302},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 405, col: 5, offset: 11643},
+						pos: position{line: 429, col: 5, offset: 12302},
 						run: (*parser).callonhead2,
 						expr: &seqExpr{
-							pos: position{line: 405, col: 5, offset: 11643},
+							pos: position{line: 429, col: 5, offset: 12302},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 405, col: 5, offset: 11643},
+									pos:        position{line: 429, col: 5, offset: 12302},
 									val:        "head",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 405, col: 13, offset: 11651},
+									pos:  position{line: 429, col: 13, offset: 12310},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 405, col: 15, offset: 11653},
+									pos:   position{line: 429, col: 15, offset: 12312},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 405, col: 21, offset: 11659},
+										pos:  position{line: 429, col: 21, offset: 12318},
 										name: "unsignedInteger",
 									},
 								},
@@ -2735,10 +2954,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 406, col: 5, offset: 11752},
+						pos: position{line: 430, col: 5, offset: 12411},
 						run: (*parser).callonhead8,
 						expr: &litMatcher{
-							pos:        position{line: 406, col: 5, offset: 11752},
+							pos:        position{line: 430, col: 5, offset: 12411},
 							val:        "head",
 							ignoreCase: true,
 						},
@@ -2748,30 +2967,30 @@ var g = &grammar{
 		},
 		{
 			name: "tail",
-			pos:  position{line: 407, col: 1, offset: 11829},
+			pos:  position{line: 431, col: 1, offset: 12488},
 			expr: &choiceExpr{
-				pos: position{line: 408, col: 5, offset: 11838},
+				pos: position{line: 432, col: 5, offset: 12497},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 408, col: 5, offset: 11838},
+						pos: position{line: 432, col: 5, offset: 12497},
 						run: (*parser).callontail2,
 						expr: &seqExpr{
-							pos: position{line: 408, col: 5, offset: 11838},
+							pos: position{line: 432, col: 5, offset: 12497},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 408, col: 5, offset: 11838},
+									pos:        position{line: 432, col: 5, offset: 12497},
 									val:        "tail",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 408, col: 13, offset: 11846},
+									pos:  position{line: 432, col: 13, offset: 12505},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 408, col: 15, offset: 11848},
+									pos:   position{line: 432, col: 15, offset: 12507},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 408, col: 21, offset: 11854},
+										pos:  position{line: 432, col: 21, offset: 12513},
 										name: "unsignedInteger",
 									},
 								},
@@ -2779,10 +2998,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 409, col: 5, offset: 11947},
+						pos: position{line: 433, col: 5, offset: 12606},
 						run: (*parser).callontail8,
 						expr: &litMatcher{
-							pos:        position{line: 409, col: 5, offset: 11947},
+							pos:        position{line: 433, col: 5, offset: 12606},
 							val:        "tail",
 							ignoreCase: true,
 						},
@@ -2792,27 +3011,27 @@ var g = &grammar{
 		},
 		{
 			name: "filter",
-			pos:  position{line: 411, col: 1, offset: 12025},
+			pos:  position{line: 435, col: 1, offset: 12684},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 5, offset: 12036},
+				pos: position{line: 436, col: 5, offset: 12695},
 				run: (*parser).callonfilter1,
 				expr: &seqExpr{
-					pos: position{line: 412, col: 5, offset: 12036},
+					pos: position{line: 436, col: 5, offset: 12695},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 412, col: 5, offset: 12036},
+							pos:        position{line: 436, col: 5, offset: 12695},
 							val:        "filter",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 412, col: 15, offset: 12046},
+							pos:  position{line: 436, col: 15, offset: 12705},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 412, col: 17, offset: 12048},
+							pos:   position{line: 436, col: 17, offset: 12707},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 412, col: 22, offset: 12053},
+								pos:  position{line: 436, col: 22, offset: 12712},
 								name: "searchExpr",
 							},
 						},
@@ -2822,27 +3041,27 @@ var g = &grammar{
 		},
 		{
 			name: "uniq",
-			pos:  position{line: 415, col: 1, offset: 12149},
+			pos:  position{line: 439, col: 1, offset: 12808},
 			expr: &choiceExpr{
-				pos: position{line: 416, col: 5, offset: 12158},
+				pos: position{line: 440, col: 5, offset: 12817},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 416, col: 5, offset: 12158},
+						pos: position{line: 440, col: 5, offset: 12817},
 						run: (*parser).callonuniq2,
 						expr: &seqExpr{
-							pos: position{line: 416, col: 5, offset: 12158},
+							pos: position{line: 440, col: 5, offset: 12817},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 416, col: 5, offset: 12158},
+									pos:        position{line: 440, col: 5, offset: 12817},
 									val:        "uniq",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 416, col: 13, offset: 12166},
+									pos:  position{line: 440, col: 13, offset: 12825},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 416, col: 15, offset: 12168},
+									pos:        position{line: 440, col: 15, offset: 12827},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2850,10 +3069,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 419, col: 5, offset: 12259},
+						pos: position{line: 443, col: 5, offset: 12918},
 						run: (*parser).callonuniq7,
 						expr: &litMatcher{
-							pos:        position{line: 419, col: 5, offset: 12259},
+							pos:        position{line: 443, col: 5, offset: 12918},
 							val:        "uniq",
 							ignoreCase: true,
 						},
@@ -2863,59 +3082,59 @@ var g = &grammar{
 		},
 		{
 			name: "put",
-			pos:  position{line: 423, col: 1, offset: 12351},
+			pos:  position{line: 447, col: 1, offset: 13010},
 			expr: &actionExpr{
-				pos: position{line: 424, col: 5, offset: 12359},
+				pos: position{line: 448, col: 5, offset: 13018},
 				run: (*parser).callonput1,
 				expr: &seqExpr{
-					pos: position{line: 424, col: 5, offset: 12359},
+					pos: position{line: 448, col: 5, offset: 13018},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 424, col: 5, offset: 12359},
+							pos:        position{line: 448, col: 5, offset: 13018},
 							val:        "put",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 424, col: 12, offset: 12366},
+							pos:  position{line: 448, col: 12, offset: 13025},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 424, col: 14, offset: 12368},
+							pos:   position{line: 448, col: 14, offset: 13027},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 424, col: 20, offset: 12374},
+								pos:  position{line: 448, col: 20, offset: 13033},
 								name: "ExpressionAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 424, col: 41, offset: 12395},
+							pos:   position{line: 448, col: 41, offset: 13054},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 424, col: 46, offset: 12400},
+								pos: position{line: 448, col: 46, offset: 13059},
 								expr: &actionExpr{
-									pos: position{line: 424, col: 47, offset: 12401},
+									pos: position{line: 448, col: 47, offset: 13060},
 									run: (*parser).callonput9,
 									expr: &seqExpr{
-										pos: position{line: 424, col: 47, offset: 12401},
+										pos: position{line: 448, col: 47, offset: 13060},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 424, col: 47, offset: 12401},
+												pos:  position{line: 448, col: 47, offset: 13060},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 424, col: 50, offset: 12404},
+												pos:        position{line: 448, col: 50, offset: 13063},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 424, col: 54, offset: 12408},
+												pos:  position{line: 448, col: 54, offset: 13067},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 424, col: 57, offset: 12411},
+												pos:   position{line: 448, col: 57, offset: 13070},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 424, col: 60, offset: 12414},
+													pos:  position{line: 448, col: 60, offset: 13073},
 													name: "ExpressionAssignment",
 												},
 											},
@@ -2930,59 +3149,59 @@ var g = &grammar{
 		},
 		{
 			name: "rename",
-			pos:  position{line: 428, col: 1, offset: 12592},
+			pos:  position{line: 452, col: 1, offset: 13250},
 			expr: &actionExpr{
-				pos: position{line: 429, col: 5, offset: 12603},
+				pos: position{line: 453, col: 5, offset: 13261},
 				run: (*parser).callonrename1,
 				expr: &seqExpr{
-					pos: position{line: 429, col: 5, offset: 12603},
+					pos: position{line: 453, col: 5, offset: 13261},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 429, col: 5, offset: 12603},
+							pos:        position{line: 453, col: 5, offset: 13261},
 							val:        "rename",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 429, col: 15, offset: 12613},
+							pos:  position{line: 453, col: 15, offset: 13271},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 429, col: 17, offset: 12615},
+							pos:   position{line: 453, col: 17, offset: 13273},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 429, col: 23, offset: 12621},
+								pos:  position{line: 453, col: 23, offset: 13279},
 								name: "FieldAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 429, col: 39, offset: 12637},
+							pos:   position{line: 453, col: 39, offset: 13295},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 429, col: 44, offset: 12642},
+								pos: position{line: 453, col: 44, offset: 13300},
 								expr: &actionExpr{
-									pos: position{line: 429, col: 45, offset: 12643},
+									pos: position{line: 453, col: 45, offset: 13301},
 									run: (*parser).callonrename9,
 									expr: &seqExpr{
-										pos: position{line: 429, col: 45, offset: 12643},
+										pos: position{line: 453, col: 45, offset: 13301},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 429, col: 45, offset: 12643},
+												pos:  position{line: 453, col: 45, offset: 13301},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 429, col: 48, offset: 12646},
+												pos:        position{line: 453, col: 48, offset: 13304},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 429, col: 52, offset: 12650},
+												pos:  position{line: 453, col: 52, offset: 13308},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 429, col: 55, offset: 12653},
+												pos:   position{line: 453, col: 55, offset: 13311},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 429, col: 58, offset: 12656},
+													pos:  position{line: 453, col: 58, offset: 13314},
 													name: "FieldAssignment",
 												},
 											},
@@ -2995,41 +3214,208 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "join",
+			pos:  position{line: 457, col: 1, offset: 13488},
+			expr: &actionExpr{
+				pos: position{line: 458, col: 5, offset: 13497},
+				run: (*parser).callonjoin1,
+				expr: &seqExpr{
+					pos: position{line: 458, col: 5, offset: 13497},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 458, col: 5, offset: 13497},
+							val:        "join",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 458, col: 13, offset: 13505},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 458, col: 18, offset: 13510},
+								name: "joinArgs",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 458, col: 27, offset: 13519},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 458, col: 29, offset: 13521},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 458, col: 35, offset: 13527},
+								name: "joinKey",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 458, col: 43, offset: 13535},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 458, col: 48, offset: 13540},
+								expr: &actionExpr{
+									pos: position{line: 458, col: 49, offset: 13541},
+									run: (*parser).callonjoin11,
+									expr: &seqExpr{
+										pos: position{line: 458, col: 49, offset: 13541},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 458, col: 49, offset: 13541},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 458, col: 52, offset: 13544},
+												val:        ",",
+												ignoreCase: false,
+											},
+											&ruleRefExpr{
+												pos:  position{line: 458, col: 56, offset: 13548},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 458, col: 59, offset: 13551},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 458, col: 61, offset: 13553},
+													name: "joinKey",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinArgs",
+			pos:  position{line: 473, col: 1, offset: 14170},
+			expr: &actionExpr{
+				pos: position{line: 474, col: 5, offset: 14183},
+				run: (*parser).callonjoinArgs1,
+				expr: &labeledExpr{
+					pos:   position{line: 474, col: 5, offset: 14183},
+					label: "args",
+					expr: &zeroOrMoreExpr{
+						pos: position{line: 474, col: 10, offset: 14188},
+						expr: &actionExpr{
+							pos: position{line: 474, col: 11, offset: 14189},
+							run: (*parser).callonjoinArgs4,
+							expr: &seqExpr{
+								pos: position{line: 474, col: 11, offset: 14189},
+								exprs: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 474, col: 11, offset: 14189},
+										name: "_",
+									},
+									&litMatcher{
+										pos:        position{line: 474, col: 13, offset: 14191},
+										val:        "-left",
+										ignoreCase: false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "joinKey",
+			pos:  position{line: 478, col: 1, offset: 14305},
+			expr: &choiceExpr{
+				pos: position{line: 479, col: 5, offset: 14317},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 479, col: 5, offset: 14317},
+						run: (*parser).callonjoinKey2,
+						expr: &seqExpr{
+							pos: position{line: 479, col: 5, offset: 14317},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 479, col: 5, offset: 14317},
+									label: "l",
+									expr: &ruleRefExpr{
+										pos:  position{line: 479, col: 7, offset: 14319},
+										name: "fieldExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 479, col: 17, offset: 14329},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 479, col: 20, offset: 14332},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 479, col: 24, offset: 14336},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 479, col: 27, offset: 14339},
+									label: "r",
+									expr: &ruleRefExpr{
+										pos:  position{line: 479, col: 29, offset: 14341},
+										name: "fieldExpr",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 482, col: 5, offset: 14427},
+						run: (*parser).callonjoinKey11,
+						expr: &labeledExpr{
+							pos:   position{line: 482, col: 5, offset: 14427},
+							label: "k",
+							expr: &ruleRefExpr{
+								pos:  position{line: 482, col: 7, offset: 14429},
+								name: "fieldExpr",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "ExpressionAssignment",
-			pos:  position{line: 433, col: 1, offset: 12831},
+			pos:  position{line: 486, col: 1, offset: 14512},
 			expr: &actionExpr{
-				pos: position{line: 434, col: 5, offset: 12856},
+				pos: position{line: 487, col: 5, offset: 14537},
 				run: (*parser).callonExpressionAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 434, col: 5, offset: 12856},
+					pos: position{line: 487, col: 5, offset: 14537},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 434, col: 5, offset: 12856},
+							pos:   position{line: 487, col: 5, offset: 14537},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 7, offset: 12858},
+								pos:  position{line: 487, col: 7, offset: 14539},
 								name: "fieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 434, col: 17, offset: 12868},
+							pos:  position{line: 487, col: 17, offset: 14549},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 434, col: 20, offset: 12871},
+							pos:        position{line: 487, col: 20, offset: 14552},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 434, col: 24, offset: 12875},
+							pos:  position{line: 487, col: 24, offset: 14556},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 434, col: 27, offset: 12878},
+							pos:   position{line: 487, col: 27, offset: 14559},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 29, offset: 12880},
+								pos:  position{line: 487, col: 29, offset: 14561},
 								name: "Expression",
 							},
 						},
@@ -3039,39 +3425,39 @@ var g = &grammar{
 		},
 		{
 			name: "FieldAssignment",
-			pos:  position{line: 438, col: 1, offset: 12971},
+			pos:  position{line: 491, col: 1, offset: 14652},
 			expr: &actionExpr{
-				pos: position{line: 439, col: 5, offset: 12991},
+				pos: position{line: 492, col: 5, offset: 14672},
 				run: (*parser).callonFieldAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 439, col: 5, offset: 12991},
+					pos: position{line: 492, col: 5, offset: 14672},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 439, col: 5, offset: 12991},
+							pos:   position{line: 492, col: 5, offset: 14672},
 							label: "l",
 							expr: &ruleRefExpr{
-								pos:  position{line: 439, col: 7, offset: 12993},
+								pos:  position{line: 492, col: 7, offset: 14674},
 								name: "fieldRefDotOnly",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 439, col: 23, offset: 13009},
+							pos:  position{line: 492, col: 23, offset: 14690},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 439, col: 26, offset: 13012},
+							pos:        position{line: 492, col: 26, offset: 14693},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 439, col: 30, offset: 13016},
+							pos:  position{line: 492, col: 30, offset: 14697},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 439, col: 33, offset: 13019},
+							pos:   position{line: 492, col: 33, offset: 14700},
 							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 439, col: 35, offset: 13021},
+								pos:  position{line: 492, col: 35, offset: 14702},
 								name: "fieldRefDotOnly",
 							},
 						},
@@ -3081,79 +3467,79 @@ var g = &grammar{
 		},
 		{
 			name: "PrimaryExpression",
-			pos:  position{line: 443, col: 1, offset: 13113},
+			pos:  position{line: 496, col: 1, offset: 14794},
 			expr: &choiceExpr{
-				pos: position{line: 444, col: 5, offset: 13135},
+				pos: position{line: 497, col: 5, offset: 14816},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 444, col: 5, offset: 13135},
+						pos:  position{line: 497, col: 5, offset: 14816},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 445, col: 5, offset: 13153},
+						pos:  position{line: 498, col: 5, offset: 14834},
 						name: "RegexpLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 446, col: 5, offset: 13171},
+						pos:  position{line: 499, col: 5, offset: 14852},
 						name: "PortLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 447, col: 5, offset: 13187},
+						pos:  position{line: 500, col: 5, offset: 14868},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 448, col: 5, offset: 13205},
+						pos:  position{line: 501, col: 5, offset: 14886},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 449, col: 5, offset: 13224},
+						pos:  position{line: 502, col: 5, offset: 14905},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 450, col: 5, offset: 13241},
+						pos:  position{line: 503, col: 5, offset: 14922},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 451, col: 5, offset: 13260},
+						pos:  position{line: 504, col: 5, offset: 14941},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 452, col: 5, offset: 13279},
+						pos:  position{line: 505, col: 5, offset: 14960},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 453, col: 5, offset: 13295},
+						pos:  position{line: 506, col: 5, offset: 14976},
 						name: "FieldReference",
 					},
 					&actionExpr{
-						pos: position{line: 454, col: 5, offset: 13314},
+						pos: position{line: 507, col: 5, offset: 14995},
 						run: (*parser).callonPrimaryExpression12,
 						expr: &seqExpr{
-							pos: position{line: 454, col: 5, offset: 13314},
+							pos: position{line: 507, col: 5, offset: 14995},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 454, col: 5, offset: 13314},
+									pos:        position{line: 507, col: 5, offset: 14995},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 454, col: 9, offset: 13318},
+									pos:  position{line: 507, col: 9, offset: 14999},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 454, col: 12, offset: 13321},
+									pos:   position{line: 507, col: 12, offset: 15002},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 454, col: 17, offset: 13326},
+										pos:  position{line: 507, col: 17, offset: 15007},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 454, col: 28, offset: 13337},
+									pos:  position{line: 507, col: 28, offset: 15018},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 454, col: 31, offset: 13340},
+									pos:        position{line: 507, col: 31, offset: 15021},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3165,15 +3551,15 @@ var g = &grammar{
 		},
 		{
 			name: "FieldReference",
-			pos:  position{line: 456, col: 1, offset: 13366},
+			pos:  position{line: 509, col: 1, offset: 15047},
 			expr: &actionExpr{
-				pos: position{line: 457, col: 5, offset: 13385},
+				pos: position{line: 510, col: 5, offset: 15066},
 				run: (*parser).callonFieldReference1,
 				expr: &labeledExpr{
-					pos:   position{line: 457, col: 5, offset: 13385},
+					pos:   position{line: 510, col: 5, offset: 15066},
 					label: "f",
 					expr: &ruleRefExpr{
-						pos:  position{line: 457, col: 7, offset: 13387},
+						pos:  position{line: 510, col: 7, offset: 15068},
 						name: "fieldName",
 					},
 				},
@@ -3181,71 +3567,71 @@ var g = &grammar{
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 473, col: 1, offset: 13825},
+			pos:  position{line: 526, col: 1, offset: 15334},
 			expr: &ruleRefExpr{
-				pos:  position{line: 473, col: 14, offset: 13838},
+				pos:  position{line: 526, col: 14, offset: 15347},
 				name: "ConditionalExpression",
 			},
 		},
 		{
 			name: "ConditionalExpression",
-			pos:  position{line: 475, col: 1, offset: 13861},
+			pos:  position{line: 528, col: 1, offset: 15370},
 			expr: &choiceExpr{
-				pos: position{line: 476, col: 5, offset: 13887},
+				pos: position{line: 529, col: 5, offset: 15396},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 476, col: 5, offset: 13887},
+						pos: position{line: 529, col: 5, offset: 15396},
 						run: (*parser).callonConditionalExpression2,
 						expr: &seqExpr{
-							pos: position{line: 476, col: 5, offset: 13887},
+							pos: position{line: 529, col: 5, offset: 15396},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 476, col: 5, offset: 13887},
+									pos:   position{line: 529, col: 5, offset: 15396},
 									label: "condition",
 									expr: &ruleRefExpr{
-										pos:  position{line: 476, col: 15, offset: 13897},
+										pos:  position{line: 529, col: 15, offset: 15406},
 										name: "LogicalORExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 476, col: 35, offset: 13917},
+									pos:  position{line: 529, col: 35, offset: 15426},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 476, col: 38, offset: 13920},
+									pos:        position{line: 529, col: 38, offset: 15429},
 									val:        "?",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 476, col: 42, offset: 13924},
+									pos:  position{line: 529, col: 42, offset: 15433},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 476, col: 45, offset: 13927},
+									pos:   position{line: 529, col: 45, offset: 15436},
 									label: "thenClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 476, col: 56, offset: 13938},
+										pos:  position{line: 529, col: 56, offset: 15447},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 476, col: 67, offset: 13949},
+									pos:  position{line: 529, col: 67, offset: 15458},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 476, col: 70, offset: 13952},
+									pos:        position{line: 529, col: 70, offset: 15461},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 476, col: 74, offset: 13956},
+									pos:  position{line: 529, col: 74, offset: 15465},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 476, col: 77, offset: 13959},
+									pos:   position{line: 529, col: 77, offset: 15468},
 									label: "elseClause",
 									expr: &ruleRefExpr{
-										pos:  position{line: 476, col: 88, offset: 13970},
+										pos:  position{line: 529, col: 88, offset: 15479},
 										name: "Expression",
 									},
 								},
@@ -3253,7 +3639,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 479, col: 5, offset: 14119},
+						pos:  position{line: 532, col: 5, offset: 15628},
 						name: "LogicalORExpression",
 					},
 				},
@@ -3261,53 +3647,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalORExpression",
-			pos:  position{line: 481, col: 1, offset: 14140},
+			pos:  position{line: 534, col: 1, offset: 15649},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 5, offset: 14164},
+				pos: position{line: 535, col: 5, offset: 15673},
 				run: (*parser).callonLogicalORExpression1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 5, offset: 14164},
+					pos: position{line: 535, col: 5, offset: 15673},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 482, col: 5, offset: 14164},
+							pos:   position{line: 535, col: 5, offset: 15673},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 11, offset: 14170},
+								pos:  position{line: 535, col: 11, offset: 15679},
 								name: "LogicalANDExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 483, col: 5, offset: 14195},
+							pos:   position{line: 536, col: 5, offset: 15704},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 483, col: 10, offset: 14200},
+								pos: position{line: 536, col: 10, offset: 15709},
 								expr: &actionExpr{
-									pos: position{line: 483, col: 11, offset: 14201},
+									pos: position{line: 536, col: 11, offset: 15710},
 									run: (*parser).callonLogicalORExpression7,
 									expr: &seqExpr{
-										pos: position{line: 483, col: 11, offset: 14201},
+										pos: position{line: 536, col: 11, offset: 15710},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 483, col: 11, offset: 14201},
+												pos:  position{line: 536, col: 11, offset: 15710},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 483, col: 14, offset: 14204},
+												pos:   position{line: 536, col: 14, offset: 15713},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 483, col: 17, offset: 14207},
+													pos:  position{line: 536, col: 17, offset: 15716},
 													name: "orToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 483, col: 25, offset: 14215},
+												pos:  position{line: 536, col: 25, offset: 15724},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 483, col: 28, offset: 14218},
+												pos:   position{line: 536, col: 28, offset: 15727},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 483, col: 33, offset: 14223},
+													pos:  position{line: 536, col: 33, offset: 15732},
 													name: "LogicalANDExpression",
 												},
 											},
@@ -3322,53 +3708,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalANDExpression",
-			pos:  position{line: 487, col: 1, offset: 14347},
+			pos:  position{line: 540, col: 1, offset: 15856},
 			expr: &actionExpr{
-				pos: position{line: 488, col: 5, offset: 14372},
+				pos: position{line: 541, col: 5, offset: 15881},
 				run: (*parser).callonLogicalANDExpression1,
 				expr: &seqExpr{
-					pos: position{line: 488, col: 5, offset: 14372},
+					pos: position{line: 541, col: 5, offset: 15881},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 488, col: 5, offset: 14372},
+							pos:   position{line: 541, col: 5, offset: 15881},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 488, col: 11, offset: 14378},
+								pos:  position{line: 541, col: 11, offset: 15887},
 								name: "EqualityCompareExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 489, col: 5, offset: 14408},
+							pos:   position{line: 542, col: 5, offset: 15917},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 489, col: 10, offset: 14413},
+								pos: position{line: 542, col: 10, offset: 15922},
 								expr: &actionExpr{
-									pos: position{line: 489, col: 11, offset: 14414},
+									pos: position{line: 542, col: 11, offset: 15923},
 									run: (*parser).callonLogicalANDExpression7,
 									expr: &seqExpr{
-										pos: position{line: 489, col: 11, offset: 14414},
+										pos: position{line: 542, col: 11, offset: 15923},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 489, col: 11, offset: 14414},
+												pos:  position{line: 542, col: 11, offset: 15923},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 489, col: 14, offset: 14417},
+												pos:   position{line: 542, col: 14, offset: 15926},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 489, col: 17, offset: 14420},
+													pos:  position{line: 542, col: 17, offset: 15929},
 													name: "andToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 489, col: 26, offset: 14429},
+												pos:  position{line: 542, col: 26, offset: 15938},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 489, col: 29, offset: 14432},
+												pos:   position{line: 542, col: 29, offset: 15941},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 489, col: 34, offset: 14437},
+													pos:  position{line: 542, col: 34, offset: 15946},
 													name: "EqualityCompareExpression",
 												},
 											},
@@ -3383,53 +3769,53 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityCompareExpression",
-			pos:  position{line: 493, col: 1, offset: 14566},
+			pos:  position{line: 546, col: 1, offset: 16075},
 			expr: &actionExpr{
-				pos: position{line: 494, col: 5, offset: 14596},
+				pos: position{line: 547, col: 5, offset: 16105},
 				run: (*parser).callonEqualityCompareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 494, col: 5, offset: 14596},
+					pos: position{line: 547, col: 5, offset: 16105},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 494, col: 5, offset: 14596},
+							pos:   position{line: 547, col: 5, offset: 16105},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 494, col: 11, offset: 14602},
+								pos:  position{line: 547, col: 11, offset: 16111},
 								name: "RelativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 495, col: 5, offset: 14625},
+							pos:   position{line: 548, col: 5, offset: 16134},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 495, col: 10, offset: 14630},
+								pos: position{line: 548, col: 10, offset: 16139},
 								expr: &actionExpr{
-									pos: position{line: 495, col: 11, offset: 14631},
+									pos: position{line: 548, col: 11, offset: 16140},
 									run: (*parser).callonEqualityCompareExpression7,
 									expr: &seqExpr{
-										pos: position{line: 495, col: 11, offset: 14631},
+										pos: position{line: 548, col: 11, offset: 16140},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 495, col: 11, offset: 14631},
+												pos:  position{line: 548, col: 11, offset: 16140},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 495, col: 14, offset: 14634},
+												pos:   position{line: 548, col: 14, offset: 16143},
 												label: "comp",
 												expr: &ruleRefExpr{
-													pos:  position{line: 495, col: 19, offset: 14639},
+													pos:  position{line: 548, col: 19, offset: 16148},
 													name: "EqualityComparator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 495, col: 38, offset: 14658},
+												pos:  position{line: 548, col: 38, offset: 16167},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 495, col: 41, offset: 14661},
+												pos:   position{line: 548, col: 41, offset: 16170},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 495, col: 46, offset: 14666},
+													pos:  position{line: 548, col: 46, offset: 16175},
 													name: "RelativeExpression",
 												},
 											},
@@ -3444,30 +3830,30 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityOperator",
-			pos:  position{line: 499, col: 1, offset: 14790},
+			pos:  position{line: 552, col: 1, offset: 16299},
 			expr: &actionExpr{
-				pos: position{line: 499, col: 20, offset: 14809},
+				pos: position{line: 552, col: 20, offset: 16318},
 				run: (*parser).callonEqualityOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 499, col: 21, offset: 14810},
+					pos: position{line: 552, col: 21, offset: 16319},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 499, col: 21, offset: 14810},
+							pos:        position{line: 552, col: 21, offset: 16319},
 							val:        "=~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 499, col: 28, offset: 14817},
+							pos:        position{line: 552, col: 28, offset: 16326},
 							val:        "!~",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 499, col: 35, offset: 14824},
+							pos:        position{line: 552, col: 35, offset: 16333},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 499, col: 41, offset: 14830},
+							pos:        position{line: 552, col: 41, offset: 16339},
 							val:        "!=",
 							ignoreCase: false,
 						},
@@ -3477,19 +3863,19 @@ var g = &grammar{
 		},
 		{
 			name: "EqualityComparator",
-			pos:  position{line: 501, col: 1, offset: 14868},
+			pos:  position{line: 554, col: 1, offset: 16377},
 			expr: &choiceExpr{
-				pos: position{line: 502, col: 5, offset: 14891},
+				pos: position{line: 555, col: 5, offset: 16400},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 502, col: 5, offset: 14891},
+						pos:  position{line: 555, col: 5, offset: 16400},
 						name: "EqualityOperator",
 					},
 					&actionExpr{
-						pos: position{line: 503, col: 5, offset: 14913},
+						pos: position{line: 556, col: 5, offset: 16421},
 						run: (*parser).callonEqualityComparator3,
 						expr: &litMatcher{
-							pos:        position{line: 503, col: 5, offset: 14913},
+							pos:        position{line: 556, col: 5, offset: 16421},
 							val:        "in",
 							ignoreCase: false,
 						},
@@ -3499,53 +3885,53 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeExpression",
-			pos:  position{line: 505, col: 1, offset: 14950},
+			pos:  position{line: 558, col: 1, offset: 16458},
 			expr: &actionExpr{
-				pos: position{line: 506, col: 5, offset: 14973},
+				pos: position{line: 559, col: 5, offset: 16481},
 				run: (*parser).callonRelativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 506, col: 5, offset: 14973},
+					pos: position{line: 559, col: 5, offset: 16481},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 506, col: 5, offset: 14973},
+							pos:   position{line: 559, col: 5, offset: 16481},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 506, col: 11, offset: 14979},
+								pos:  position{line: 559, col: 11, offset: 16487},
 								name: "AdditiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 507, col: 5, offset: 15002},
+							pos:   position{line: 560, col: 5, offset: 16510},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 507, col: 10, offset: 15007},
+								pos: position{line: 560, col: 10, offset: 16515},
 								expr: &actionExpr{
-									pos: position{line: 507, col: 11, offset: 15008},
+									pos: position{line: 560, col: 11, offset: 16516},
 									run: (*parser).callonRelativeExpression7,
 									expr: &seqExpr{
-										pos: position{line: 507, col: 11, offset: 15008},
+										pos: position{line: 560, col: 11, offset: 16516},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 507, col: 11, offset: 15008},
+												pos:  position{line: 560, col: 11, offset: 16516},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 507, col: 14, offset: 15011},
+												pos:   position{line: 560, col: 14, offset: 16519},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 507, col: 17, offset: 15014},
+													pos:  position{line: 560, col: 17, offset: 16522},
 													name: "RelativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 507, col: 34, offset: 15031},
+												pos:  position{line: 560, col: 34, offset: 16539},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 507, col: 37, offset: 15034},
+												pos:   position{line: 560, col: 37, offset: 16542},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 507, col: 42, offset: 15039},
+													pos:  position{line: 560, col: 42, offset: 16547},
 													name: "AdditiveExpression",
 												},
 											},
@@ -3560,30 +3946,30 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeOperator",
-			pos:  position{line: 511, col: 1, offset: 15161},
+			pos:  position{line: 564, col: 1, offset: 16669},
 			expr: &actionExpr{
-				pos: position{line: 511, col: 20, offset: 15180},
+				pos: position{line: 564, col: 20, offset: 16688},
 				run: (*parser).callonRelativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 511, col: 21, offset: 15181},
+					pos: position{line: 564, col: 21, offset: 16689},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 511, col: 21, offset: 15181},
+							pos:        position{line: 564, col: 21, offset: 16689},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 511, col: 28, offset: 15188},
+							pos:        position{line: 564, col: 28, offset: 16696},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 511, col: 34, offset: 15194},
+							pos:        position{line: 564, col: 34, offset: 16702},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 511, col: 41, offset: 15201},
+							pos:        position{line: 564, col: 41, offset: 16709},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -3593,53 +3979,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpression",
-			pos:  position{line: 513, col: 1, offset: 15238},
+			pos:  position{line: 566, col: 1, offset: 16746},
 			expr: &actionExpr{
-				pos: position{line: 514, col: 5, offset: 15261},
+				pos: position{line: 567, col: 5, offset: 16769},
 				run: (*parser).callonAdditiveExpression1,
 				expr: &seqExpr{
-					pos: position{line: 514, col: 5, offset: 15261},
+					pos: position{line: 567, col: 5, offset: 16769},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 514, col: 5, offset: 15261},
+							pos:   position{line: 567, col: 5, offset: 16769},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 514, col: 11, offset: 15267},
+								pos:  position{line: 567, col: 11, offset: 16775},
 								name: "MultiplicativeExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 515, col: 5, offset: 15296},
+							pos:   position{line: 568, col: 5, offset: 16804},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 515, col: 10, offset: 15301},
+								pos: position{line: 568, col: 10, offset: 16809},
 								expr: &actionExpr{
-									pos: position{line: 515, col: 11, offset: 15302},
+									pos: position{line: 568, col: 11, offset: 16810},
 									run: (*parser).callonAdditiveExpression7,
 									expr: &seqExpr{
-										pos: position{line: 515, col: 11, offset: 15302},
+										pos: position{line: 568, col: 11, offset: 16810},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 515, col: 11, offset: 15302},
+												pos:  position{line: 568, col: 11, offset: 16810},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 515, col: 14, offset: 15305},
+												pos:   position{line: 568, col: 14, offset: 16813},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 515, col: 17, offset: 15308},
+													pos:  position{line: 568, col: 17, offset: 16816},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 515, col: 34, offset: 15325},
+												pos:  position{line: 568, col: 34, offset: 16833},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 515, col: 37, offset: 15328},
+												pos:   position{line: 568, col: 37, offset: 16836},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 515, col: 42, offset: 15333},
+													pos:  position{line: 568, col: 42, offset: 16841},
 													name: "MultiplicativeExpression",
 												},
 											},
@@ -3654,20 +4040,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 519, col: 1, offset: 15461},
+			pos:  position{line: 572, col: 1, offset: 16969},
 			expr: &actionExpr{
-				pos: position{line: 519, col: 20, offset: 15480},
+				pos: position{line: 572, col: 20, offset: 16988},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 519, col: 21, offset: 15481},
+					pos: position{line: 572, col: 21, offset: 16989},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 519, col: 21, offset: 15481},
+							pos:        position{line: 572, col: 21, offset: 16989},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 519, col: 27, offset: 15487},
+							pos:        position{line: 572, col: 27, offset: 16995},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -3677,53 +4063,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpression",
-			pos:  position{line: 521, col: 1, offset: 15524},
+			pos:  position{line: 574, col: 1, offset: 17032},
 			expr: &actionExpr{
-				pos: position{line: 522, col: 5, offset: 15553},
+				pos: position{line: 575, col: 5, offset: 17061},
 				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 522, col: 5, offset: 15553},
+					pos: position{line: 575, col: 5, offset: 17061},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 522, col: 5, offset: 15553},
+							pos:   position{line: 575, col: 5, offset: 17061},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 522, col: 11, offset: 15559},
+								pos:  position{line: 575, col: 11, offset: 17067},
 								name: "NotExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 523, col: 5, offset: 15577},
+							pos:   position{line: 576, col: 5, offset: 17085},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 523, col: 10, offset: 15582},
+								pos: position{line: 576, col: 10, offset: 17090},
 								expr: &actionExpr{
-									pos: position{line: 523, col: 11, offset: 15583},
+									pos: position{line: 576, col: 11, offset: 17091},
 									run: (*parser).callonMultiplicativeExpression7,
 									expr: &seqExpr{
-										pos: position{line: 523, col: 11, offset: 15583},
+										pos: position{line: 576, col: 11, offset: 17091},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 523, col: 11, offset: 15583},
+												pos:  position{line: 576, col: 11, offset: 17091},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 523, col: 14, offset: 15586},
+												pos:   position{line: 576, col: 14, offset: 17094},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 523, col: 17, offset: 15589},
+													pos:  position{line: 576, col: 17, offset: 17097},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 523, col: 40, offset: 15612},
+												pos:  position{line: 576, col: 40, offset: 17120},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 523, col: 43, offset: 15615},
+												pos:   position{line: 576, col: 43, offset: 17123},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 523, col: 48, offset: 15620},
+													pos:  position{line: 576, col: 48, offset: 17128},
 													name: "NotExpression",
 												},
 											},
@@ -3738,20 +4124,20 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 527, col: 1, offset: 15737},
+			pos:  position{line: 580, col: 1, offset: 17245},
 			expr: &actionExpr{
-				pos: position{line: 527, col: 26, offset: 15762},
+				pos: position{line: 580, col: 26, offset: 17270},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 527, col: 27, offset: 15763},
+					pos: position{line: 580, col: 27, offset: 17271},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 527, col: 27, offset: 15763},
+							pos:        position{line: 580, col: 27, offset: 17271},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 527, col: 33, offset: 15769},
+							pos:        position{line: 580, col: 33, offset: 17277},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -3761,30 +4147,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpression",
-			pos:  position{line: 529, col: 1, offset: 15806},
+			pos:  position{line: 582, col: 1, offset: 17314},
 			expr: &choiceExpr{
-				pos: position{line: 530, col: 5, offset: 15824},
+				pos: position{line: 583, col: 5, offset: 17332},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 530, col: 5, offset: 15824},
+						pos: position{line: 583, col: 5, offset: 17332},
 						run: (*parser).callonNotExpression2,
 						expr: &seqExpr{
-							pos: position{line: 530, col: 5, offset: 15824},
+							pos: position{line: 583, col: 5, offset: 17332},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 530, col: 5, offset: 15824},
+									pos:        position{line: 583, col: 5, offset: 17332},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 530, col: 9, offset: 15828},
+									pos:  position{line: 583, col: 9, offset: 17336},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 530, col: 12, offset: 15831},
+									pos:   position{line: 583, col: 12, offset: 17339},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 530, col: 14, offset: 15833},
+										pos:  position{line: 583, col: 14, offset: 17341},
 										name: "NotExpression",
 									},
 								},
@@ -3792,7 +4178,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 533, col: 5, offset: 15952},
+						pos:  position{line: 586, col: 5, offset: 17460},
 						name: "CastExpression",
 					},
 				},
@@ -3800,50 +4186,50 @@ var g = &grammar{
 		},
 		{
 			name: "CastExpression",
-			pos:  position{line: 535, col: 1, offset: 15968},
+			pos:  position{line: 588, col: 1, offset: 17476},
 			expr: &actionExpr{
-				pos: position{line: 536, col: 5, offset: 15987},
+				pos: position{line: 589, col: 5, offset: 17495},
 				run: (*parser).callonCastExpression1,
 				expr: &seqExpr{
-					pos: position{line: 536, col: 5, offset: 15987},
+					pos: position{line: 589, col: 5, offset: 17495},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 536, col: 5, offset: 15987},
+							pos:   position{line: 589, col: 5, offset: 17495},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 536, col: 7, offset: 15989},
+								pos:  position{line: 589, col: 7, offset: 17497},
 								name: "CallExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 536, col: 22, offset: 16004},
+							pos:   position{line: 589, col: 22, offset: 17512},
 							label: "t",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 536, col: 24, offset: 16006},
+								pos: position{line: 589, col: 24, offset: 17514},
 								expr: &actionExpr{
-									pos: position{line: 536, col: 25, offset: 16007},
+									pos: position{line: 589, col: 25, offset: 17515},
 									run: (*parser).callonCastExpression7,
 									expr: &seqExpr{
-										pos: position{line: 536, col: 25, offset: 16007},
+										pos: position{line: 589, col: 25, offset: 17515},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 536, col: 25, offset: 16007},
+												pos:  position{line: 589, col: 25, offset: 17515},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 536, col: 28, offset: 16010},
+												pos:        position{line: 589, col: 28, offset: 17518},
 												val:        ":",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 536, col: 32, offset: 16014},
+												pos:  position{line: 589, col: 32, offset: 17522},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 536, col: 35, offset: 16017},
+												pos:   position{line: 589, col: 35, offset: 17525},
 												label: "ct",
 												expr: &ruleRefExpr{
-													pos:  position{line: 536, col: 38, offset: 16020},
+													pos:  position{line: 589, col: 38, offset: 17528},
 													name: "ZngType",
 												},
 											},
@@ -3858,85 +4244,95 @@ var g = &grammar{
 		},
 		{
 			name: "ZngType",
-			pos:  position{line: 544, col: 1, offset: 16194},
+			pos:  position{line: 597, col: 1, offset: 17702},
 			expr: &actionExpr{
-				pos: position{line: 545, col: 4, offset: 16205},
+				pos: position{line: 598, col: 4, offset: 17713},
 				run: (*parser).callonZngType1,
 				expr: &choiceExpr{
-					pos: position{line: 545, col: 5, offset: 16206},
+					pos: position{line: 598, col: 5, offset: 17714},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 545, col: 5, offset: 16206},
+							pos:        position{line: 598, col: 5, offset: 17714},
 							val:        "bool",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 545, col: 14, offset: 16215},
+							pos:        position{line: 598, col: 14, offset: 17723},
+							val:        "bytes",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 598, col: 24, offset: 17733},
 							val:        "byte",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 545, col: 23, offset: 16224},
+							pos:        position{line: 598, col: 33, offset: 17742},
 							val:        "int16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 545, col: 33, offset: 16234},
+							pos:        position{line: 598, col: 43, offset: 17752},
 							val:        "uint16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 545, col: 44, offset: 16245},
+							pos:        position{line: 598, col: 54, offset: 17763},
 							val:        "int32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 545, col: 54, offset: 16255},
+							pos:        position{line: 598, col: 64, offset: 17773},
 							val:        "uint32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 4, offset: 16267},
+							pos:        position{line: 599, col: 4, offset: 17785},
 							val:        "int64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 14, offset: 16277},
+							pos:        position{line: 599, col: 14, offset: 17795},
 							val:        "uint64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 25, offset: 16288},
+							pos:        position{line: 599, col: 25, offset: 17806},
 							val:        "float64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 37, offset: 16300},
+							pos:        position{line: 599, col: 37, offset: 17818},
 							val:        "string",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 48, offset: 16311},
+							pos:        position{line: 599, col: 48, offset: 17829},
 							val:        "bstring",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 4, offset: 16324},
+							pos:        position{line: 599, col: 60, offset: 17841},
+							val:        "enum",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 600, col: 4, offset: 17851},
 							val:        "ip",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 11, offset: 16331},
+							pos:        position{line: 600, col: 11, offset: 17858},
 							val:        "net",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 19, offset: 16339},
+							pos:        position{line: 600, col: 19, offset: 17866},
 							val:        "time",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 28, offset: 16348},
+							pos:        position{line: 600, col: 28, offset: 17875},
 							val:        "duration",
 							ignoreCase: false,
 						},
@@ -3946,43 +4342,43 @@ var g = &grammar{
 		},
 		{
 			name: "CallExpression",
-			pos:  position{line: 549, col: 1, offset: 16392},
+			pos:  position{line: 602, col: 1, offset: 17919},
 			expr: &choiceExpr{
-				pos: position{line: 550, col: 5, offset: 16411},
+				pos: position{line: 603, col: 5, offset: 17938},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 550, col: 5, offset: 16411},
+						pos: position{line: 603, col: 5, offset: 17938},
 						run: (*parser).callonCallExpression2,
 						expr: &seqExpr{
-							pos: position{line: 550, col: 5, offset: 16411},
+							pos: position{line: 603, col: 5, offset: 17938},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 550, col: 5, offset: 16411},
+									pos:   position{line: 603, col: 5, offset: 17938},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 550, col: 8, offset: 16414},
+										pos:  position{line: 603, col: 8, offset: 17941},
 										name: "FunctionName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 550, col: 21, offset: 16427},
+									pos:  position{line: 603, col: 21, offset: 17954},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 550, col: 24, offset: 16430},
+									pos:        position{line: 603, col: 24, offset: 17957},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 550, col: 28, offset: 16434},
+									pos:   position{line: 603, col: 28, offset: 17961},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 550, col: 33, offset: 16439},
+										pos:  position{line: 603, col: 33, offset: 17966},
 										name: "ArgumentList",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 550, col: 46, offset: 16452},
+									pos:        position{line: 603, col: 46, offset: 17979},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3990,7 +4386,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 553, col: 5, offset: 16563},
+						pos:  position{line: 606, col: 5, offset: 18090},
 						name: "DereferenceExpression",
 					},
 				},
@@ -3998,21 +4394,21 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionName",
-			pos:  position{line: 555, col: 1, offset: 16586},
+			pos:  position{line: 608, col: 1, offset: 18113},
 			expr: &actionExpr{
-				pos: position{line: 556, col: 5, offset: 16603},
+				pos: position{line: 609, col: 5, offset: 18130},
 				run: (*parser).callonFunctionName1,
 				expr: &seqExpr{
-					pos: position{line: 556, col: 5, offset: 16603},
+					pos: position{line: 609, col: 5, offset: 18130},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 556, col: 5, offset: 16603},
+							pos:  position{line: 609, col: 5, offset: 18130},
 							name: "FunctionNameStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 556, col: 23, offset: 16621},
+							pos: position{line: 609, col: 23, offset: 18148},
 							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 23, offset: 16621},
+								pos:  position{line: 609, col: 23, offset: 18148},
 								name: "FunctionNameRest",
 							},
 						},
@@ -4022,9 +4418,9 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameStart",
-			pos:  position{line: 558, col: 1, offset: 16671},
+			pos:  position{line: 611, col: 1, offset: 18198},
 			expr: &charClassMatcher{
-				pos:        position{line: 558, col: 21, offset: 16691},
+				pos:        position{line: 611, col: 21, offset: 18218},
 				val:        "[A-Za-z]",
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
 				ignoreCase: false,
@@ -4033,18 +4429,18 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionNameRest",
-			pos:  position{line: 559, col: 1, offset: 16700},
+			pos:  position{line: 612, col: 1, offset: 18227},
 			expr: &choiceExpr{
-				pos: position{line: 559, col: 20, offset: 16719},
+				pos: position{line: 612, col: 20, offset: 18246},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 559, col: 20, offset: 16719},
+						pos:  position{line: 612, col: 20, offset: 18246},
 						name: "FunctionNameStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 559, col: 40, offset: 16739},
-						val:        "[.0-9]",
-						chars:      []rune{'.'},
+						pos:        position{line: 612, col: 40, offset: 18266},
+						val:        "[._0-9]",
+						chars:      []rune{'.', '_'},
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
@@ -4054,53 +4450,53 @@ var g = &grammar{
 		},
 		{
 			name: "ArgumentList",
-			pos:  position{line: 561, col: 1, offset: 16747},
+			pos:  position{line: 614, col: 1, offset: 18275},
 			expr: &choiceExpr{
-				pos: position{line: 562, col: 5, offset: 16764},
+				pos: position{line: 615, col: 5, offset: 18292},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 562, col: 5, offset: 16764},
+						pos: position{line: 615, col: 5, offset: 18292},
 						run: (*parser).callonArgumentList2,
 						expr: &seqExpr{
-							pos: position{line: 562, col: 5, offset: 16764},
+							pos: position{line: 615, col: 5, offset: 18292},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 562, col: 5, offset: 16764},
+									pos:   position{line: 615, col: 5, offset: 18292},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 562, col: 11, offset: 16770},
+										pos:  position{line: 615, col: 11, offset: 18298},
 										name: "Expression",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 562, col: 22, offset: 16781},
+									pos:   position{line: 615, col: 22, offset: 18309},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 562, col: 27, offset: 16786},
+										pos: position{line: 615, col: 27, offset: 18314},
 										expr: &actionExpr{
-											pos: position{line: 562, col: 28, offset: 16787},
+											pos: position{line: 615, col: 28, offset: 18315},
 											run: (*parser).callonArgumentList8,
 											expr: &seqExpr{
-												pos: position{line: 562, col: 28, offset: 16787},
+												pos: position{line: 615, col: 28, offset: 18315},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 562, col: 28, offset: 16787},
+														pos:  position{line: 615, col: 28, offset: 18315},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 562, col: 31, offset: 16790},
+														pos:        position{line: 615, col: 31, offset: 18318},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 562, col: 35, offset: 16794},
+														pos:  position{line: 615, col: 35, offset: 18322},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 562, col: 38, offset: 16797},
+														pos:   position{line: 615, col: 38, offset: 18325},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 562, col: 40, offset: 16799},
+															pos:  position{line: 615, col: 40, offset: 18327},
 															name: "Expression",
 														},
 													},
@@ -4113,10 +4509,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 565, col: 5, offset: 16915},
+						pos: position{line: 618, col: 5, offset: 18442},
 						run: (*parser).callonArgumentList15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 565, col: 5, offset: 16915},
+							pos:  position{line: 618, col: 5, offset: 18442},
 							name: "__",
 						},
 					},
@@ -4125,62 +4521,62 @@ var g = &grammar{
 		},
 		{
 			name: "DereferenceExpression",
-			pos:  position{line: 567, col: 1, offset: 16951},
+			pos:  position{line: 620, col: 1, offset: 18478},
 			expr: &actionExpr{
-				pos: position{line: 568, col: 5, offset: 16977},
+				pos: position{line: 621, col: 5, offset: 18504},
 				run: (*parser).callonDereferenceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 568, col: 5, offset: 16977},
+					pos: position{line: 621, col: 5, offset: 18504},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 568, col: 5, offset: 16977},
+							pos:   position{line: 621, col: 5, offset: 18504},
 							label: "base",
 							expr: &ruleRefExpr{
-								pos:  position{line: 568, col: 10, offset: 16982},
+								pos:  position{line: 621, col: 10, offset: 18509},
 								name: "PrimaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 569, col: 5, offset: 17004},
+							pos:   position{line: 622, col: 5, offset: 18531},
 							label: "derefs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 569, col: 12, offset: 17011},
+								pos: position{line: 622, col: 12, offset: 18538},
 								expr: &choiceExpr{
-									pos: position{line: 570, col: 9, offset: 17021},
+									pos: position{line: 623, col: 9, offset: 18548},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 570, col: 9, offset: 17021},
+											pos: position{line: 623, col: 9, offset: 18548},
 											run: (*parser).callonDereferenceExpression8,
 											expr: &seqExpr{
-												pos: position{line: 570, col: 9, offset: 17021},
+												pos: position{line: 623, col: 9, offset: 18548},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 570, col: 9, offset: 17021},
+														pos:  position{line: 623, col: 9, offset: 18548},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 570, col: 12, offset: 17024},
+														pos:        position{line: 623, col: 12, offset: 18551},
 														val:        "[",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 570, col: 16, offset: 17028},
+														pos:  position{line: 623, col: 16, offset: 18555},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 570, col: 19, offset: 17031},
+														pos:   position{line: 623, col: 19, offset: 18558},
 														label: "index",
 														expr: &ruleRefExpr{
-															pos:  position{line: 570, col: 25, offset: 17037},
+															pos:  position{line: 623, col: 25, offset: 18564},
 															name: "Expression",
 														},
 													},
 													&ruleRefExpr{
-														pos:  position{line: 570, col: 36, offset: 17048},
+														pos:  position{line: 623, col: 36, offset: 18575},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 570, col: 39, offset: 17051},
+														pos:        position{line: 623, col: 39, offset: 18578},
 														val:        "]",
 														ignoreCase: false,
 													},
@@ -4188,29 +4584,29 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 573, col: 9, offset: 17123},
+											pos: position{line: 626, col: 9, offset: 18650},
 											run: (*parser).callonDereferenceExpression17,
 											expr: &seqExpr{
-												pos: position{line: 573, col: 9, offset: 17123},
+												pos: position{line: 626, col: 9, offset: 18650},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 573, col: 9, offset: 17123},
+														pos:  position{line: 626, col: 9, offset: 18650},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 573, col: 12, offset: 17126},
+														pos:        position{line: 626, col: 12, offset: 18653},
 														val:        ".",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 573, col: 16, offset: 17130},
+														pos:  position{line: 626, col: 16, offset: 18657},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 573, col: 19, offset: 17133},
+														pos:   position{line: 626, col: 19, offset: 18660},
 														label: "field",
 														expr: &ruleRefExpr{
-															pos:  position{line: 573, col: 25, offset: 17139},
+															pos:  position{line: 626, col: 25, offset: 18666},
 															name: "fieldName",
 														},
 													},
@@ -4227,54 +4623,54 @@ var g = &grammar{
 		},
 		{
 			name: "duration",
-			pos:  position{line: 580, col: 1, offset: 17347},
+			pos:  position{line: 633, col: 1, offset: 18874},
 			expr: &choiceExpr{
-				pos: position{line: 581, col: 5, offset: 17360},
+				pos: position{line: 634, col: 5, offset: 18887},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 581, col: 5, offset: 17360},
+						pos:  position{line: 634, col: 5, offset: 18887},
 						name: "seconds",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 582, col: 5, offset: 17372},
+						pos:  position{line: 635, col: 5, offset: 18899},
 						name: "minutes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 583, col: 5, offset: 17384},
+						pos:  position{line: 636, col: 5, offset: 18911},
 						name: "hours",
 					},
 					&seqExpr{
-						pos: position{line: 584, col: 5, offset: 17394},
+						pos: position{line: 637, col: 5, offset: 18921},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 584, col: 5, offset: 17394},
+								pos:  position{line: 637, col: 5, offset: 18921},
 								name: "hours",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 584, col: 11, offset: 17400},
+								pos:  position{line: 637, col: 11, offset: 18927},
 								name: "_",
 							},
 							&litMatcher{
-								pos:        position{line: 584, col: 13, offset: 17402},
+								pos:        position{line: 637, col: 13, offset: 18929},
 								val:        "and",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 584, col: 19, offset: 17408},
+								pos:  position{line: 637, col: 19, offset: 18935},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 584, col: 21, offset: 17410},
+								pos:  position{line: 637, col: 21, offset: 18937},
 								name: "minutes",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 585, col: 5, offset: 17422},
+						pos:  position{line: 638, col: 5, offset: 18949},
 						name: "days",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 586, col: 5, offset: 17431},
+						pos:  position{line: 639, col: 5, offset: 18958},
 						name: "weeks",
 					},
 				},
@@ -4282,32 +4678,32 @@ var g = &grammar{
 		},
 		{
 			name: "sec_abbrev",
-			pos:  position{line: 588, col: 1, offset: 17438},
+			pos:  position{line: 641, col: 1, offset: 18965},
 			expr: &choiceExpr{
-				pos: position{line: 589, col: 5, offset: 17453},
+				pos: position{line: 642, col: 5, offset: 18980},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 589, col: 5, offset: 17453},
+						pos:        position{line: 642, col: 5, offset: 18980},
 						val:        "seconds",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 590, col: 5, offset: 17467},
+						pos:        position{line: 643, col: 5, offset: 18994},
 						val:        "second",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 591, col: 5, offset: 17480},
+						pos:        position{line: 644, col: 5, offset: 19007},
 						val:        "secs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 592, col: 5, offset: 17491},
+						pos:        position{line: 645, col: 5, offset: 19018},
 						val:        "sec",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 593, col: 5, offset: 17501},
+						pos:        position{line: 646, col: 5, offset: 19028},
 						val:        "s",
 						ignoreCase: false,
 					},
@@ -4316,32 +4712,32 @@ var g = &grammar{
 		},
 		{
 			name: "min_abbrev",
-			pos:  position{line: 595, col: 1, offset: 17506},
+			pos:  position{line: 648, col: 1, offset: 19033},
 			expr: &choiceExpr{
-				pos: position{line: 596, col: 5, offset: 17521},
+				pos: position{line: 649, col: 5, offset: 19048},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 596, col: 5, offset: 17521},
+						pos:        position{line: 649, col: 5, offset: 19048},
 						val:        "minutes",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 597, col: 5, offset: 17535},
+						pos:        position{line: 650, col: 5, offset: 19062},
 						val:        "minute",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 598, col: 5, offset: 17548},
+						pos:        position{line: 651, col: 5, offset: 19075},
 						val:        "mins",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 599, col: 5, offset: 17559},
+						pos:        position{line: 652, col: 5, offset: 19086},
 						val:        "min",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 600, col: 5, offset: 17569},
+						pos:        position{line: 653, col: 5, offset: 19096},
 						val:        "m",
 						ignoreCase: false,
 					},
@@ -4350,32 +4746,32 @@ var g = &grammar{
 		},
 		{
 			name: "hour_abbrev",
-			pos:  position{line: 602, col: 1, offset: 17574},
+			pos:  position{line: 655, col: 1, offset: 19101},
 			expr: &choiceExpr{
-				pos: position{line: 603, col: 5, offset: 17590},
+				pos: position{line: 656, col: 5, offset: 19117},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 603, col: 5, offset: 17590},
+						pos:        position{line: 656, col: 5, offset: 19117},
 						val:        "hours",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 604, col: 5, offset: 17602},
+						pos:        position{line: 657, col: 5, offset: 19129},
 						val:        "hrs",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 605, col: 5, offset: 17612},
+						pos:        position{line: 658, col: 5, offset: 19139},
 						val:        "hr",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 606, col: 5, offset: 17621},
+						pos:        position{line: 659, col: 5, offset: 19148},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 607, col: 5, offset: 17629},
+						pos:        position{line: 660, col: 5, offset: 19156},
 						val:        "hour",
 						ignoreCase: false,
 					},
@@ -4384,22 +4780,22 @@ var g = &grammar{
 		},
 		{
 			name: "day_abbrev",
-			pos:  position{line: 609, col: 1, offset: 17637},
+			pos:  position{line: 662, col: 1, offset: 19164},
 			expr: &choiceExpr{
-				pos: position{line: 609, col: 14, offset: 17650},
+				pos: position{line: 662, col: 14, offset: 19177},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 609, col: 14, offset: 17650},
+						pos:        position{line: 662, col: 14, offset: 19177},
 						val:        "days",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 609, col: 21, offset: 17657},
+						pos:        position{line: 662, col: 21, offset: 19184},
 						val:        "day",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 609, col: 27, offset: 17663},
+						pos:        position{line: 662, col: 27, offset: 19190},
 						val:        "d",
 						ignoreCase: false,
 					},
@@ -4408,32 +4804,32 @@ var g = &grammar{
 		},
 		{
 			name: "week_abbrev",
-			pos:  position{line: 610, col: 1, offset: 17667},
+			pos:  position{line: 663, col: 1, offset: 19194},
 			expr: &choiceExpr{
-				pos: position{line: 610, col: 15, offset: 17681},
+				pos: position{line: 663, col: 15, offset: 19208},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 610, col: 15, offset: 17681},
+						pos:        position{line: 663, col: 15, offset: 19208},
 						val:        "weeks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 610, col: 23, offset: 17689},
+						pos:        position{line: 663, col: 23, offset: 19216},
 						val:        "week",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 610, col: 30, offset: 17696},
+						pos:        position{line: 663, col: 30, offset: 19223},
 						val:        "wks",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 610, col: 36, offset: 17702},
+						pos:        position{line: 663, col: 36, offset: 19229},
 						val:        "wk",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 610, col: 41, offset: 17707},
+						pos:        position{line: 663, col: 41, offset: 19234},
 						val:        "w",
 						ignoreCase: false,
 					},
@@ -4442,42 +4838,42 @@ var g = &grammar{
 		},
 		{
 			name: "seconds",
-			pos:  position{line: 612, col: 1, offset: 17712},
+			pos:  position{line: 665, col: 1, offset: 19239},
 			expr: &choiceExpr{
-				pos: position{line: 613, col: 5, offset: 17724},
+				pos: position{line: 666, col: 5, offset: 19251},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 613, col: 5, offset: 17724},
+						pos: position{line: 666, col: 5, offset: 19251},
 						run: (*parser).callonseconds2,
 						expr: &litMatcher{
-							pos:        position{line: 613, col: 5, offset: 17724},
+							pos:        position{line: 666, col: 5, offset: 19251},
 							val:        "second",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 614, col: 5, offset: 17810},
+						pos: position{line: 667, col: 5, offset: 19337},
 						run: (*parser).callonseconds4,
 						expr: &seqExpr{
-							pos: position{line: 614, col: 5, offset: 17810},
+							pos: position{line: 667, col: 5, offset: 19337},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 614, col: 5, offset: 17810},
+									pos:   position{line: 667, col: 5, offset: 19337},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 614, col: 9, offset: 17814},
+										pos:  position{line: 667, col: 9, offset: 19341},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 614, col: 16, offset: 17821},
+									pos: position{line: 667, col: 16, offset: 19348},
 									expr: &ruleRefExpr{
-										pos:  position{line: 614, col: 16, offset: 17821},
+										pos:  position{line: 667, col: 16, offset: 19348},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 614, col: 19, offset: 17824},
+									pos:  position{line: 667, col: 19, offset: 19351},
 									name: "sec_abbrev",
 								},
 							},
@@ -4488,42 +4884,42 @@ var g = &grammar{
 		},
 		{
 			name: "minutes",
-			pos:  position{line: 616, col: 1, offset: 17911},
+			pos:  position{line: 669, col: 1, offset: 19438},
 			expr: &choiceExpr{
-				pos: position{line: 617, col: 5, offset: 17923},
+				pos: position{line: 670, col: 5, offset: 19450},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 617, col: 5, offset: 17923},
+						pos: position{line: 670, col: 5, offset: 19450},
 						run: (*parser).callonminutes2,
 						expr: &litMatcher{
-							pos:        position{line: 617, col: 5, offset: 17923},
+							pos:        position{line: 670, col: 5, offset: 19450},
 							val:        "minute",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 618, col: 5, offset: 18010},
+						pos: position{line: 671, col: 5, offset: 19537},
 						run: (*parser).callonminutes4,
 						expr: &seqExpr{
-							pos: position{line: 618, col: 5, offset: 18010},
+							pos: position{line: 671, col: 5, offset: 19537},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 618, col: 5, offset: 18010},
+									pos:   position{line: 671, col: 5, offset: 19537},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 618, col: 9, offset: 18014},
+										pos:  position{line: 671, col: 9, offset: 19541},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 618, col: 16, offset: 18021},
+									pos: position{line: 671, col: 16, offset: 19548},
 									expr: &ruleRefExpr{
-										pos:  position{line: 618, col: 16, offset: 18021},
+										pos:  position{line: 671, col: 16, offset: 19548},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 618, col: 19, offset: 18024},
+									pos:  position{line: 671, col: 19, offset: 19551},
 									name: "min_abbrev",
 								},
 							},
@@ -4534,42 +4930,42 @@ var g = &grammar{
 		},
 		{
 			name: "hours",
-			pos:  position{line: 620, col: 1, offset: 18120},
+			pos:  position{line: 673, col: 1, offset: 19647},
 			expr: &choiceExpr{
-				pos: position{line: 621, col: 5, offset: 18130},
+				pos: position{line: 674, col: 5, offset: 19657},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 621, col: 5, offset: 18130},
+						pos: position{line: 674, col: 5, offset: 19657},
 						run: (*parser).callonhours2,
 						expr: &litMatcher{
-							pos:        position{line: 621, col: 5, offset: 18130},
+							pos:        position{line: 674, col: 5, offset: 19657},
 							val:        "hour",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 622, col: 5, offset: 18217},
+						pos: position{line: 675, col: 5, offset: 19744},
 						run: (*parser).callonhours4,
 						expr: &seqExpr{
-							pos: position{line: 622, col: 5, offset: 18217},
+							pos: position{line: 675, col: 5, offset: 19744},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 622, col: 5, offset: 18217},
+									pos:   position{line: 675, col: 5, offset: 19744},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 622, col: 9, offset: 18221},
+										pos:  position{line: 675, col: 9, offset: 19748},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 622, col: 16, offset: 18228},
+									pos: position{line: 675, col: 16, offset: 19755},
 									expr: &ruleRefExpr{
-										pos:  position{line: 622, col: 16, offset: 18228},
+										pos:  position{line: 675, col: 16, offset: 19755},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 622, col: 19, offset: 18231},
+									pos:  position{line: 675, col: 19, offset: 19758},
 									name: "hour_abbrev",
 								},
 							},
@@ -4580,42 +4976,42 @@ var g = &grammar{
 		},
 		{
 			name: "days",
-			pos:  position{line: 624, col: 1, offset: 18330},
+			pos:  position{line: 677, col: 1, offset: 19857},
 			expr: &choiceExpr{
-				pos: position{line: 625, col: 5, offset: 18339},
+				pos: position{line: 678, col: 5, offset: 19866},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 625, col: 5, offset: 18339},
+						pos: position{line: 678, col: 5, offset: 19866},
 						run: (*parser).callondays2,
 						expr: &litMatcher{
-							pos:        position{line: 625, col: 5, offset: 18339},
+							pos:        position{line: 678, col: 5, offset: 19866},
 							val:        "day",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 626, col: 5, offset: 18428},
+						pos: position{line: 679, col: 5, offset: 19955},
 						run: (*parser).callondays4,
 						expr: &seqExpr{
-							pos: position{line: 626, col: 5, offset: 18428},
+							pos: position{line: 679, col: 5, offset: 19955},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 626, col: 5, offset: 18428},
+									pos:   position{line: 679, col: 5, offset: 19955},
 									label: "num",
 									expr: &ruleRefExpr{
-										pos:  position{line: 626, col: 9, offset: 18432},
+										pos:  position{line: 679, col: 9, offset: 19959},
 										name: "number",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 626, col: 16, offset: 18439},
+									pos: position{line: 679, col: 16, offset: 19966},
 									expr: &ruleRefExpr{
-										pos:  position{line: 626, col: 16, offset: 18439},
+										pos:  position{line: 679, col: 16, offset: 19966},
 										name: "_",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 626, col: 19, offset: 18442},
+									pos:  position{line: 679, col: 19, offset: 19969},
 									name: "day_abbrev",
 								},
 							},
@@ -4626,30 +5022,30 @@ var g = &grammar{
 		},
 		{
 			name: "weeks",
-			pos:  position{line: 628, col: 1, offset: 18545},
+			pos:  position{line: 681, col: 1, offset: 20072},
 			expr: &actionExpr{
-				pos: position{line: 629, col: 5, offset: 18555},
+				pos: position{line: 682, col: 5, offset: 20082},
 				run: (*parser).callonweeks1,
 				expr: &seqExpr{
-					pos: position{line: 629, col: 5, offset: 18555},
+					pos: position{line: 682, col: 5, offset: 20082},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 629, col: 5, offset: 18555},
+							pos:   position{line: 682, col: 5, offset: 20082},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 629, col: 9, offset: 18559},
+								pos:  position{line: 682, col: 9, offset: 20086},
 								name: "number",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 629, col: 16, offset: 18566},
+							pos: position{line: 682, col: 16, offset: 20093},
 							expr: &ruleRefExpr{
-								pos:  position{line: 629, col: 16, offset: 18566},
+								pos:  position{line: 682, col: 16, offset: 20093},
 								name: "_",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 629, col: 19, offset: 18569},
+							pos:  position{line: 682, col: 19, offset: 20096},
 							name: "week_abbrev",
 						},
 					},
@@ -4658,53 +5054,53 @@ var g = &grammar{
 		},
 		{
 			name: "number",
-			pos:  position{line: 631, col: 1, offset: 18673},
+			pos:  position{line: 684, col: 1, offset: 20200},
 			expr: &ruleRefExpr{
-				pos:  position{line: 631, col: 10, offset: 18682},
+				pos:  position{line: 684, col: 10, offset: 20209},
 				name: "unsignedInteger",
 			},
 		},
 		{
 			name: "addr",
-			pos:  position{line: 635, col: 1, offset: 18728},
+			pos:  position{line: 688, col: 1, offset: 20228},
 			expr: &actionExpr{
-				pos: position{line: 636, col: 5, offset: 18737},
+				pos: position{line: 689, col: 5, offset: 20237},
 				run: (*parser).callonaddr1,
 				expr: &labeledExpr{
-					pos:   position{line: 636, col: 5, offset: 18737},
+					pos:   position{line: 689, col: 5, offset: 20237},
 					label: "a",
 					expr: &seqExpr{
-						pos: position{line: 636, col: 8, offset: 18740},
+						pos: position{line: 689, col: 8, offset: 20240},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 636, col: 8, offset: 18740},
+								pos:  position{line: 689, col: 8, offset: 20240},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 636, col: 24, offset: 18756},
+								pos:        position{line: 689, col: 24, offset: 20256},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 636, col: 28, offset: 18760},
+								pos:  position{line: 689, col: 28, offset: 20260},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 636, col: 44, offset: 18776},
+								pos:        position{line: 689, col: 44, offset: 20276},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 636, col: 48, offset: 18780},
+								pos:  position{line: 689, col: 48, offset: 20280},
 								name: "unsignedInteger",
 							},
 							&litMatcher{
-								pos:        position{line: 636, col: 64, offset: 18796},
+								pos:        position{line: 689, col: 64, offset: 20296},
 								val:        ".",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 636, col: 68, offset: 18800},
+								pos:  position{line: 689, col: 68, offset: 20300},
 								name: "unsignedInteger",
 							},
 						},
@@ -4714,23 +5110,23 @@ var g = &grammar{
 		},
 		{
 			name: "port",
-			pos:  position{line: 638, col: 1, offset: 18849},
+			pos:  position{line: 691, col: 1, offset: 20349},
 			expr: &actionExpr{
-				pos: position{line: 639, col: 5, offset: 18858},
+				pos: position{line: 692, col: 5, offset: 20358},
 				run: (*parser).callonport1,
 				expr: &seqExpr{
-					pos: position{line: 639, col: 5, offset: 18858},
+					pos: position{line: 692, col: 5, offset: 20358},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 639, col: 5, offset: 18858},
+							pos:        position{line: 692, col: 5, offset: 20358},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 639, col: 9, offset: 18862},
+							pos:   position{line: 692, col: 9, offset: 20362},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 639, col: 11, offset: 18864},
+								pos:  position{line: 692, col: 11, offset: 20364},
 								name: "suint",
 							},
 						},
@@ -4740,32 +5136,32 @@ var g = &grammar{
 		},
 		{
 			name: "ip6addr",
-			pos:  position{line: 643, col: 1, offset: 19020},
+			pos:  position{line: 696, col: 1, offset: 20391},
 			expr: &choiceExpr{
-				pos: position{line: 644, col: 5, offset: 19032},
+				pos: position{line: 697, col: 5, offset: 20403},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 644, col: 5, offset: 19032},
+						pos: position{line: 697, col: 5, offset: 20403},
 						run: (*parser).callonip6addr2,
 						expr: &seqExpr{
-							pos: position{line: 644, col: 5, offset: 19032},
+							pos: position{line: 697, col: 5, offset: 20403},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 644, col: 5, offset: 19032},
+									pos:   position{line: 697, col: 5, offset: 20403},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 644, col: 7, offset: 19034},
+										pos: position{line: 697, col: 7, offset: 20405},
 										expr: &ruleRefExpr{
-											pos:  position{line: 644, col: 8, offset: 19035},
+											pos:  position{line: 697, col: 8, offset: 20406},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 644, col: 20, offset: 19047},
+									pos:   position{line: 697, col: 20, offset: 20418},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 644, col: 22, offset: 19049},
+										pos:  position{line: 697, col: 22, offset: 20420},
 										name: "ip6tail",
 									},
 								},
@@ -4773,51 +5169,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 647, col: 5, offset: 19113},
+						pos: position{line: 700, col: 5, offset: 20484},
 						run: (*parser).callonip6addr9,
 						expr: &seqExpr{
-							pos: position{line: 647, col: 5, offset: 19113},
+							pos: position{line: 700, col: 5, offset: 20484},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 647, col: 5, offset: 19113},
+									pos:   position{line: 700, col: 5, offset: 20484},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 647, col: 7, offset: 19115},
+										pos:  position{line: 700, col: 7, offset: 20486},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 647, col: 11, offset: 19119},
+									pos:   position{line: 700, col: 11, offset: 20490},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 647, col: 13, offset: 19121},
+										pos: position{line: 700, col: 13, offset: 20492},
 										expr: &ruleRefExpr{
-											pos:  position{line: 647, col: 14, offset: 19122},
+											pos:  position{line: 700, col: 14, offset: 20493},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 647, col: 25, offset: 19133},
+									pos:        position{line: 700, col: 25, offset: 20504},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 647, col: 30, offset: 19138},
+									pos:   position{line: 700, col: 30, offset: 20509},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 647, col: 32, offset: 19140},
+										pos: position{line: 700, col: 32, offset: 20511},
 										expr: &ruleRefExpr{
-											pos:  position{line: 647, col: 33, offset: 19141},
+											pos:  position{line: 700, col: 33, offset: 20512},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 647, col: 45, offset: 19153},
+									pos:   position{line: 700, col: 45, offset: 20524},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 647, col: 47, offset: 19155},
+										pos:  position{line: 700, col: 47, offset: 20526},
 										name: "ip6tail",
 									},
 								},
@@ -4825,32 +5221,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 650, col: 5, offset: 19254},
+						pos: position{line: 703, col: 5, offset: 20625},
 						run: (*parser).callonip6addr22,
 						expr: &seqExpr{
-							pos: position{line: 650, col: 5, offset: 19254},
+							pos: position{line: 703, col: 5, offset: 20625},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 650, col: 5, offset: 19254},
+									pos:        position{line: 703, col: 5, offset: 20625},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 650, col: 10, offset: 19259},
+									pos:   position{line: 703, col: 10, offset: 20630},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 650, col: 12, offset: 19261},
+										pos: position{line: 703, col: 12, offset: 20632},
 										expr: &ruleRefExpr{
-											pos:  position{line: 650, col: 13, offset: 19262},
+											pos:  position{line: 703, col: 13, offset: 20633},
 											name: "h_prepend",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 650, col: 25, offset: 19274},
+									pos:   position{line: 703, col: 25, offset: 20645},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 650, col: 27, offset: 19276},
+										pos:  position{line: 703, col: 27, offset: 20647},
 										name: "ip6tail",
 									},
 								},
@@ -4858,32 +5254,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 653, col: 5, offset: 19347},
+						pos: position{line: 706, col: 5, offset: 20718},
 						run: (*parser).callonip6addr30,
 						expr: &seqExpr{
-							pos: position{line: 653, col: 5, offset: 19347},
+							pos: position{line: 706, col: 5, offset: 20718},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 653, col: 5, offset: 19347},
+									pos:   position{line: 706, col: 5, offset: 20718},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 653, col: 7, offset: 19349},
+										pos:  position{line: 706, col: 7, offset: 20720},
 										name: "h16",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 653, col: 11, offset: 19353},
+									pos:   position{line: 706, col: 11, offset: 20724},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 653, col: 13, offset: 19355},
+										pos: position{line: 706, col: 13, offset: 20726},
 										expr: &ruleRefExpr{
-											pos:  position{line: 653, col: 14, offset: 19356},
+											pos:  position{line: 706, col: 14, offset: 20727},
 											name: "h_append",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 653, col: 25, offset: 19367},
+									pos:        position{line: 706, col: 25, offset: 20738},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -4891,10 +5287,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 656, col: 5, offset: 19435},
+						pos: position{line: 709, col: 5, offset: 20806},
 						run: (*parser).callonip6addr38,
 						expr: &litMatcher{
-							pos:        position{line: 656, col: 5, offset: 19435},
+							pos:        position{line: 709, col: 5, offset: 20806},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -4904,16 +5300,16 @@ var g = &grammar{
 		},
 		{
 			name: "ip6tail",
-			pos:  position{line: 660, col: 1, offset: 19472},
+			pos:  position{line: 713, col: 1, offset: 20843},
 			expr: &choiceExpr{
-				pos: position{line: 661, col: 5, offset: 19484},
+				pos: position{line: 714, col: 5, offset: 20855},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 661, col: 5, offset: 19484},
+						pos:  position{line: 714, col: 5, offset: 20855},
 						name: "addr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 662, col: 5, offset: 19493},
+						pos:  position{line: 715, col: 5, offset: 20864},
 						name: "h16",
 					},
 				},
@@ -4921,23 +5317,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_append",
-			pos:  position{line: 664, col: 1, offset: 19498},
+			pos:  position{line: 717, col: 1, offset: 20869},
 			expr: &actionExpr{
-				pos: position{line: 664, col: 12, offset: 19509},
+				pos: position{line: 717, col: 12, offset: 20880},
 				run: (*parser).callonh_append1,
 				expr: &seqExpr{
-					pos: position{line: 664, col: 12, offset: 19509},
+					pos: position{line: 717, col: 12, offset: 20880},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 664, col: 12, offset: 19509},
+							pos:        position{line: 717, col: 12, offset: 20880},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 664, col: 16, offset: 19513},
+							pos:   position{line: 717, col: 16, offset: 20884},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 664, col: 18, offset: 19515},
+								pos:  position{line: 717, col: 18, offset: 20886},
 								name: "h16",
 							},
 						},
@@ -4947,23 +5343,23 @@ var g = &grammar{
 		},
 		{
 			name: "h_prepend",
-			pos:  position{line: 665, col: 1, offset: 19552},
+			pos:  position{line: 718, col: 1, offset: 20923},
 			expr: &actionExpr{
-				pos: position{line: 665, col: 13, offset: 19564},
+				pos: position{line: 718, col: 13, offset: 20935},
 				run: (*parser).callonh_prepend1,
 				expr: &seqExpr{
-					pos: position{line: 665, col: 13, offset: 19564},
+					pos: position{line: 718, col: 13, offset: 20935},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 665, col: 13, offset: 19564},
+							pos:   position{line: 718, col: 13, offset: 20935},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 15, offset: 19566},
+								pos:  position{line: 718, col: 15, offset: 20937},
 								name: "h16",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 19, offset: 19570},
+							pos:        position{line: 718, col: 19, offset: 20941},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -4973,31 +5369,31 @@ var g = &grammar{
 		},
 		{
 			name: "subnet",
-			pos:  position{line: 667, col: 1, offset: 19608},
+			pos:  position{line: 720, col: 1, offset: 20979},
 			expr: &actionExpr{
-				pos: position{line: 668, col: 5, offset: 19619},
+				pos: position{line: 721, col: 5, offset: 20990},
 				run: (*parser).callonsubnet1,
 				expr: &seqExpr{
-					pos: position{line: 668, col: 5, offset: 19619},
+					pos: position{line: 721, col: 5, offset: 20990},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 668, col: 5, offset: 19619},
+							pos:   position{line: 721, col: 5, offset: 20990},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 668, col: 7, offset: 19621},
+								pos:  position{line: 721, col: 7, offset: 20992},
 								name: "addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 668, col: 12, offset: 19626},
+							pos:        position{line: 721, col: 12, offset: 20997},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 668, col: 16, offset: 19630},
+							pos:   position{line: 721, col: 16, offset: 21001},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 668, col: 18, offset: 19632},
+								pos:  position{line: 721, col: 18, offset: 21003},
 								name: "unsignedInteger",
 							},
 						},
@@ -5007,31 +5403,31 @@ var g = &grammar{
 		},
 		{
 			name: "ip6subnet",
-			pos:  position{line: 672, col: 1, offset: 19716},
+			pos:  position{line: 725, col: 1, offset: 21087},
 			expr: &actionExpr{
-				pos: position{line: 673, col: 5, offset: 19730},
+				pos: position{line: 726, col: 5, offset: 21101},
 				run: (*parser).callonip6subnet1,
 				expr: &seqExpr{
-					pos: position{line: 673, col: 5, offset: 19730},
+					pos: position{line: 726, col: 5, offset: 21101},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 673, col: 5, offset: 19730},
+							pos:   position{line: 726, col: 5, offset: 21101},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 673, col: 7, offset: 19732},
+								pos:  position{line: 726, col: 7, offset: 21103},
 								name: "ip6addr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 673, col: 15, offset: 19740},
+							pos:        position{line: 726, col: 15, offset: 21111},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 673, col: 19, offset: 19744},
+							pos:   position{line: 726, col: 19, offset: 21115},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 673, col: 21, offset: 19746},
+								pos:  position{line: 726, col: 21, offset: 21117},
 								name: "unsignedInteger",
 							},
 						},
@@ -5041,15 +5437,15 @@ var g = &grammar{
 		},
 		{
 			name: "unsignedInteger",
-			pos:  position{line: 677, col: 1, offset: 19820},
+			pos:  position{line: 730, col: 1, offset: 21191},
 			expr: &actionExpr{
-				pos: position{line: 678, col: 5, offset: 19840},
+				pos: position{line: 731, col: 5, offset: 21211},
 				run: (*parser).callonunsignedInteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 678, col: 5, offset: 19840},
+					pos:   position{line: 731, col: 5, offset: 21211},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 678, col: 7, offset: 19842},
+						pos:  position{line: 731, col: 7, offset: 21213},
 						name: "suint",
 					},
 				},
@@ -5057,14 +5453,14 @@ var g = &grammar{
 		},
 		{
 			name: "suint",
-			pos:  position{line: 680, col: 1, offset: 19877},
+			pos:  position{line: 733, col: 1, offset: 21248},
 			expr: &actionExpr{
-				pos: position{line: 681, col: 5, offset: 19887},
+				pos: position{line: 734, col: 5, offset: 21258},
 				run: (*parser).callonsuint1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 681, col: 5, offset: 19887},
+					pos: position{line: 734, col: 5, offset: 21258},
 					expr: &charClassMatcher{
-						pos:        position{line: 681, col: 5, offset: 19887},
+						pos:        position{line: 734, col: 5, offset: 21258},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -5075,15 +5471,15 @@ var g = &grammar{
 		},
 		{
 			name: "integer",
-			pos:  position{line: 683, col: 1, offset: 19926},
+			pos:  position{line: 736, col: 1, offset: 21297},
 			expr: &actionExpr{
-				pos: position{line: 684, col: 5, offset: 19938},
+				pos: position{line: 737, col: 5, offset: 21309},
 				run: (*parser).calloninteger1,
 				expr: &labeledExpr{
-					pos:   position{line: 684, col: 5, offset: 19938},
+					pos:   position{line: 737, col: 5, offset: 21309},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 684, col: 7, offset: 19940},
+						pos:  position{line: 737, col: 7, offset: 21311},
 						name: "sinteger",
 					},
 				},
@@ -5091,17 +5487,17 @@ var g = &grammar{
 		},
 		{
 			name: "sinteger",
-			pos:  position{line: 686, col: 1, offset: 19978},
+			pos:  position{line: 739, col: 1, offset: 21349},
 			expr: &actionExpr{
-				pos: position{line: 687, col: 5, offset: 19991},
+				pos: position{line: 740, col: 5, offset: 21362},
 				run: (*parser).callonsinteger1,
 				expr: &seqExpr{
-					pos: position{line: 687, col: 5, offset: 19991},
+					pos: position{line: 740, col: 5, offset: 21362},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 687, col: 5, offset: 19991},
+							pos: position{line: 740, col: 5, offset: 21362},
 							expr: &charClassMatcher{
-								pos:        position{line: 687, col: 5, offset: 19991},
+								pos:        position{line: 740, col: 5, offset: 21362},
 								val:        "[+-]",
 								chars:      []rune{'+', '-'},
 								ignoreCase: false,
@@ -5109,7 +5505,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 687, col: 11, offset: 19997},
+							pos:  position{line: 740, col: 11, offset: 21368},
 							name: "suint",
 						},
 					},
@@ -5118,15 +5514,15 @@ var g = &grammar{
 		},
 		{
 			name: "double",
-			pos:  position{line: 689, col: 1, offset: 20035},
+			pos:  position{line: 742, col: 1, offset: 21406},
 			expr: &actionExpr{
-				pos: position{line: 690, col: 5, offset: 20046},
+				pos: position{line: 743, col: 5, offset: 21417},
 				run: (*parser).callondouble1,
 				expr: &labeledExpr{
-					pos:   position{line: 690, col: 5, offset: 20046},
+					pos:   position{line: 743, col: 5, offset: 21417},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 690, col: 7, offset: 20048},
+						pos:  position{line: 743, col: 7, offset: 21419},
 						name: "sdouble",
 					},
 				},
@@ -5134,47 +5530,47 @@ var g = &grammar{
 		},
 		{
 			name: "sdouble",
-			pos:  position{line: 694, col: 1, offset: 20095},
+			pos:  position{line: 747, col: 1, offset: 21466},
 			expr: &choiceExpr{
-				pos: position{line: 695, col: 5, offset: 20107},
+				pos: position{line: 748, col: 5, offset: 21478},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 695, col: 5, offset: 20107},
+						pos: position{line: 748, col: 5, offset: 21478},
 						run: (*parser).callonsdouble2,
 						expr: &seqExpr{
-							pos: position{line: 695, col: 5, offset: 20107},
+							pos: position{line: 748, col: 5, offset: 21478},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 695, col: 5, offset: 20107},
+									pos: position{line: 748, col: 5, offset: 21478},
 									expr: &litMatcher{
-										pos:        position{line: 695, col: 5, offset: 20107},
+										pos:        position{line: 748, col: 5, offset: 21478},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 695, col: 10, offset: 20112},
+									pos: position{line: 748, col: 10, offset: 21483},
 									expr: &ruleRefExpr{
-										pos:  position{line: 695, col: 10, offset: 20112},
+										pos:  position{line: 748, col: 10, offset: 21483},
 										name: "doubleInteger",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 695, col: 25, offset: 20127},
+									pos:        position{line: 748, col: 25, offset: 21498},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 695, col: 29, offset: 20131},
+									pos: position{line: 748, col: 29, offset: 21502},
 									expr: &ruleRefExpr{
-										pos:  position{line: 695, col: 29, offset: 20131},
+										pos:  position{line: 748, col: 29, offset: 21502},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 695, col: 42, offset: 20144},
+									pos: position{line: 748, col: 42, offset: 21515},
 									expr: &ruleRefExpr{
-										pos:  position{line: 695, col: 42, offset: 20144},
+										pos:  position{line: 748, col: 42, offset: 21515},
 										name: "exponentPart",
 									},
 								},
@@ -5182,35 +5578,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 698, col: 5, offset: 20203},
+						pos: position{line: 751, col: 5, offset: 21574},
 						run: (*parser).callonsdouble13,
 						expr: &seqExpr{
-							pos: position{line: 698, col: 5, offset: 20203},
+							pos: position{line: 751, col: 5, offset: 21574},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 698, col: 5, offset: 20203},
+									pos: position{line: 751, col: 5, offset: 21574},
 									expr: &litMatcher{
-										pos:        position{line: 698, col: 5, offset: 20203},
+										pos:        position{line: 751, col: 5, offset: 21574},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 698, col: 10, offset: 20208},
+									pos:        position{line: 751, col: 10, offset: 21579},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 698, col: 14, offset: 20212},
+									pos: position{line: 751, col: 14, offset: 21583},
 									expr: &ruleRefExpr{
-										pos:  position{line: 698, col: 14, offset: 20212},
+										pos:  position{line: 751, col: 14, offset: 21583},
 										name: "doubleDigit",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 698, col: 27, offset: 20225},
+									pos: position{line: 751, col: 27, offset: 21596},
 									expr: &ruleRefExpr{
-										pos:  position{line: 698, col: 27, offset: 20225},
+										pos:  position{line: 751, col: 27, offset: 21596},
 										name: "exponentPart",
 									},
 								},
@@ -5222,29 +5618,29 @@ var g = &grammar{
 		},
 		{
 			name: "doubleInteger",
-			pos:  position{line: 702, col: 1, offset: 20281},
+			pos:  position{line: 755, col: 1, offset: 21652},
 			expr: &choiceExpr{
-				pos: position{line: 703, col: 5, offset: 20299},
+				pos: position{line: 756, col: 5, offset: 21670},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 703, col: 5, offset: 20299},
+						pos:        position{line: 756, col: 5, offset: 21670},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 704, col: 5, offset: 20307},
+						pos: position{line: 757, col: 5, offset: 21678},
 						exprs: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 704, col: 5, offset: 20307},
+								pos:        position{line: 757, col: 5, offset: 21678},
 								val:        "[1-9]",
 								ranges:     []rune{'1', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 704, col: 11, offset: 20313},
+								pos: position{line: 757, col: 11, offset: 21684},
 								expr: &charClassMatcher{
-									pos:        position{line: 704, col: 11, offset: 20313},
+									pos:        position{line: 757, col: 11, offset: 21684},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
@@ -5258,9 +5654,9 @@ var g = &grammar{
 		},
 		{
 			name: "doubleDigit",
-			pos:  position{line: 706, col: 1, offset: 20321},
+			pos:  position{line: 759, col: 1, offset: 21692},
 			expr: &charClassMatcher{
-				pos:        position{line: 706, col: 15, offset: 20335},
+				pos:        position{line: 759, col: 15, offset: 21706},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -5269,17 +5665,17 @@ var g = &grammar{
 		},
 		{
 			name: "exponentPart",
-			pos:  position{line: 708, col: 1, offset: 20342},
+			pos:  position{line: 761, col: 1, offset: 21713},
 			expr: &seqExpr{
-				pos: position{line: 708, col: 16, offset: 20357},
+				pos: position{line: 761, col: 16, offset: 21728},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 708, col: 16, offset: 20357},
+						pos:        position{line: 761, col: 16, offset: 21728},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 708, col: 21, offset: 20362},
+						pos:  position{line: 761, col: 21, offset: 21733},
 						name: "sinteger",
 					},
 				},
@@ -5287,17 +5683,17 @@ var g = &grammar{
 		},
 		{
 			name: "h16",
-			pos:  position{line: 710, col: 1, offset: 20372},
+			pos:  position{line: 763, col: 1, offset: 21743},
 			expr: &actionExpr{
-				pos: position{line: 710, col: 7, offset: 20378},
+				pos: position{line: 763, col: 7, offset: 21749},
 				run: (*parser).callonh161,
 				expr: &labeledExpr{
-					pos:   position{line: 710, col: 7, offset: 20378},
+					pos:   position{line: 763, col: 7, offset: 21749},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 710, col: 13, offset: 20384},
+						pos: position{line: 763, col: 13, offset: 21755},
 						expr: &ruleRefExpr{
-							pos:  position{line: 710, col: 13, offset: 20384},
+							pos:  position{line: 763, col: 13, offset: 21755},
 							name: "hexdigit",
 						},
 					},
@@ -5306,9 +5702,9 @@ var g = &grammar{
 		},
 		{
 			name: "hexdigit",
-			pos:  position{line: 712, col: 1, offset: 20426},
+			pos:  position{line: 765, col: 1, offset: 21797},
 			expr: &charClassMatcher{
-				pos:        position{line: 712, col: 12, offset: 20437},
+				pos:        position{line: 765, col: 12, offset: 21808},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -5317,17 +5713,17 @@ var g = &grammar{
 		},
 		{
 			name: "searchWord",
-			pos:  position{line: 714, col: 1, offset: 20450},
+			pos:  position{line: 767, col: 1, offset: 21821},
 			expr: &actionExpr{
-				pos: position{line: 715, col: 5, offset: 20465},
+				pos: position{line: 768, col: 5, offset: 21836},
 				run: (*parser).callonsearchWord1,
 				expr: &labeledExpr{
-					pos:   position{line: 715, col: 5, offset: 20465},
+					pos:   position{line: 768, col: 5, offset: 21836},
 					label: "chars",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 715, col: 11, offset: 20471},
+						pos: position{line: 768, col: 11, offset: 21842},
 						expr: &ruleRefExpr{
-							pos:  position{line: 715, col: 11, offset: 20471},
+							pos:  position{line: 768, col: 11, offset: 21842},
 							name: "searchWordPart",
 						},
 					},
@@ -5336,33 +5732,33 @@ var g = &grammar{
 		},
 		{
 			name: "searchWordPart",
-			pos:  position{line: 717, col: 1, offset: 20521},
+			pos:  position{line: 770, col: 1, offset: 21892},
 			expr: &choiceExpr{
-				pos: position{line: 718, col: 5, offset: 20540},
+				pos: position{line: 771, col: 5, offset: 21911},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 718, col: 5, offset: 20540},
+						pos: position{line: 771, col: 5, offset: 21911},
 						run: (*parser).callonsearchWordPart2,
 						expr: &seqExpr{
-							pos: position{line: 718, col: 5, offset: 20540},
+							pos: position{line: 771, col: 5, offset: 21911},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 718, col: 5, offset: 20540},
+									pos:        position{line: 771, col: 5, offset: 21911},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 718, col: 10, offset: 20545},
+									pos:   position{line: 771, col: 10, offset: 21916},
 									label: "s",
 									expr: &choiceExpr{
-										pos: position{line: 718, col: 13, offset: 20548},
+										pos: position{line: 771, col: 13, offset: 21919},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 718, col: 13, offset: 20548},
+												pos:  position{line: 771, col: 13, offset: 21919},
 												name: "escapeSequence",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 718, col: 30, offset: 20565},
+												pos:  position{line: 771, col: 30, offset: 21936},
 												name: "searchEscape",
 											},
 										},
@@ -5372,18 +5768,18 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 719, col: 5, offset: 20602},
+						pos: position{line: 772, col: 5, offset: 21972},
 						run: (*parser).callonsearchWordPart9,
 						expr: &seqExpr{
-							pos: position{line: 719, col: 5, offset: 20602},
+							pos: position{line: 772, col: 5, offset: 21972},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 719, col: 5, offset: 20602},
+									pos: position{line: 772, col: 5, offset: 21972},
 									expr: &choiceExpr{
-										pos: position{line: 719, col: 7, offset: 20604},
+										pos: position{line: 772, col: 7, offset: 21974},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 719, col: 7, offset: 20604},
+												pos:        position{line: 772, col: 7, offset: 21974},
 												val:        "[\\x00-\\x1F\\x5C(),!><=\\x22|\\x27;]",
 												chars:      []rune{'\\', '(', ')', ',', '!', '>', '<', '=', '"', '|', '\'', ';'},
 												ranges:     []rune{'\x00', '\x1f'},
@@ -5391,14 +5787,14 @@ var g = &grammar{
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 719, col: 42, offset: 20639},
+												pos:  position{line: 772, col: 42, offset: 22009},
 												name: "ws",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 719, col: 46, offset: 20643,
+									line: 772, col: 46, offset: 22013,
 								},
 							},
 						},
@@ -5408,34 +5804,34 @@ var g = &grammar{
 		},
 		{
 			name: "quotedString",
-			pos:  position{line: 721, col: 1, offset: 20677},
+			pos:  position{line: 774, col: 1, offset: 22047},
 			expr: &choiceExpr{
-				pos: position{line: 722, col: 5, offset: 20694},
+				pos: position{line: 775, col: 5, offset: 22064},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 722, col: 5, offset: 20694},
+						pos: position{line: 775, col: 5, offset: 22064},
 						run: (*parser).callonquotedString2,
 						expr: &seqExpr{
-							pos: position{line: 722, col: 5, offset: 20694},
+							pos: position{line: 775, col: 5, offset: 22064},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 722, col: 5, offset: 20694},
+									pos:        position{line: 775, col: 5, offset: 22064},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 722, col: 9, offset: 20698},
+									pos:   position{line: 775, col: 9, offset: 22068},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 722, col: 11, offset: 20700},
+										pos: position{line: 775, col: 11, offset: 22070},
 										expr: &ruleRefExpr{
-											pos:  position{line: 722, col: 11, offset: 20700},
+											pos:  position{line: 775, col: 11, offset: 22070},
 											name: "doubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 722, col: 29, offset: 20718},
+									pos:        position{line: 775, col: 29, offset: 22088},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -5443,29 +5839,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 723, col: 5, offset: 20755},
+						pos: position{line: 776, col: 5, offset: 22125},
 						run: (*parser).callonquotedString9,
 						expr: &seqExpr{
-							pos: position{line: 723, col: 5, offset: 20755},
+							pos: position{line: 776, col: 5, offset: 22125},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 723, col: 5, offset: 20755},
+									pos:        position{line: 776, col: 5, offset: 22125},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 723, col: 9, offset: 20759},
+									pos:   position{line: 776, col: 9, offset: 22129},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 723, col: 11, offset: 20761},
+										pos: position{line: 776, col: 11, offset: 22131},
 										expr: &ruleRefExpr{
-											pos:  position{line: 723, col: 11, offset: 20761},
+											pos:  position{line: 776, col: 11, offset: 22131},
 											name: "singleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 723, col: 29, offset: 20779},
+									pos:        position{line: 776, col: 29, offset: 22149},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -5477,55 +5873,55 @@ var g = &grammar{
 		},
 		{
 			name: "doubleQuotedChar",
-			pos:  position{line: 725, col: 1, offset: 20813},
+			pos:  position{line: 778, col: 1, offset: 22183},
 			expr: &choiceExpr{
-				pos: position{line: 726, col: 5, offset: 20834},
+				pos: position{line: 779, col: 5, offset: 22204},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 726, col: 5, offset: 20834},
+						pos: position{line: 779, col: 5, offset: 22204},
 						run: (*parser).callondoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 726, col: 5, offset: 20834},
+							pos: position{line: 779, col: 5, offset: 22204},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 726, col: 5, offset: 20834},
+									pos: position{line: 779, col: 5, offset: 22204},
 									expr: &choiceExpr{
-										pos: position{line: 726, col: 7, offset: 20836},
+										pos: position{line: 779, col: 7, offset: 22206},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 726, col: 7, offset: 20836},
+												pos:        position{line: 779, col: 7, offset: 22206},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 726, col: 13, offset: 20842},
+												pos:  position{line: 779, col: 13, offset: 22212},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 726, col: 26, offset: 20855,
+									line: 779, col: 26, offset: 22225,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 727, col: 5, offset: 20892},
+						pos: position{line: 780, col: 5, offset: 22262},
 						run: (*parser).callondoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 727, col: 5, offset: 20892},
+							pos: position{line: 780, col: 5, offset: 22262},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 727, col: 5, offset: 20892},
+									pos:        position{line: 780, col: 5, offset: 22262},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 727, col: 10, offset: 20897},
+									pos:   position{line: 780, col: 10, offset: 22267},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 727, col: 12, offset: 20899},
+										pos:  position{line: 780, col: 12, offset: 22269},
 										name: "escapeSequence",
 									},
 								},
@@ -5537,55 +5933,55 @@ var g = &grammar{
 		},
 		{
 			name: "singleQuotedChar",
-			pos:  position{line: 729, col: 1, offset: 20933},
+			pos:  position{line: 782, col: 1, offset: 22303},
 			expr: &choiceExpr{
-				pos: position{line: 730, col: 5, offset: 20954},
+				pos: position{line: 783, col: 5, offset: 22324},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 730, col: 5, offset: 20954},
+						pos: position{line: 783, col: 5, offset: 22324},
 						run: (*parser).callonsingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 730, col: 5, offset: 20954},
+							pos: position{line: 783, col: 5, offset: 22324},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 730, col: 5, offset: 20954},
+									pos: position{line: 783, col: 5, offset: 22324},
 									expr: &choiceExpr{
-										pos: position{line: 730, col: 7, offset: 20956},
+										pos: position{line: 783, col: 7, offset: 22326},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 730, col: 7, offset: 20956},
+												pos:        position{line: 783, col: 7, offset: 22326},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 730, col: 13, offset: 20962},
+												pos:  position{line: 783, col: 13, offset: 22332},
 												name: "escapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 730, col: 26, offset: 20975,
+									line: 783, col: 26, offset: 22345,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 731, col: 5, offset: 21012},
+						pos: position{line: 784, col: 5, offset: 22382},
 						run: (*parser).callonsingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 731, col: 5, offset: 21012},
+							pos: position{line: 784, col: 5, offset: 22382},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 731, col: 5, offset: 21012},
+									pos:        position{line: 784, col: 5, offset: 22382},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 731, col: 10, offset: 21017},
+									pos:   position{line: 784, col: 10, offset: 22387},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 731, col: 12, offset: 21019},
+										pos:  position{line: 784, col: 12, offset: 22389},
 										name: "escapeSequence",
 									},
 								},
@@ -5597,38 +5993,38 @@ var g = &grammar{
 		},
 		{
 			name: "escapeSequence",
-			pos:  position{line: 733, col: 1, offset: 21053},
+			pos:  position{line: 786, col: 1, offset: 22423},
 			expr: &choiceExpr{
-				pos: position{line: 734, col: 5, offset: 21072},
+				pos: position{line: 787, col: 5, offset: 22442},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 734, col: 5, offset: 21072},
+						pos: position{line: 787, col: 5, offset: 22442},
 						run: (*parser).callonescapeSequence2,
 						expr: &seqExpr{
-							pos: position{line: 734, col: 5, offset: 21072},
+							pos: position{line: 787, col: 5, offset: 22442},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 734, col: 5, offset: 21072},
+									pos:        position{line: 787, col: 5, offset: 22442},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 734, col: 9, offset: 21076},
+									pos:  position{line: 787, col: 9, offset: 22446},
 									name: "hexdigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 734, col: 18, offset: 21085},
+									pos:  position{line: 787, col: 18, offset: 22455},
 									name: "hexdigit",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 735, col: 5, offset: 21136},
+						pos:  position{line: 788, col: 5, offset: 22506},
 						name: "singleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 736, col: 5, offset: 21157},
+						pos:  position{line: 789, col: 5, offset: 22527},
 						name: "unicodeEscape",
 					},
 				},
@@ -5636,75 +6032,75 @@ var g = &grammar{
 		},
 		{
 			name: "singleCharEscape",
-			pos:  position{line: 738, col: 1, offset: 21172},
+			pos:  position{line: 791, col: 1, offset: 22542},
 			expr: &choiceExpr{
-				pos: position{line: 739, col: 5, offset: 21193},
+				pos: position{line: 792, col: 5, offset: 22563},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 739, col: 5, offset: 21193},
+						pos:        position{line: 792, col: 5, offset: 22563},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 740, col: 5, offset: 21201},
+						pos:        position{line: 793, col: 5, offset: 22571},
 						val:        "\"",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 741, col: 5, offset: 21209},
+						pos:        position{line: 794, col: 5, offset: 22579},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 742, col: 5, offset: 21218},
+						pos: position{line: 795, col: 5, offset: 22588},
 						run: (*parser).callonsingleCharEscape5,
 						expr: &litMatcher{
-							pos:        position{line: 742, col: 5, offset: 21218},
+							pos:        position{line: 795, col: 5, offset: 22588},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 743, col: 5, offset: 21247},
+						pos: position{line: 796, col: 5, offset: 22617},
 						run: (*parser).callonsingleCharEscape7,
 						expr: &litMatcher{
-							pos:        position{line: 743, col: 5, offset: 21247},
+							pos:        position{line: 796, col: 5, offset: 22617},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 744, col: 5, offset: 21276},
+						pos: position{line: 797, col: 5, offset: 22646},
 						run: (*parser).callonsingleCharEscape9,
 						expr: &litMatcher{
-							pos:        position{line: 744, col: 5, offset: 21276},
+							pos:        position{line: 797, col: 5, offset: 22646},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 745, col: 5, offset: 21305},
+						pos: position{line: 798, col: 5, offset: 22675},
 						run: (*parser).callonsingleCharEscape11,
 						expr: &litMatcher{
-							pos:        position{line: 745, col: 5, offset: 21305},
+							pos:        position{line: 798, col: 5, offset: 22675},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 746, col: 5, offset: 21334},
+						pos: position{line: 799, col: 5, offset: 22704},
 						run: (*parser).callonsingleCharEscape13,
 						expr: &litMatcher{
-							pos:        position{line: 746, col: 5, offset: 21334},
+							pos:        position{line: 799, col: 5, offset: 22704},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 747, col: 5, offset: 21363},
+						pos: position{line: 800, col: 5, offset: 22733},
 						run: (*parser).callonsingleCharEscape15,
 						expr: &litMatcher{
-							pos:        position{line: 747, col: 5, offset: 21363},
+							pos:        position{line: 800, col: 5, offset: 22733},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -5714,24 +6110,24 @@ var g = &grammar{
 		},
 		{
 			name: "searchEscape",
-			pos:  position{line: 749, col: 1, offset: 21389},
+			pos:  position{line: 802, col: 1, offset: 22759},
 			expr: &choiceExpr{
-				pos: position{line: 750, col: 5, offset: 21406},
+				pos: position{line: 803, col: 5, offset: 22776},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 750, col: 5, offset: 21406},
+						pos: position{line: 803, col: 5, offset: 22776},
 						run: (*parser).callonsearchEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 750, col: 5, offset: 21406},
+							pos:        position{line: 803, col: 5, offset: 22776},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 751, col: 5, offset: 21434},
+						pos: position{line: 804, col: 5, offset: 22804},
 						run: (*parser).callonsearchEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 751, col: 5, offset: 21434},
+							pos:        position{line: 804, col: 5, offset: 22804},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -5741,41 +6137,41 @@ var g = &grammar{
 		},
 		{
 			name: "unicodeEscape",
-			pos:  position{line: 753, col: 1, offset: 21461},
+			pos:  position{line: 806, col: 1, offset: 22831},
 			expr: &choiceExpr{
-				pos: position{line: 754, col: 5, offset: 21479},
+				pos: position{line: 807, col: 5, offset: 22849},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 754, col: 5, offset: 21479},
+						pos: position{line: 807, col: 5, offset: 22849},
 						run: (*parser).callonunicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 754, col: 5, offset: 21479},
+							pos: position{line: 807, col: 5, offset: 22849},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 754, col: 5, offset: 21479},
+									pos:        position{line: 807, col: 5, offset: 22849},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 754, col: 9, offset: 21483},
+									pos:   position{line: 807, col: 9, offset: 22853},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 754, col: 16, offset: 21490},
+										pos: position{line: 807, col: 16, offset: 22860},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 754, col: 16, offset: 21490},
+												pos:  position{line: 807, col: 16, offset: 22860},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 754, col: 25, offset: 21499},
+												pos:  position{line: 807, col: 25, offset: 22869},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 754, col: 34, offset: 21508},
+												pos:  position{line: 807, col: 34, offset: 22878},
 												name: "hexdigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 754, col: 43, offset: 21517},
+												pos:  position{line: 807, col: 43, offset: 22887},
 												name: "hexdigit",
 											},
 										},
@@ -5785,63 +6181,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 757, col: 5, offset: 21580},
+						pos: position{line: 810, col: 5, offset: 22950},
 						run: (*parser).callonunicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 757, col: 5, offset: 21580},
+							pos: position{line: 810, col: 5, offset: 22950},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 757, col: 5, offset: 21580},
+									pos:        position{line: 810, col: 5, offset: 22950},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 757, col: 9, offset: 21584},
+									pos:        position{line: 810, col: 9, offset: 22954},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 757, col: 13, offset: 21588},
+									pos:   position{line: 810, col: 13, offset: 22958},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 757, col: 20, offset: 21595},
+										pos: position{line: 810, col: 20, offset: 22965},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 757, col: 20, offset: 21595},
+												pos:  position{line: 810, col: 20, offset: 22965},
 												name: "hexdigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 757, col: 29, offset: 21604},
+												pos: position{line: 810, col: 29, offset: 22974},
 												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 29, offset: 21604},
+													pos:  position{line: 810, col: 29, offset: 22974},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 757, col: 39, offset: 21614},
+												pos: position{line: 810, col: 39, offset: 22984},
 												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 39, offset: 21614},
+													pos:  position{line: 810, col: 39, offset: 22984},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 757, col: 49, offset: 21624},
+												pos: position{line: 810, col: 49, offset: 22994},
 												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 49, offset: 21624},
+													pos:  position{line: 810, col: 49, offset: 22994},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 757, col: 59, offset: 21634},
+												pos: position{line: 810, col: 59, offset: 23004},
 												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 59, offset: 21634},
+													pos:  position{line: 810, col: 59, offset: 23004},
 													name: "hexdigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 757, col: 69, offset: 21644},
+												pos: position{line: 810, col: 69, offset: 23014},
 												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 69, offset: 21644},
+													pos:  position{line: 810, col: 69, offset: 23014},
 													name: "hexdigit",
 												},
 											},
@@ -5849,7 +6245,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 757, col: 80, offset: 21655},
+									pos:        position{line: 810, col: 80, offset: 23025},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -5861,28 +6257,28 @@ var g = &grammar{
 		},
 		{
 			name: "reString",
-			pos:  position{line: 761, col: 1, offset: 21709},
+			pos:  position{line: 814, col: 1, offset: 23079},
 			expr: &actionExpr{
-				pos: position{line: 762, col: 5, offset: 21722},
+				pos: position{line: 815, col: 5, offset: 23092},
 				run: (*parser).callonreString1,
 				expr: &seqExpr{
-					pos: position{line: 762, col: 5, offset: 21722},
+					pos: position{line: 815, col: 5, offset: 23092},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 762, col: 5, offset: 21722},
+							pos:        position{line: 815, col: 5, offset: 23092},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 762, col: 9, offset: 21726},
+							pos:   position{line: 815, col: 9, offset: 23096},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 762, col: 11, offset: 21728},
+								pos:  position{line: 815, col: 11, offset: 23098},
 								name: "reBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 762, col: 18, offset: 21735},
+							pos:        position{line: 815, col: 18, offset: 23105},
 							val:        "/",
 							ignoreCase: false,
 						},
@@ -5892,24 +6288,24 @@ var g = &grammar{
 		},
 		{
 			name: "reBody",
-			pos:  position{line: 764, col: 1, offset: 21758},
+			pos:  position{line: 817, col: 1, offset: 23128},
 			expr: &actionExpr{
-				pos: position{line: 765, col: 5, offset: 21769},
+				pos: position{line: 818, col: 5, offset: 23139},
 				run: (*parser).callonreBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 765, col: 5, offset: 21769},
+					pos: position{line: 818, col: 5, offset: 23139},
 					expr: &choiceExpr{
-						pos: position{line: 765, col: 6, offset: 21770},
+						pos: position{line: 818, col: 6, offset: 23140},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 765, col: 6, offset: 21770},
+								pos:        position{line: 818, col: 6, offset: 23140},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&litMatcher{
-								pos:        position{line: 765, col: 13, offset: 21777},
+								pos:        position{line: 818, col: 13, offset: 23147},
 								val:        "\\/",
 								ignoreCase: false,
 							},
@@ -5920,9 +6316,9 @@ var g = &grammar{
 		},
 		{
 			name: "escapedChar",
-			pos:  position{line: 767, col: 1, offset: 21817},
+			pos:  position{line: 820, col: 1, offset: 23187},
 			expr: &charClassMatcher{
-				pos:        position{line: 768, col: 5, offset: 21833},
+				pos:        position{line: 821, col: 5, offset: 23203},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -5932,37 +6328,37 @@ var g = &grammar{
 		},
 		{
 			name: "ws",
-			pos:  position{line: 770, col: 1, offset: 21848},
+			pos:  position{line: 823, col: 1, offset: 23218},
 			expr: &choiceExpr{
-				pos: position{line: 771, col: 5, offset: 21855},
+				pos: position{line: 824, col: 5, offset: 23225},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 771, col: 5, offset: 21855},
+						pos:        position{line: 824, col: 5, offset: 23225},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 772, col: 5, offset: 21864},
+						pos:        position{line: 825, col: 5, offset: 23234},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 773, col: 5, offset: 21873},
+						pos:        position{line: 826, col: 5, offset: 23243},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 774, col: 5, offset: 21882},
+						pos:        position{line: 827, col: 5, offset: 23252},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 775, col: 5, offset: 21890},
+						pos:        position{line: 828, col: 5, offset: 23260},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 776, col: 5, offset: 21903},
+						pos:        position{line: 829, col: 5, offset: 23273},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -5972,33 +6368,33 @@ var g = &grammar{
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 778, col: 1, offset: 21913},
+			pos:         position{line: 831, col: 1, offset: 23283},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 778, col: 18, offset: 21930},
+				pos: position{line: 831, col: 18, offset: 23300},
 				expr: &ruleRefExpr{
-					pos:  position{line: 778, col: 18, offset: 21930},
+					pos:  position{line: 831, col: 18, offset: 23300},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 779, col: 1, offset: 21934},
+			pos:  position{line: 832, col: 1, offset: 23304},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 779, col: 6, offset: 21939},
+				pos: position{line: 832, col: 6, offset: 23309},
 				expr: &ruleRefExpr{
-					pos:  position{line: 779, col: 6, offset: 21939},
+					pos:  position{line: 832, col: 6, offset: 23309},
 					name: "ws",
 				},
 			},
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 781, col: 1, offset: 21944},
+			pos:  position{line: 834, col: 1, offset: 23314},
 			expr: &notExpr{
-				pos: position{line: 781, col: 7, offset: 21950},
+				pos: position{line: 834, col: 7, offset: 23320},
 				expr: &anyMatcher{
-					line: 781, col: 8, offset: 21951,
+					line: 834, col: 8, offset: 23321,
 				},
 			},
 		},
@@ -6566,6 +6962,16 @@ func (p *parser) callonfieldReference13() (interface{}, error) {
 	return p.cur.onfieldReference13(stack["index"])
 }
 
+func (c *current) onfieldReference19(key interface{}) (interface{}, error) {
+	return map[string]interface{}{"op": "FieldCall", "fn": "MapIndex", "field": nil, "param": key}, nil
+}
+
+func (p *parser) callonfieldReference19() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReference19(stack["key"])
+}
+
 func (c *current) onfieldReference1(base, ds interface{}) (interface{}, error) {
 	var ret = map[string]interface{}{"op": "FieldRead", "field": base}
 	for _, d := range ds.([]interface{}) {
@@ -6682,7 +7088,7 @@ func (p *parser) callonfieldReducerOp8() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp10() (interface{}, error) {
-	return "Var", nil
+	return "Stdev", nil
 }
 
 func (p *parser) callonfieldReducerOp10() (interface{}, error) {
@@ -6692,7 +7098,7 @@ func (p *parser) callonfieldReducerOp10() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp12() (interface{}, error) {
-	return "Entropy", nil
+	return "Var", nil
 }
 
 func (p *parser) callonfieldReducerOp12() (interface{}, error) {
@@ -6702,7 +7108,7 @@ func (p *parser) callonfieldReducerOp12() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp14() (interface{}, error) {
-	return "Min", nil
+	return "Var", nil
 }
 
 func (p *parser) callonfieldReducerOp14() (interface{}, error) {
@@ -6712,7 +7118,7 @@ func (p *parser) callonfieldReducerOp14() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp16() (interface{}, error) {
-	return "Max", nil
+	return "Entropy", nil
 }
 
 func (p *parser) callonfieldReducerOp16() (interface{}, error) {
@@ -6722,7 +7128,7 @@ func (p *parser) callonfieldReducerOp16() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp18() (interface{}, error) {
-	return "First", nil
+	return "Min", nil
 }
 
 func (p *parser) callonfieldReducerOp18() (interface{}, error) {
@@ -6732,7 +7138,7 @@ func (p *parser) callonfieldReducerOp18() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp20() (interface{}, error) {
-	return "Last", nil
+	return "Max", nil
 }
 
 func (p *parser) callonfieldReducerOp20() (interface{}, error) {
@@ -6742,7 +7148,7 @@ func (p *parser) callonfieldReducerOp20() (interface{}, error) {
 }
 
 func (c *current) onfieldReducerOp22() (interface{}, error) {
-	return "CountDistinct", nil
+	return "First", nil
 }
 
 func (p *parser) callonfieldReducerOp22() (interface{}, error) {
@@ -6751,6 +7157,76 @@ func (p *parser) callonfieldReducerOp22() (interface{}, error) {
 	return p.cur.onfieldReducerOp22()
 }
 
+func (c *current) onfieldReducerOp24() (interface{}, error) {
+	return "Last", nil
+}
+
+func (p *parser) callonfieldReducerOp24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp24()
+}
+
+func (c *current) onfieldReducerOp26() (interface{}, error) {
+	return "CountDistinct", nil
+}
+
+func (p *parser) callonfieldReducerOp26() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp26()
+}
+
+func (c *current) onfieldReducerOp28() (interface{}, error) {
+	return "Median", nil
+}
+
+func (p *parser) callonfieldReducerOp28() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp28()
+}
+
+func (c *current) onfieldReducerOp30() (interface{}, error) {
+	return "Collect", nil
+}
+
+func (p *parser) callonfieldReducerOp30() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp30()
+}
+
+func (c *current) onfieldReducerOp32() (interface{}, error) {
+	return "Union", nil
+}
+
+func (p *parser) callonfieldReducerOp32() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerOp32()
+}
+
+func (c *current) onparamReducerOp2() (interface{}, error) {
+	return "Percentile", nil
+}
+
+func (p *parser) callonparamReducerOp2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp2()
+}
+
+func (c *current) onparamReducerOp4() (interface{}, error) {
+	return "Quantile", nil
+}
+
+func (p *parser) callonparamReducerOp4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducerOp4()
+}
+
 func (c *current) onpaddedFieldExpr1(field interface{}) (interface{}, error) {
 	return field, nil
 }
@@ -6776,8 +7252,19 @@ func (p *parser) calloncountReducer1() (interface{}, error) {
 	return p.cur.oncountReducer1(stack["op"], stack["field"])
 }
 
-func (c *current) onfieldReducer1(op, field interface{}) (interface{}, error) {
-	var r = map[string]interface{}{"op": op, "var": toLowerCase(op)}
+func (c *current) onfieldReducerFunc1(op interface{}) (interface{}, error) {
+	return map[string]interface{}{"op": op, "var": toLowerCase(string(c.text))}, nil
+
+}
+
+func (p *parser) callonfieldReducerFunc1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onfieldReducerFunc1(stack["op"])
+}
+
+func (c *current) onfieldReducer1(fn, field interface{}) (interface{}, error) {
+	var r = map[string]interface{}{"op": fn.(map[string]interface{})["op"], "var": fn.(map[string]interface{})["var"]}
 	if field != nil {
 		r["field"] = field
 	}
@@ -6788,7 +7275,18 @@ func (c *current) onfieldReducer1(op, field interface{}) (interface{}, error) {
 func (p *parser) callonfieldReducer1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onfieldReducer1(stack["op"], stack["field"])
+	return p.cur.onfieldReducer1(stack["fn"], stack["field"])
+}
+
+func (c *current) onparamReducer1(op, field, param interface{}) (interface{}, error) {
+	return map[string]interface{}{"op": op, "var": toLowerCase(op), "field": field, "param": param}, nil
+
+}
+
+func (p *parser) callonparamReducer1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onparamReducer1(stack["op"], stack["field"], stack["param"])
 }
 
 func (c *current) ongroupByProc1(every, reducers, keys, limit interface{}) (interface{}, error) {
@@ -7148,6 +7646,81 @@ func (p *parser) callonrename1() (interface{}, error) {
 	return p.cur.onrename1(stack["first"], stack["rest"])
 }
 
+func (c *current) onjoin11(k interface{}) (interface{}, error) {
+	return k, nil
+}
+
+func (p *parser) callonjoin11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin11(stack["k"])
+}
+
+func (c *current) onjoin1(args, first, rest interface{}) (interface{}, error) {
+	var argm = args.(map[string]interface{})
+	var leftKeys = []interface{}{}
+	var rightKeys = []interface{}{}
+	for _, k := range append([]interface{}{first}, (rest.([]interface{}))...) {
+		leftKeys = append(leftKeys, k.(map[string]interface{})["left"])
+		rightKeys = append(rightKeys, k.(map[string]interface{})["right"])
+	}
+	var proc = map[string]interface{}{"op": "JoinProc", "kind": "inner", "left_keys": leftKeys, "right_keys": rightKeys}
+	if _, ok := argm["left"]; ok {
+		proc["kind"] = "left"
+	}
+	return proc, nil
+
+}
+
+func (p *parser) callonjoin1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoin1(stack["args"], stack["first"], stack["rest"])
+}
+
+func (c *current) onjoinArgs4() (interface{}, error) {
+	return map[string]interface{}{"name": "left", "value": nil}, nil
+}
+
+func (p *parser) callonjoinArgs4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArgs4()
+}
+
+func (c *current) onjoinArgs1(args interface{}) (interface{}, error) {
+	return makeArgMap(args)
+
+}
+
+func (p *parser) callonjoinArgs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinArgs1(stack["args"])
+}
+
+func (c *current) onjoinKey2(l, r interface{}) (interface{}, error) {
+	return map[string]interface{}{"left": l, "right": r}, nil
+
+}
+
+func (p *parser) callonjoinKey2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKey2(stack["l"], stack["r"])
+}
+
+func (c *current) onjoinKey11(k interface{}) (interface{}, error) {
+	return map[string]interface{}{"left": k, "right": k}, nil
+
+}
+
+func (p *parser) callonjoinKey11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onjoinKey11(stack["k"])
+}
+
 func (c *current) onExpressionAssignment1(f, e interface{}) (interface{}, error) {
 	return map[string]interface{}{"target": f, "expression": e}, nil
 
@@ -8085,18 +8658,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
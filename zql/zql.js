@@ -303,7 +303,8 @@ function peg$parse(input, options) {
       peg$c86 = "]",
       peg$c87 = peg$literalExpectation("]", false),
       peg$c88 = function(base, index) { return {"op": "FieldCall", "fn": "Index", "field": null, "param": index} },
-      peg$c89 = function(base, ds) {
+      peg$c89 = function(base, key) { return {"op": "FieldCall", "fn": "MapIndex", "field": null, "param": key} },
+      peg$c90 = function(base, ds) {
            let ret = {"op": "FieldRead", "field": base}
            for(let  d of ds) {
              let derefs = d 
@@ -312,13 +313,13 @@ function peg$parse(input, options) {
            }
            return ret
          },
-      peg$c90 = function(fn, field) {
+      peg$c91 = function(fn, field) {
             return {"op": "FieldCall", "fn": fn, "field": field, "param": null}
           },
-      peg$c91 = "len",
-      peg$c92 = peg$literalExpectation("len", true),
-      peg$c93 = function() { return "Len" },
-      peg$c94 = function(first, rest) {
+      peg$c92 = "len",
+      peg$c93 = peg$literalExpectation("len", true),
+      peg$c94 = function() { return "Len" },
+      peg$c95 = function(first, rest) {
             let result = [first]
 
             for(let  r of rest) {
@@ -327,58 +328,83 @@ function peg$parse(input, options) {
 
             return result
         },
-      peg$c95 = function(base, refs) { return text() },
-      peg$c96 = "count",
-      peg$c97 = peg$literalExpectation("count", true),
-      peg$c98 = function() { return "Count" },
-      peg$c99 = "sum",
-      peg$c100 = peg$literalExpectation("sum", true),
-      peg$c101 = function() { return "Sum" },
-      peg$c102 = "avg",
-      peg$c103 = peg$literalExpectation("avg", true),
-      peg$c104 = function() { return "Avg" },
-      peg$c105 = "stdev",
-      peg$c106 = peg$literalExpectation("stdev", true),
-      peg$c107 = function() { return "Stdev" },
-      peg$c108 = "sd",
-      peg$c109 = peg$literalExpectation("sd", true),
-      peg$c110 = "var",
-      peg$c111 = peg$literalExpectation("var", true),
-      peg$c112 = function() { return "Var" },
-      peg$c113 = "entropy",
-      peg$c114 = peg$literalExpectation("entropy", true),
-      peg$c115 = function() { return "Entropy" },
-      peg$c116 = "min",
-      peg$c117 = peg$literalExpectation("min", true),
-      peg$c118 = function() { return "Min" },
-      peg$c119 = "max",
-      peg$c120 = peg$literalExpectation("max", true),
-      peg$c121 = function() { return "Max" },
-      peg$c122 = "first",
-      peg$c123 = peg$literalExpectation("first", true),
-      peg$c124 = function() { return "First" },
-      peg$c125 = "last",
-      peg$c126 = peg$literalExpectation("last", true),
-      peg$c127 = function() { return "Last" },
-      peg$c128 = "countdistinct",
-      peg$c129 = peg$literalExpectation("countdistinct", true),
-      peg$c130 = function() { return "CountDistinct" },
-      peg$c131 = function(field) { return field },
-      peg$c132 = function(op, field) {
+      peg$c96 = function(base, refs) { return text() },
+      peg$c97 = "count",
+      peg$c98 = peg$literalExpectation("count", true),
+      peg$c99 = function() { return "Count" },
+      peg$c100 = "sum",
+      peg$c101 = peg$literalExpectation("sum", true),
+      peg$c102 = function() { return "Sum" },
+      peg$c103 = "avg",
+      peg$c104 = peg$literalExpectation("avg", true),
+      peg$c105 = function() { return "Avg" },
+      peg$c106 = "stdev",
+      peg$c107 = peg$literalExpectation("stdev", true),
+      peg$c108 = function() { return "Stdev" },
+      peg$c109 = "stddev",
+      peg$c110 = peg$literalExpectation("stddev", true),
+      peg$c111 = "sd",
+      peg$c112 = peg$literalExpectation("sd", true),
+      peg$c113 = "variance",
+      peg$c114 = peg$literalExpectation("variance", true),
+      peg$c115 = function() { return "Var" },
+      peg$c116 = "var",
+      peg$c117 = peg$literalExpectation("var", true),
+      peg$c118 = "entropy",
+      peg$c119 = peg$literalExpectation("entropy", true),
+      peg$c120 = function() { return "Entropy" },
+      peg$c121 = "min",
+      peg$c122 = peg$literalExpectation("min", true),
+      peg$c123 = function() { return "Min" },
+      peg$c124 = "max",
+      peg$c125 = peg$literalExpectation("max", true),
+      peg$c126 = function() { return "Max" },
+      peg$c127 = "first",
+      peg$c128 = peg$literalExpectation("first", true),
+      peg$c129 = function() { return "First" },
+      peg$c130 = "last",
+      peg$c131 = peg$literalExpectation("last", true),
+      peg$c132 = function() { return "Last" },
+      peg$c133 = "countdistinct",
+      peg$c134 = peg$literalExpectation("countdistinct", true),
+      peg$c135 = function() { return "CountDistinct" },
+      peg$c136 = "median",
+      peg$c137 = peg$literalExpectation("median", true),
+      peg$c138 = function() { return "Median" },
+      peg$c139 = "collect",
+      peg$c140 = peg$literalExpectation("collect", true),
+      peg$c141 = function() { return "Collect" },
+      peg$c142 = "union",
+      peg$c143 = peg$literalExpectation("union", true),
+      peg$c144 = function() { return "Union" },
+      peg$c145 = "percentile",
+      peg$c146 = peg$literalExpectation("percentile", true),
+      peg$c147 = function() { return "Percentile" },
+      peg$c148 = "quantile",
+      peg$c149 = peg$literalExpectation("quantile", true),
+      peg$c150 = function() { return "Quantile" },
+      peg$c151 = function(field) { return field },
+      peg$c152 = function(op, field) {
           let r = {"op": op, "var": "count"}
           if (field) {
             r["field"] = field
           }
           return r
         },
-      peg$c133 = function(op, field) {
-          let r = {"op": op, "var": toLowerCase(op)}
+      peg$c153 = function(op) {
+          return {"op": op, "var": toLowerCase(text())}
+        },
+      peg$c154 = function(fn, field) {
+          let r = {"op": fn["op"], "var": fn["var"]}
           if (field) {
             r["field"] = field
           }
           return r
         },
-      peg$c134 = function(every, reducers, keys, limit) {
+      peg$c155 = function(op, field, param) {
+          return {"op": op, "var": toLowerCase(op), "field": field, "param": param}
+        },
+      peg$c156 = function(every, reducers, keys, limit) {
           if (OR(keys, every)) {
             if (keys) {
               keys = keys[1]
@@ -394,24 +420,24 @@ function peg$parse(input, options) {
           }
           return {"op": "GroupByProc", "reducers": reducers}
         },
-      peg$c135 = "=",
-      peg$c136 = peg$literalExpectation("=", false),
-      peg$c137 = function(field, f) {
+      peg$c157 = "=",
+      peg$c158 = peg$literalExpectation("=", false),
+      peg$c159 = function(field, f) {
           let r = f
           r["var"] = field    
           return r
         },
-      peg$c138 = function(first, rest) {
+      peg$c160 = function(first, rest) {
             let result = [first]
             for(let  r of rest) {
               result.push( r[3])
             }
             return result
           },
-      peg$c139 = "sort",
-      peg$c140 = peg$literalExpectation("sort", true),
-      peg$c141 = function(args, l) { return l },
-      peg$c142 = function(args, list) {
+      peg$c161 = "sort",
+      peg$c162 = peg$literalExpectation("sort", true),
+      peg$c163 = function(args, l) { return l },
+      peg$c164 = function(args, list) {
           let argm = args
           let proc = {"op": "SortProc", "fields": list, "sortdir": 1, "nullsfirst": false}
           if ( "r" in argm) {
@@ -424,25 +450,25 @@ function peg$parse(input, options) {
           }
           return proc
         },
-      peg$c143 = function(a) { return a },
-      peg$c144 = function(args) {
+      peg$c165 = function(a) { return a },
+      peg$c166 = function(args) {
           return makeArgMap(args)
       },
-      peg$c145 = "-r",
-      peg$c146 = peg$literalExpectation("-r", false),
-      peg$c147 = function() { return {"name": "r", "value": null} },
-      peg$c148 = "-nulls",
-      peg$c149 = peg$literalExpectation("-nulls", false),
-      peg$c150 = peg$literalExpectation("first", false),
-      peg$c151 = peg$literalExpectation("last", false),
-      peg$c152 = function(where) { return {"name": "nulls", "value": where} },
-      peg$c153 = "top",
-      peg$c154 = peg$literalExpectation("top", true),
-      peg$c155 = function(n) { return n},
-      peg$c156 = "-flush",
-      peg$c157 = peg$literalExpectation("-flush", false),
-      peg$c158 = function(limit, flush, f) { return f },
-      peg$c159 = function(limit, flush, fields) {
+      peg$c167 = "-r",
+      peg$c168 = peg$literalExpectation("-r", false),
+      peg$c169 = function() { return {"name": "r", "value": null} },
+      peg$c170 = "-nulls",
+      peg$c171 = peg$literalExpectation("-nulls", false),
+      peg$c172 = peg$literalExpectation("first", false),
+      peg$c173 = peg$literalExpectation("last", false),
+      peg$c174 = function(where) { return {"name": "nulls", "value": where} },
+      peg$c175 = "top",
+      peg$c176 = peg$literalExpectation("top", true),
+      peg$c177 = function(n) { return n},
+      peg$c178 = "-flush",
+      peg$c179 = peg$literalExpectation("-flush", false),
+      peg$c180 = function(limit, flush, f) { return f },
+      peg$c181 = function(limit, flush, fields) {
           let proc = {"op": "TopProc"}
           if (limit) {
             proc["limit"] = limit
@@ -455,22 +481,22 @@ function peg$parse(input, options) {
           }
           return proc
         },
-      peg$c160 = "-limit",
-      peg$c161 = peg$literalExpectation("-limit", false),
-      peg$c162 = function(limit) { return limit },
-      peg$c163 = "-c",
-      peg$c164 = peg$literalExpectation("-c", false),
-      peg$c165 = function() { return {"name": "c", "value": null} },
-      peg$c166 = function(args) {
+      peg$c182 = "-limit",
+      peg$c183 = peg$literalExpectation("-limit", false),
+      peg$c184 = function(limit) { return limit },
+      peg$c185 = "-c",
+      peg$c186 = peg$literalExpectation("-c", false),
+      peg$c187 = function() { return {"name": "c", "value": null} },
+      peg$c188 = function(args) {
           return makeArgMap(args)
         },
-      peg$c167 = function(field) {
+      peg$c189 = function(field) {
           return {"target": "", "source": field}
         },
-      peg$c168 = "cut",
-      peg$c169 = peg$literalExpectation("cut", true),
-      peg$c170 = function(args, first, cl) { return cl },
-      peg$c171 = function(args, first, rest) {
+      peg$c190 = "cut",
+      peg$c191 = peg$literalExpectation("cut", true),
+      peg$c192 = function(args, first, cl) { return cl },
+      peg$c193 = function(args, first, rest) {
           let argm = args
           let proc = {"op": "CutProc", "fields": [first, ... rest], "complement": false} 
           if ( "c" in argm) {
@@ -478,41 +504,67 @@ function peg$parse(input, options) {
           }
           return proc
         },
-      peg$c172 = "head",
-      peg$c173 = peg$literalExpectation("head", true),
-      peg$c174 = function(count) { return {"op": "HeadProc", "count": count} },
-      peg$c175 = function() { return {"op": "HeadProc", "count": 1} },
-      peg$c176 = "tail",
-      peg$c177 = peg$literalExpectation("tail", true),
-      peg$c178 = function(count) { return {"op": "TailProc", "count": count} },
-      peg$c179 = function() { return {"op": "TailProc", "count": 1} },
-      peg$c180 = "filter",
-      peg$c181 = peg$literalExpectation("filter", true),
-      peg$c182 = "uniq",
-      peg$c183 = peg$literalExpectation("uniq", true),
-      peg$c184 = function() {
+      peg$c194 = "head",
+      peg$c195 = peg$literalExpectation("head", true),
+      peg$c196 = function(count) { return {"op": "HeadProc", "count": count} },
+      peg$c197 = function() { return {"op": "HeadProc", "count": 1} },
+      peg$c198 = "tail",
+      peg$c199 = peg$literalExpectation("tail", true),
+      peg$c200 = function(count) { return {"op": "TailProc", "count": count} },
+      peg$c201 = function() { return {"op": "TailProc", "count": 1} },
+      peg$c202 = "filter",
+      peg$c203 = peg$literalExpectation("filter", true),
+      peg$c204 = "uniq",
+      peg$c205 = peg$literalExpectation("uniq", true),
+      peg$c206 = function() {
             return {"op": "UniqProc", "cflag": true}
           },
-      peg$c185 = function() {
+      peg$c207 = function() {
             return {"op": "UniqProc", "cflag": false}
           },
-      peg$c186 = "put",
-      peg$c187 = peg$literalExpectation("put", true),
-      peg$c188 = function(first, rest) {
+      peg$c208 = "put",
+      peg$c209 = peg$literalExpectation("put", true),
+      peg$c210 = function(first, rest) {
             return {"op": "PutProc", "clauses": [first, ... rest]}
           },
-      peg$c189 = "rename",
-      peg$c190 = peg$literalExpectation("rename", true),
-      peg$c191 = function(first, rest) {
+      peg$c211 = "rename",
+      peg$c212 = peg$literalExpectation("rename", true),
+      peg$c213 = function(first, rest) {
             return {"op": "RenameProc", "fields": [first, ... rest]}
           },
-      peg$c192 = function(f, e) {
+      peg$c214 = "join",
+      peg$c215 = peg$literalExpectation("join", true),
+      peg$c216 = function(args, first, k) { return k },
+      peg$c217 = function(args, first, rest) {
+            let argm = args
+            let leftKeys = []
+            let rightKeys = []
+            for(let k of [first, ...rest]) {
+              leftKeys.push(k["left"])
+              rightKeys.push(k["right"])
+            }
+            let proc = {"op": "JoinProc", "kind": "inner", "left_keys": leftKeys, "right_keys": rightKeys}
+            if ("left" in argm) {
+              proc["kind"] = "left"
+            }
+            return proc
+          },
+      peg$c218 = "-left",
+      peg$c219 = peg$literalExpectation("-left", false),
+      peg$c220 = function() { return {"name": "left", "value": null} },
+      peg$c221 = function(l, r) {
+            return {"left": l, "right": r}
+          },
+      peg$c222 = function(k) {
+            return {"left": k, "right": k}
+          },
+      peg$c223 = function(f, e) {
             return {"target": f, "expression": e}
           },
-      peg$c193 = function(l, r) {
+      peg$c224 = function(l, r) {
             return {"target": l, "source": r}
           },
-      peg$c194 = function(f) {
+      peg$c225 = function(f) {
             let ret = {"op": "FieldRead", "field": f}
             for(let  d of []) {
               let derefs = d 
@@ -521,260 +573,264 @@ function peg$parse(input, options) {
             }
             return ret
           },
-      peg$c195 = "?",
-      peg$c196 = peg$literalExpectation("?", false),
-      peg$c197 = ":",
-      peg$c198 = peg$literalExpectation(":", false),
-      peg$c199 = function(condition, thenClause, elseClause) {
+      peg$c226 = "?",
+      peg$c227 = peg$literalExpectation("?", false),
+      peg$c228 = ":",
+      peg$c229 = peg$literalExpectation(":", false),
+      peg$c230 = function(condition, thenClause, elseClause) {
           return {"op": "ConditionalExpr", "condition": condition, "then": thenClause, "else": elseClause}
         },
-      peg$c200 = function(first, op, expr) { return [op, expr] },
-      peg$c201 = function(first, rest) {
+      peg$c231 = function(first, op, expr) { return [op, expr] },
+      peg$c232 = function(first, rest) {
               return makeBinaryExprChain(first, rest)
           },
-      peg$c202 = function(first, comp, expr) { return [comp, expr] },
-      peg$c203 = "=~",
-      peg$c204 = peg$literalExpectation("=~", false),
-      peg$c205 = "!~",
-      peg$c206 = peg$literalExpectation("!~", false),
-      peg$c207 = "!=",
-      peg$c208 = peg$literalExpectation("!=", false),
-      peg$c209 = peg$literalExpectation("in", false),
-      peg$c210 = "<=",
-      peg$c211 = peg$literalExpectation("<=", false),
-      peg$c212 = "<",
-      peg$c213 = peg$literalExpectation("<", false),
-      peg$c214 = ">=",
-      peg$c215 = peg$literalExpectation(">=", false),
-      peg$c216 = ">",
-      peg$c217 = peg$literalExpectation(">", false),
-      peg$c218 = "+",
-      peg$c219 = peg$literalExpectation("+", false),
-      peg$c220 = "/",
-      peg$c221 = peg$literalExpectation("/", false),
-      peg$c222 = function(e) {
+      peg$c233 = function(first, comp, expr) { return [comp, expr] },
+      peg$c234 = "=~",
+      peg$c235 = peg$literalExpectation("=~", false),
+      peg$c236 = "!~",
+      peg$c237 = peg$literalExpectation("!~", false),
+      peg$c238 = "!=",
+      peg$c239 = peg$literalExpectation("!=", false),
+      peg$c240 = peg$literalExpectation("in", false),
+      peg$c241 = "<=",
+      peg$c242 = peg$literalExpectation("<=", false),
+      peg$c243 = "<",
+      peg$c244 = peg$literalExpectation("<", false),
+      peg$c245 = ">=",
+      peg$c246 = peg$literalExpectation(">=", false),
+      peg$c247 = ">",
+      peg$c248 = peg$literalExpectation(">", false),
+      peg$c249 = "+",
+      peg$c250 = peg$literalExpectation("+", false),
+      peg$c251 = "/",
+      peg$c252 = peg$literalExpectation("/", false),
+      peg$c253 = function(e) {
               return {"op": "UnaryExpr", "operator": "!", "operand": e}
           },
-      peg$c223 = function(e, ct) { return ct },
-      peg$c224 = function(e, t) {
+      peg$c254 = function(e, ct) { return ct },
+      peg$c255 = function(e, t) {
           if (t) {
             return {"op": "CastExpr", "expr": e, "type": t}
           } else {
             return e
           }
         },
-      peg$c225 = "bool",
-      peg$c226 = peg$literalExpectation("bool", false),
-      peg$c227 = "byte",
-      peg$c228 = peg$literalExpectation("byte", false),
-      peg$c229 = "int16",
-      peg$c230 = peg$literalExpectation("int16", false),
-      peg$c231 = "uint16",
-      peg$c232 = peg$literalExpectation("uint16", false),
-      peg$c233 = "int32",
-      peg$c234 = peg$literalExpectation("int32", false),
-      peg$c235 = "uint32",
-      peg$c236 = peg$literalExpectation("uint32", false),
-      peg$c237 = "int64",
-      peg$c238 = peg$literalExpectation("int64", false),
-      peg$c239 = "uint64",
-      peg$c240 = peg$literalExpectation("uint64", false),
-      peg$c241 = "float64",
-      peg$c242 = peg$literalExpectation("float64", false),
-      peg$c243 = "string",
-      peg$c244 = peg$literalExpectation("string", false),
-      peg$c245 = "bstring",
-      peg$c246 = peg$literalExpectation("bstring", false),
-      peg$c247 = "ip",
-      peg$c248 = peg$literalExpectation("ip", false),
-      peg$c249 = "net",
-      peg$c250 = peg$literalExpectation("net", false),
-      peg$c251 = "time",
-      peg$c252 = peg$literalExpectation("time", false),
-      peg$c253 = "duration",
-      peg$c254 = peg$literalExpectation("duration", false),
-      peg$c255 = function(fn, args) {
+      peg$c256 = "bool",
+      peg$c257 = peg$literalExpectation("bool", false),
+      peg$c258 = "bytes",
+      peg$c259 = peg$literalExpectation("bytes", false),
+      peg$c260 = "byte",
+      peg$c261 = peg$literalExpectation("byte", false),
+      peg$c262 = "int16",
+      peg$c263 = peg$literalExpectation("int16", false),
+      peg$c264 = "uint16",
+      peg$c265 = peg$literalExpectation("uint16", false),
+      peg$c266 = "int32",
+      peg$c267 = peg$literalExpectation("int32", false),
+      peg$c268 = "uint32",
+      peg$c269 = peg$literalExpectation("uint32", false),
+      peg$c270 = "int64",
+      peg$c271 = peg$literalExpectation("int64", false),
+      peg$c272 = "uint64",
+      peg$c273 = peg$literalExpectation("uint64", false),
+      peg$c274 = "float64",
+      peg$c275 = peg$literalExpectation("float64", false),
+      peg$c276 = "string",
+      peg$c277 = peg$literalExpectation("string", false),
+      peg$c278 = "bstring",
+      peg$c279 = peg$literalExpectation("bstring", false),
+      peg$c280 = "enum",
+      peg$c281 = peg$literalExpectation("enum", false),
+      peg$c282 = "ip",
+      peg$c283 = peg$literalExpectation("ip", false),
+      peg$c284 = "net",
+      peg$c285 = peg$literalExpectation("net", false),
+      peg$c286 = "time",
+      peg$c287 = peg$literalExpectation("time", false),
+      peg$c288 = "duration",
+      peg$c289 = peg$literalExpectation("duration", false),
+      peg$c290 = function(fn, args) {
               return {"op": "FunctionCall", "function": fn, "args": args}
           },
-      peg$c256 = /^[A-Za-z]/,
-      peg$c257 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
-      peg$c258 = /^[.0-9]/,
-      peg$c259 = peg$classExpectation([".", ["0", "9"]], false, false),
-      peg$c260 = function(first, e) { return e },
-      peg$c261 = function(first, rest) {
+      peg$c291 = /^[A-Za-z]/,
+      peg$c292 = peg$classExpectation([["A", "Z"], ["a", "z"]], false, false),
+      peg$c293 = /^[._0-9]/,
+      peg$c294 = peg$classExpectation([".", "_", ["0", "9"]], false, false),
+      peg$c295 = function(first, e) { return e },
+      peg$c296 = function(first, rest) {
             return [first, ... rest]
         },
-      peg$c262 = function() { return [] },
-      peg$c263 = function(base, index) {
+      peg$c297 = function() { return [] },
+      peg$c298 = function(base, index) {
                 return ["[", index]
               },
-      peg$c264 = function(base, field) {
+      peg$c299 = function(base, field) {
                 return [".", {"op": "Literal", "type": "string", "value": field}]
               },
-      peg$c265 = function(base, derefs) {
+      peg$c300 = function(base, derefs) {
               return makeBinaryExprChain(base, derefs)
           },
-      peg$c266 = peg$literalExpectation("and", false),
-      peg$c267 = "seconds",
-      peg$c268 = peg$literalExpectation("seconds", false),
-      peg$c269 = "second",
-      peg$c270 = peg$literalExpectation("second", false),
-      peg$c271 = "secs",
-      peg$c272 = peg$literalExpectation("secs", false),
-      peg$c273 = "sec",
-      peg$c274 = peg$literalExpectation("sec", false),
-      peg$c275 = "s",
-      peg$c276 = peg$literalExpectation("s", false),
-      peg$c277 = "minutes",
-      peg$c278 = peg$literalExpectation("minutes", false),
-      peg$c279 = "minute",
-      peg$c280 = peg$literalExpectation("minute", false),
-      peg$c281 = "mins",
-      peg$c282 = peg$literalExpectation("mins", false),
-      peg$c283 = peg$literalExpectation("min", false),
-      peg$c284 = "m",
-      peg$c285 = peg$literalExpectation("m", false),
-      peg$c286 = "hours",
-      peg$c287 = peg$literalExpectation("hours", false),
-      peg$c288 = "hrs",
-      peg$c289 = peg$literalExpectation("hrs", false),
-      peg$c290 = "hr",
-      peg$c291 = peg$literalExpectation("hr", false),
-      peg$c292 = "h",
-      peg$c293 = peg$literalExpectation("h", false),
-      peg$c294 = "hour",
-      peg$c295 = peg$literalExpectation("hour", false),
-      peg$c296 = "days",
-      peg$c297 = peg$literalExpectation("days", false),
-      peg$c298 = "day",
-      peg$c299 = peg$literalExpectation("day", false),
-      peg$c300 = "d",
-      peg$c301 = peg$literalExpectation("d", false),
-      peg$c302 = "weeks",
-      peg$c303 = peg$literalExpectation("weeks", false),
-      peg$c304 = "week",
-      peg$c305 = peg$literalExpectation("week", false),
-      peg$c306 = "wks",
-      peg$c307 = peg$literalExpectation("wks", false),
-      peg$c308 = "wk",
-      peg$c309 = peg$literalExpectation("wk", false),
-      peg$c310 = "w",
-      peg$c311 = peg$literalExpectation("w", false),
-      peg$c312 = function() { return {"type": "Duration", "seconds": 1} },
-      peg$c313 = function(num) { return {"type": "Duration", "seconds": num} },
-      peg$c314 = function() { return {"type": "Duration", "seconds": 60} },
-      peg$c315 = function(num) { return {"type": "Duration", "seconds": num*60} },
-      peg$c316 = function() { return {"type": "Duration", "seconds": 3600} },
-      peg$c317 = function(num) { return {"type": "Duration", "seconds": num*3600} },
-      peg$c318 = function() { return {"type": "Duration", "seconds": 3600*24} },
-      peg$c319 = function(num) { return {"type": "Duration", "seconds": (num*3600*24)} },
-      peg$c320 = function(num) { return {"type": "Duration", "seconds": num*3600*24*7} },
-      peg$c321 = function(a) { return text() },
-      peg$c322 = function(a, b) {
+      peg$c301 = peg$literalExpectation("and", false),
+      peg$c302 = "seconds",
+      peg$c303 = peg$literalExpectation("seconds", false),
+      peg$c304 = "second",
+      peg$c305 = peg$literalExpectation("second", false),
+      peg$c306 = "secs",
+      peg$c307 = peg$literalExpectation("secs", false),
+      peg$c308 = "sec",
+      peg$c309 = peg$literalExpectation("sec", false),
+      peg$c310 = "s",
+      peg$c311 = peg$literalExpectation("s", false),
+      peg$c312 = "minutes",
+      peg$c313 = peg$literalExpectation("minutes", false),
+      peg$c314 = "minute",
+      peg$c315 = peg$literalExpectation("minute", false),
+      peg$c316 = "mins",
+      peg$c317 = peg$literalExpectation("mins", false),
+      peg$c318 = peg$literalExpectation("min", false),
+      peg$c319 = "m",
+      peg$c320 = peg$literalExpectation("m", false),
+      peg$c321 = "hours",
+      peg$c322 = peg$literalExpectation("hours", false),
+      peg$c323 = "hrs",
+      peg$c324 = peg$literalExpectation("hrs", false),
+      peg$c325 = "hr",
+      peg$c326 = peg$literalExpectation("hr", false),
+      peg$c327 = "h",
+      peg$c328 = peg$literalExpectation("h", false),
+      peg$c329 = "hour",
+      peg$c330 = peg$literalExpectation("hour", false),
+      peg$c331 = "days",
+      peg$c332 = peg$literalExpectation("days", false),
+      peg$c333 = "day",
+      peg$c334 = peg$literalExpectation("day", false),
+      peg$c335 = "d",
+      peg$c336 = peg$literalExpectation("d", false),
+      peg$c337 = "weeks",
+      peg$c338 = peg$literalExpectation("weeks", false),
+      peg$c339 = "week",
+      peg$c340 = peg$literalExpectation("week", false),
+      peg$c341 = "wks",
+      peg$c342 = peg$literalExpectation("wks", false),
+      peg$c343 = "wk",
+      peg$c344 = peg$literalExpectation("wk", false),
+      peg$c345 = "w",
+      peg$c346 = peg$literalExpectation("w", false),
+      peg$c347 = function() { return {"type": "Duration", "seconds": 1} },
+      peg$c348 = function(num) { return {"type": "Duration", "seconds": num} },
+      peg$c349 = function() { return {"type": "Duration", "seconds": 60} },
+      peg$c350 = function(num) { return {"type": "Duration", "seconds": num*60} },
+      peg$c351 = function() { return {"type": "Duration", "seconds": 3600} },
+      peg$c352 = function(num) { return {"type": "Duration", "seconds": num*3600} },
+      peg$c353 = function() { return {"type": "Duration", "seconds": 3600*24} },
+      peg$c354 = function(num) { return {"type": "Duration", "seconds": (num*3600*24)} },
+      peg$c355 = function(num) { return {"type": "Duration", "seconds": num*3600*24*7} },
+      peg$c356 = function(a) { return text() },
+      peg$c357 = function(a, b) {
             return joinChars(a) + b
           },
-      peg$c323 = "::",
-      peg$c324 = peg$literalExpectation("::", false),
-      peg$c325 = function(a, b, d, e) {
+      peg$c358 = "::",
+      peg$c359 = peg$literalExpectation("::", false),
+      peg$c360 = function(a, b, d, e) {
             return a + joinChars(b) + "::" + joinChars(d) + e
           },
-      peg$c326 = function(a, b) {
+      peg$c361 = function(a, b) {
             return "::" + joinChars(a) + b
           },
-      peg$c327 = function(a, b) {
+      peg$c362 = function(a, b) {
             return a + joinChars(b) + "::"
           },
-      peg$c328 = function() {
+      peg$c363 = function() {
             return "::"
           },
-      peg$c329 = function(v) { return ":" + v },
-      peg$c330 = function(v) { return v + ":" },
-      peg$c331 = function(a, m) {
+      peg$c364 = function(v) { return ":" + v },
+      peg$c365 = function(v) { return v + ":" },
+      peg$c366 = function(a, m) {
             return a + "/" + m.toString();
           },
-      peg$c332 = function(a, m) {
+      peg$c367 = function(a, m) {
             return a + "/" + m;
           },
-      peg$c333 = function(s) { return parseInt(s) },
-      peg$c334 = /^[+\-]/,
-      peg$c335 = peg$classExpectation(["+", "-"], false, false),
-      peg$c336 = function(s) {
+      peg$c368 = function(s) { return parseInt(s) },
+      peg$c369 = /^[+\-]/,
+      peg$c370 = peg$classExpectation(["+", "-"], false, false),
+      peg$c371 = function(s) {
             return parseFloat(s)
         },
-      peg$c337 = function() {
+      peg$c372 = function() {
             return text()
           },
-      peg$c338 = "0",
-      peg$c339 = peg$literalExpectation("0", false),
-      peg$c340 = /^[1-9]/,
-      peg$c341 = peg$classExpectation([["1", "9"]], false, false),
-      peg$c342 = "e",
-      peg$c343 = peg$literalExpectation("e", true),
-      peg$c344 = function(chars) { return text() },
-      peg$c345 = /^[0-9a-fA-F]/,
-      peg$c346 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
-      peg$c347 = function(chars) { return joinChars(chars) },
-      peg$c348 = "\\",
-      peg$c349 = peg$literalExpectation("\\", false),
-      peg$c350 = /^[\0-\x1F\\(),!><="|';]/,
-      peg$c351 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";"], false, false),
-      peg$c352 = peg$anyExpectation(),
-      peg$c353 = "\"",
-      peg$c354 = peg$literalExpectation("\"", false),
-      peg$c355 = function(v) { return joinChars(v) },
-      peg$c356 = "'",
-      peg$c357 = peg$literalExpectation("'", false),
-      peg$c358 = "x",
-      peg$c359 = peg$literalExpectation("x", false),
-      peg$c360 = function() { return "\\" + text() },
-      peg$c361 = "b",
-      peg$c362 = peg$literalExpectation("b", false),
-      peg$c363 = function() { return "\b" },
-      peg$c364 = "f",
-      peg$c365 = peg$literalExpectation("f", false),
-      peg$c366 = function() { return "\f" },
-      peg$c367 = "n",
-      peg$c368 = peg$literalExpectation("n", false),
-      peg$c369 = function() { return "\n" },
-      peg$c370 = "r",
-      peg$c371 = peg$literalExpectation("r", false),
-      peg$c372 = function() { return "\r" },
-      peg$c373 = "t",
-      peg$c374 = peg$literalExpectation("t", false),
-      peg$c375 = function() { return "\t" },
-      peg$c376 = "v",
-      peg$c377 = peg$literalExpectation("v", false),
-      peg$c378 = function() { return "\v" },
-      peg$c379 = function() { return "=" },
-      peg$c380 = function() { return "\\*" },
-      peg$c381 = "u",
-      peg$c382 = peg$literalExpectation("u", false),
-      peg$c383 = function(chars) {
+      peg$c373 = "0",
+      peg$c374 = peg$literalExpectation("0", false),
+      peg$c375 = /^[1-9]/,
+      peg$c376 = peg$classExpectation([["1", "9"]], false, false),
+      peg$c377 = "e",
+      peg$c378 = peg$literalExpectation("e", true),
+      peg$c379 = function(chars) { return text() },
+      peg$c380 = /^[0-9a-fA-F]/,
+      peg$c381 = peg$classExpectation([["0", "9"], ["a", "f"], ["A", "F"]], false, false),
+      peg$c382 = function(chars) { return joinChars(chars) },
+      peg$c383 = "\\",
+      peg$c384 = peg$literalExpectation("\\", false),
+      peg$c385 = /^[\0-\x1F\\(),!><="|';]/,
+      peg$c386 = peg$classExpectation([["\0", "\x1F"], "\\", "(", ")", ",", "!", ">", "<", "=", "\"", "|", "'", ";"], false, false),
+      peg$c387 = peg$anyExpectation(),
+      peg$c388 = "\"",
+      peg$c389 = peg$literalExpectation("\"", false),
+      peg$c390 = function(v) { return joinChars(v) },
+      peg$c391 = "'",
+      peg$c392 = peg$literalExpectation("'", false),
+      peg$c393 = "x",
+      peg$c394 = peg$literalExpectation("x", false),
+      peg$c395 = function() { return "\\" + text() },
+      peg$c396 = "b",
+      peg$c397 = peg$literalExpectation("b", false),
+      peg$c398 = function() { return "\b" },
+      peg$c399 = "f",
+      peg$c400 = peg$literalExpectation("f", false),
+      peg$c401 = function() { return "\f" },
+      peg$c402 = "n",
+      peg$c403 = peg$literalExpectation("n", false),
+      peg$c404 = function() { return "\n" },
+      peg$c405 = "r",
+      peg$c406 = peg$literalExpectation("r", false),
+      peg$c407 = function() { return "\r" },
+      peg$c408 = "t",
+      peg$c409 = peg$literalExpectation("t", false),
+      peg$c410 = function() { return "\t" },
+      peg$c411 = "v",
+      peg$c412 = peg$literalExpectation("v", false),
+      peg$c413 = function() { return "\v" },
+      peg$c414 = function() { return "=" },
+      peg$c415 = function() { return "\\*" },
+      peg$c416 = "u",
+      peg$c417 = peg$literalExpectation("u", false),
+      peg$c418 = function(chars) {
             return makeUnicodeChar(chars)
           },
-      peg$c384 = "{",
-      peg$c385 = peg$literalExpectation("{", false),
-      peg$c386 = "}",
-      peg$c387 = peg$literalExpectation("}", false),
-      peg$c388 = /^[^\/\\]/,
-      peg$c389 = peg$classExpectation(["/", "\\"], true, false),
-      peg$c390 = "\\/",
-      peg$c391 = peg$literalExpectation("\\/", false),
-      peg$c392 = /^[\0-\x1F\\]/,
-      peg$c393 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
-      peg$c394 = "\t",
-      peg$c395 = peg$literalExpectation("\t", false),
-      peg$c396 = "\x0B",
-      peg$c397 = peg$literalExpectation("\x0B", false),
-      peg$c398 = "\f",
-      peg$c399 = peg$literalExpectation("\f", false),
-      peg$c400 = " ",
-      peg$c401 = peg$literalExpectation(" ", false),
-      peg$c402 = "\xA0",
-      peg$c403 = peg$literalExpectation("\xA0", false),
-      peg$c404 = "\uFEFF",
-      peg$c405 = peg$literalExpectation("\uFEFF", false),
-      peg$c406 = peg$otherExpectation("whitespace"),
+      peg$c419 = "{",
+      peg$c420 = peg$literalExpectation("{", false),
+      peg$c421 = "}",
+      peg$c422 = peg$literalExpectation("}", false),
+      peg$c423 = /^[^\/\\]/,
+      peg$c424 = peg$classExpectation(["/", "\\"], true, false),
+      peg$c425 = "\\/",
+      peg$c426 = peg$literalExpectation("\\/", false),
+      peg$c427 = /^[\0-\x1F\\]/,
+      peg$c428 = peg$classExpectation([["\0", "\x1F"], "\\"], false, false),
+      peg$c429 = "\t",
+      peg$c430 = peg$literalExpectation("\t", false),
+      peg$c431 = "\x0B",
+      peg$c432 = peg$literalExpectation("\x0B", false),
+      peg$c433 = "\f",
+      peg$c434 = peg$literalExpectation("\f", false),
+      peg$c435 = " ",
+      peg$c436 = peg$literalExpectation(" ", false),
+      peg$c437 = "\xA0",
+      peg$c438 = peg$literalExpectation("\xA0", false),
+      peg$c439 = "\uFEFF",
+      peg$c440 = peg$literalExpectation("\uFEFF", false),
+      peg$c441 = peg$otherExpectation("whitespace"),
 
       peg$currPos          = 0,
       peg$savedPos         = 0,
@@ -2551,6 +2607,42 @@ function peg$parse(input, options) {
           peg$currPos = s3;
           s3 = peg$FAILED;
         }
+        if (s3 === peg$FAILED) {
+          s3 = peg$currPos;
+          if (input.charCodeAt(peg$currPos) === 91) {
+            s4 = peg$c84;
+            peg$currPos++;
+          } else {
+            s4 = peg$FAILED;
+            if (peg$silentFails === 0) { peg$fail(peg$c85); }
+          }
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parsequotedString();
+            if (s5 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 93) {
+                s6 = peg$c86;
+                peg$currPos++;
+              } else {
+                s6 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c87); }
+              }
+              if (s6 !== peg$FAILED) {
+                peg$savedPos = s3;
+                s4 = peg$c89(s1, s5);
+                s3 = s4;
+              } else {
+                peg$currPos = s3;
+                s3 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s3;
+            s3 = peg$FAILED;
+          }
+        }
       }
       while (s3 !== peg$FAILED) {
         s2.push(s3);
@@ -2611,11 +2703,47 @@ function peg$parse(input, options) {
             peg$currPos = s3;
             s3 = peg$FAILED;
           }
+          if (s3 === peg$FAILED) {
+            s3 = peg$currPos;
+            if (input.charCodeAt(peg$currPos) === 91) {
+              s4 = peg$c84;
+              peg$currPos++;
+            } else {
+              s4 = peg$FAILED;
+              if (peg$silentFails === 0) { peg$fail(peg$c85); }
+            }
+            if (s4 !== peg$FAILED) {
+              s5 = peg$parsequotedString();
+              if (s5 !== peg$FAILED) {
+                if (input.charCodeAt(peg$currPos) === 93) {
+                  s6 = peg$c86;
+                  peg$currPos++;
+                } else {
+                  s6 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c87); }
+                }
+                if (s6 !== peg$FAILED) {
+                  peg$savedPos = s3;
+                  s4 = peg$c89(s1, s5);
+                  s3 = s4;
+                } else {
+                  peg$currPos = s3;
+                  s3 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s3;
+                s3 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s3;
+              s3 = peg$FAILED;
+            }
+          }
         }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c89(s1, s2);
+        s1 = peg$c90(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2669,7 +2797,7 @@ function peg$parse(input, options) {
                 }
                 if (s7 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c90(s1, s5);
+                  s1 = peg$c91(s1, s5);
                   s0 = s1;
                 } else {
                   peg$currPos = s0;
@@ -2710,16 +2838,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c91) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c92) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c92); }
+      if (peg$silentFails === 0) { peg$fail(peg$c93); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c93();
+      s1 = peg$c94();
     }
     s0 = s1;
 
@@ -2816,7 +2944,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c94(s1, s2);
+        s1 = peg$c95(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2884,7 +3012,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c95(s1, s2);
+        s1 = peg$c96(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -2902,16 +3030,16 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c96) {
+    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c97) {
       s1 = input.substr(peg$currPos, 5);
       peg$currPos += 5;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c97); }
+      if (peg$silentFails === 0) { peg$fail(peg$c98); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c98();
+      s1 = peg$c99();
     }
     s0 = s1;
 
@@ -2922,79 +3050,79 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c99) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c100) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c100); }
+      if (peg$silentFails === 0) { peg$fail(peg$c101); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c101();
+      s1 = peg$c102();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 3).toLowerCase() === peg$c102) {
+      if (input.substr(peg$currPos, 3).toLowerCase() === peg$c103) {
         s1 = input.substr(peg$currPos, 3);
         peg$currPos += 3;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c103); }
+        if (peg$silentFails === 0) { peg$fail(peg$c104); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c104();
+        s1 = peg$c105();
       }
       s0 = s1;
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 5).toLowerCase() === peg$c105) {
+        if (input.substr(peg$currPos, 5).toLowerCase() === peg$c106) {
           s1 = input.substr(peg$currPos, 5);
           peg$currPos += 5;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c106); }
+          if (peg$silentFails === 0) { peg$fail(peg$c107); }
         }
         if (s1 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c107();
+          s1 = peg$c108();
         }
         s0 = s1;
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
-          if (input.substr(peg$currPos, 2).toLowerCase() === peg$c108) {
-            s1 = input.substr(peg$currPos, 2);
-            peg$currPos += 2;
+          if (input.substr(peg$currPos, 6).toLowerCase() === peg$c109) {
+            s1 = input.substr(peg$currPos, 6);
+            peg$currPos += 6;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c109); }
+            if (peg$silentFails === 0) { peg$fail(peg$c110); }
           }
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c107();
+            s1 = peg$c108();
           }
           s0 = s1;
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            if (input.substr(peg$currPos, 3).toLowerCase() === peg$c110) {
-              s1 = input.substr(peg$currPos, 3);
-              peg$currPos += 3;
+            if (input.substr(peg$currPos, 2).toLowerCase() === peg$c111) {
+              s1 = input.substr(peg$currPos, 2);
+              peg$currPos += 2;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c111); }
+              if (peg$silentFails === 0) { peg$fail(peg$c112); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c112();
+              s1 = peg$c108();
             }
             s0 = s1;
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
-              if (input.substr(peg$currPos, 7).toLowerCase() === peg$c113) {
-                s1 = input.substr(peg$currPos, 7);
-                peg$currPos += 7;
+              if (input.substr(peg$currPos, 8).toLowerCase() === peg$c113) {
+                s1 = input.substr(peg$currPos, 8);
+                peg$currPos += 8;
               } else {
                 s1 = peg$FAILED;
                 if (peg$silentFails === 0) { peg$fail(peg$c114); }
@@ -3015,65 +3143,140 @@ function peg$parse(input, options) {
                 }
                 if (s1 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c118();
+                  s1 = peg$c115();
                 }
                 s0 = s1;
                 if (s0 === peg$FAILED) {
                   s0 = peg$currPos;
-                  if (input.substr(peg$currPos, 3).toLowerCase() === peg$c119) {
-                    s1 = input.substr(peg$currPos, 3);
-                    peg$currPos += 3;
+                  if (input.substr(peg$currPos, 7).toLowerCase() === peg$c118) {
+                    s1 = input.substr(peg$currPos, 7);
+                    peg$currPos += 7;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c120); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c119); }
                   }
                   if (s1 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c121();
+                    s1 = peg$c120();
                   }
                   s0 = s1;
                   if (s0 === peg$FAILED) {
                     s0 = peg$currPos;
-                    if (input.substr(peg$currPos, 5).toLowerCase() === peg$c122) {
-                      s1 = input.substr(peg$currPos, 5);
-                      peg$currPos += 5;
+                    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c121) {
+                      s1 = input.substr(peg$currPos, 3);
+                      peg$currPos += 3;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c123); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c122); }
                     }
                     if (s1 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c124();
+                      s1 = peg$c123();
                     }
                     s0 = s1;
                     if (s0 === peg$FAILED) {
                       s0 = peg$currPos;
-                      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c125) {
-                        s1 = input.substr(peg$currPos, 4);
-                        peg$currPos += 4;
+                      if (input.substr(peg$currPos, 3).toLowerCase() === peg$c124) {
+                        s1 = input.substr(peg$currPos, 3);
+                        peg$currPos += 3;
                       } else {
                         s1 = peg$FAILED;
-                        if (peg$silentFails === 0) { peg$fail(peg$c126); }
+                        if (peg$silentFails === 0) { peg$fail(peg$c125); }
                       }
                       if (s1 !== peg$FAILED) {
                         peg$savedPos = s0;
-                        s1 = peg$c127();
+                        s1 = peg$c126();
                       }
                       s0 = s1;
                       if (s0 === peg$FAILED) {
                         s0 = peg$currPos;
-                        if (input.substr(peg$currPos, 13).toLowerCase() === peg$c128) {
-                          s1 = input.substr(peg$currPos, 13);
-                          peg$currPos += 13;
+                        if (input.substr(peg$currPos, 5).toLowerCase() === peg$c127) {
+                          s1 = input.substr(peg$currPos, 5);
+                          peg$currPos += 5;
                         } else {
                           s1 = peg$FAILED;
-                          if (peg$silentFails === 0) { peg$fail(peg$c129); }
+                          if (peg$silentFails === 0) { peg$fail(peg$c128); }
                         }
                         if (s1 !== peg$FAILED) {
                           peg$savedPos = s0;
-                          s1 = peg$c130();
+                          s1 = peg$c129();
                         }
                         s0 = s1;
+                        if (s0 === peg$FAILED) {
+                          s0 = peg$currPos;
+                          if (input.substr(peg$currPos, 4).toLowerCase() === peg$c130) {
+                            s1 = input.substr(peg$currPos, 4);
+                            peg$currPos += 4;
+                          } else {
+                            s1 = peg$FAILED;
+                            if (peg$silentFails === 0) { peg$fail(peg$c131); }
+                          }
+                          if (s1 !== peg$FAILED) {
+                            peg$savedPos = s0;
+                            s1 = peg$c132();
+                          }
+                          s0 = s1;
+                          if (s0 === peg$FAILED) {
+                            s0 = peg$currPos;
+                            if (input.substr(peg$currPos, 13).toLowerCase() === peg$c133) {
+                              s1 = input.substr(peg$currPos, 13);
+                              peg$currPos += 13;
+                            } else {
+                              s1 = peg$FAILED;
+                              if (peg$silentFails === 0) { peg$fail(peg$c134); }
+                            }
+                            if (s1 !== peg$FAILED) {
+                              peg$savedPos = s0;
+                              s1 = peg$c135();
+                            }
+                            s0 = s1;
+                            if (s0 === peg$FAILED) {
+                              s0 = peg$currPos;
+                              if (input.substr(peg$currPos, 6).toLowerCase() === peg$c136) {
+                                s1 = input.substr(peg$currPos, 6);
+                                peg$currPos += 6;
+                              } else {
+                                s1 = peg$FAILED;
+                                if (peg$silentFails === 0) { peg$fail(peg$c137); }
+                              }
+                              if (s1 !== peg$FAILED) {
+                                peg$savedPos = s0;
+                                s1 = peg$c138();
+                              }
+                              s0 = s1;
+                              if (s0 === peg$FAILED) {
+                                s0 = peg$currPos;
+                                if (input.substr(peg$currPos, 7).toLowerCase() === peg$c139) {
+                                  s1 = input.substr(peg$currPos, 7);
+                                  peg$currPos += 7;
+                                } else {
+                                  s1 = peg$FAILED;
+                                  if (peg$silentFails === 0) { peg$fail(peg$c140); }
+                                }
+                                if (s1 !== peg$FAILED) {
+                                  peg$savedPos = s0;
+                                  s1 = peg$c141();
+                                }
+                                s0 = s1;
+                                if (s0 === peg$FAILED) {
+                                  s0 = peg$currPos;
+                                  if (input.substr(peg$currPos, 5).toLowerCase() === peg$c142) {
+                                    s1 = input.substr(peg$currPos, 5);
+                                    peg$currPos += 5;
+                                  } else {
+                                    s1 = peg$FAILED;
+                                    if (peg$silentFails === 0) { peg$fail(peg$c143); }
+                                  }
+                                  if (s1 !== peg$FAILED) {
+                                    peg$savedPos = s0;
+                                    s1 = peg$c144();
+                                  }
+                                  s0 = s1;
+                                }
+                              }
+                            }
+                          }
+                        }
                       }
                     }
                   }
@@ -3088,6 +3291,41 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parseparamReducerOp() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 10).toLowerCase() === peg$c145) {
+      s1 = input.substr(peg$currPos, 10);
+      peg$currPos += 10;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c146); }
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c147();
+    }
+    s0 = s1;
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      if (input.substr(peg$currPos, 8).toLowerCase() === peg$c148) {
+        s1 = input.substr(peg$currPos, 8);
+        peg$currPos += 8;
+      } else {
+        s1 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c149); }
+      }
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c150();
+      }
+      s0 = s1;
+    }
+
+    return s0;
+  }
+
   function peg$parsepaddedFieldExpr() {
     var s0, s1, s2, s3;
 
@@ -3105,7 +3343,7 @@ function peg$parse(input, options) {
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c131(s2);
+          s1 = peg$c151(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3161,7 +3399,7 @@ function peg$parse(input, options) {
               }
               if (s6 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c132(s1, s4);
+                s1 = peg$c152(s1, s4);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -3191,11 +3429,25 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parsefieldReducerFunc() {
+    var s0, s1;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldReducerOp();
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c153(s1);
+    }
+    s0 = s1;
+
+    return s0;
+  }
+
   function peg$parsefieldReducer() {
     var s0, s1, s2, s3, s4, s5, s6, s7;
 
     s0 = peg$currPos;
-    s1 = peg$parsefieldReducerOp();
+    s1 = peg$parsefieldReducerFunc();
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 === peg$FAILED) {
@@ -3231,7 +3483,7 @@ function peg$parse(input, options) {
                 }
                 if (s7 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c133(s1, s5);
+                  s1 = peg$c154(s1, s5);
                   s0 = s1;
                 } else {
                   peg$currPos = s0;
@@ -3265,6 +3517,119 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parseparamReducer() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11;
+
+    s0 = peg$currPos;
+    s1 = peg$parseparamReducerOp();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse_();
+      if (s2 === peg$FAILED) {
+        s2 = null;
+      }
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 40) {
+          s3 = peg$c19;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c20); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse_();
+          if (s4 === peg$FAILED) {
+            s4 = null;
+          }
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parsefieldExpr();
+            if (s5 !== peg$FAILED) {
+              s6 = peg$parse_();
+              if (s6 === peg$FAILED) {
+                s6 = null;
+              }
+              if (s6 !== peg$FAILED) {
+                if (input.charCodeAt(peg$currPos) === 44) {
+                  s7 = peg$c60;
+                  peg$currPos++;
+                } else {
+                  s7 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c61); }
+                }
+                if (s7 !== peg$FAILED) {
+                  s8 = peg$parse_();
+                  if (s8 === peg$FAILED) {
+                    s8 = null;
+                  }
+                  if (s8 !== peg$FAILED) {
+                    s9 = peg$parsedouble();
+                    if (s9 === peg$FAILED) {
+                      s9 = peg$parseunsignedInteger();
+                    }
+                    if (s9 !== peg$FAILED) {
+                      s10 = peg$parse_();
+                      if (s10 === peg$FAILED) {
+                        s10 = null;
+                      }
+                      if (s10 !== peg$FAILED) {
+                        if (input.charCodeAt(peg$currPos) === 41) {
+                          s11 = peg$c21;
+                          peg$currPos++;
+                        } else {
+                          s11 = peg$FAILED;
+                          if (peg$silentFails === 0) { peg$fail(peg$c22); }
+                        }
+                        if (s11 !== peg$FAILED) {
+                          peg$savedPos = s0;
+                          s1 = peg$c155(s1, s5, s9);
+                          s0 = s1;
+                        } else {
+                          peg$currPos = s0;
+                          s0 = peg$FAILED;
+                        }
+                      } else {
+                        peg$currPos = s0;
+                        s0 = peg$FAILED;
+                      }
+                    } else {
+                      peg$currPos = s0;
+                      s0 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s0;
+                    s0 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s0;
+                  s0 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s0;
+                s0 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
   function peg$parsegroupByProc() {
     var s0, s1, s2, s3, s4, s5;
 
@@ -3315,7 +3680,7 @@ function peg$parse(input, options) {
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c134(s1, s2, s3, s4);
+            s1 = peg$c156(s1, s2, s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3349,11 +3714,11 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c135;
+          s3 = peg$c157;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c136); }
+          if (peg$silentFails === 0) { peg$fail(peg$c158); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse_();
@@ -3364,7 +3729,7 @@ function peg$parse(input, options) {
             s5 = peg$parsereducer();
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c137(s1, s5);
+              s1 = peg$c159(s1, s5);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -3398,7 +3763,10 @@ function peg$parse(input, options) {
 
     s0 = peg$parsecountReducer();
     if (s0 === peg$FAILED) {
-      s0 = peg$parsefieldReducer();
+      s0 = peg$parseparamReducer();
+      if (s0 === peg$FAILED) {
+        s0 = peg$parsefieldReducer();
+      }
     }
 
     return s0;
@@ -3494,7 +3862,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c138(s1, s2);
+        s1 = peg$c160(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -3528,6 +3896,9 @@ function peg$parse(input, options) {
                   s0 = peg$parseput();
                   if (s0 === peg$FAILED) {
                     s0 = peg$parserename();
+                    if (s0 === peg$FAILED) {
+                      s0 = peg$parsejoin();
+                    }
                   }
                 }
               }
@@ -3544,12 +3915,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c139) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c161) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c140); }
+      if (peg$silentFails === 0) { peg$fail(peg$c162); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesortArgs();
@@ -3560,7 +3931,7 @@ function peg$parse(input, options) {
           s5 = peg$parsefieldExprList();
           if (s5 !== peg$FAILED) {
             peg$savedPos = s3;
-            s4 = peg$c141(s2, s5);
+            s4 = peg$c163(s2, s5);
             s3 = s4;
           } else {
             peg$currPos = s3;
@@ -3575,7 +3946,7 @@ function peg$parse(input, options) {
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c142(s2, s3);
+          s1 = peg$c164(s2, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -3604,7 +3975,7 @@ function peg$parse(input, options) {
       s4 = peg$parsesortArg();
       if (s4 !== peg$FAILED) {
         peg$savedPos = s2;
-        s3 = peg$c143(s4);
+        s3 = peg$c165(s4);
         s2 = s3;
       } else {
         peg$currPos = s2;
@@ -3622,7 +3993,7 @@ function peg$parse(input, options) {
         s4 = peg$parsesortArg();
         if (s4 !== peg$FAILED) {
           peg$savedPos = s2;
-          s3 = peg$c143(s4);
+          s3 = peg$c165(s4);
           s2 = s3;
         } else {
           peg$currPos = s2;
@@ -3635,7 +4006,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c144(s1);
+      s1 = peg$c166(s1);
     }
     s0 = s1;
 
@@ -3646,45 +4017,45 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c145) {
-      s1 = peg$c145;
+    if (input.substr(peg$currPos, 2) === peg$c167) {
+      s1 = peg$c167;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c146); }
+      if (peg$silentFails === 0) { peg$fail(peg$c168); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c147();
+      s1 = peg$c169();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 6) === peg$c148) {
-        s1 = peg$c148;
+      if (input.substr(peg$currPos, 6) === peg$c170) {
+        s1 = peg$c170;
         peg$currPos += 6;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c149); }
+        if (peg$silentFails === 0) { peg$fail(peg$c171); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parse_();
         if (s2 !== peg$FAILED) {
           s3 = peg$currPos;
-          if (input.substr(peg$currPos, 5) === peg$c122) {
-            s4 = peg$c122;
+          if (input.substr(peg$currPos, 5) === peg$c127) {
+            s4 = peg$c127;
             peg$currPos += 5;
           } else {
             s4 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c150); }
+            if (peg$silentFails === 0) { peg$fail(peg$c172); }
           }
           if (s4 === peg$FAILED) {
-            if (input.substr(peg$currPos, 4) === peg$c125) {
-              s4 = peg$c125;
+            if (input.substr(peg$currPos, 4) === peg$c130) {
+              s4 = peg$c130;
               peg$currPos += 4;
             } else {
               s4 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c151); }
+              if (peg$silentFails === 0) { peg$fail(peg$c173); }
             }
           }
           if (s4 !== peg$FAILED) {
@@ -3694,7 +4065,7 @@ function peg$parse(input, options) {
           s3 = s4;
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c152(s3);
+            s1 = peg$c174(s3);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3717,12 +4088,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c153) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c175) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c154); }
+      if (peg$silentFails === 0) { peg$fail(peg$c176); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$currPos;
@@ -3731,7 +4102,7 @@ function peg$parse(input, options) {
         s4 = peg$parseunsignedInteger();
         if (s4 !== peg$FAILED) {
           peg$savedPos = s2;
-          s3 = peg$c155(s4);
+          s3 = peg$c177(s4);
           s2 = s3;
         } else {
           peg$currPos = s2;
@@ -3748,12 +4119,12 @@ function peg$parse(input, options) {
         s3 = peg$currPos;
         s4 = peg$parse_();
         if (s4 !== peg$FAILED) {
-          if (input.substr(peg$currPos, 6) === peg$c156) {
-            s5 = peg$c156;
+          if (input.substr(peg$currPos, 6) === peg$c178) {
+            s5 = peg$c178;
             peg$currPos += 6;
           } else {
             s5 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c157); }
+            if (peg$silentFails === 0) { peg$fail(peg$c179); }
           }
           if (s5 !== peg$FAILED) {
             s4 = [s4, s5];
@@ -3776,7 +4147,7 @@ function peg$parse(input, options) {
             s6 = peg$parsefieldExprList();
             if (s6 !== peg$FAILED) {
               peg$savedPos = s4;
-              s5 = peg$c158(s2, s3, s6);
+              s5 = peg$c180(s2, s3, s6);
               s4 = s5;
             } else {
               peg$currPos = s4;
@@ -3791,7 +4162,7 @@ function peg$parse(input, options) {
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c159(s2, s3, s4);
+            s1 = peg$c181(s2, s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3819,12 +4190,12 @@ function peg$parse(input, options) {
     s0 = peg$currPos;
     s1 = peg$parse_();
     if (s1 !== peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c160) {
-        s2 = peg$c160;
+      if (input.substr(peg$currPos, 6) === peg$c182) {
+        s2 = peg$c182;
         peg$currPos += 6;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c161); }
+        if (peg$silentFails === 0) { peg$fail(peg$c183); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parse_();
@@ -3832,7 +4203,7 @@ function peg$parse(input, options) {
           s4 = peg$parseunsignedInteger();
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c162(s4);
+            s1 = peg$c184(s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -3862,16 +4233,16 @@ function peg$parse(input, options) {
     s2 = peg$currPos;
     s3 = peg$parse_();
     if (s3 !== peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c163) {
-        s4 = peg$c163;
+      if (input.substr(peg$currPos, 2) === peg$c185) {
+        s4 = peg$c185;
         peg$currPos += 2;
       } else {
         s4 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c164); }
+        if (peg$silentFails === 0) { peg$fail(peg$c186); }
       }
       if (s4 !== peg$FAILED) {
         peg$savedPos = s2;
-        s3 = peg$c165();
+        s3 = peg$c187();
         s2 = s3;
       } else {
         peg$currPos = s2;
@@ -3886,16 +4257,16 @@ function peg$parse(input, options) {
       s2 = peg$currPos;
       s3 = peg$parse_();
       if (s3 !== peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c163) {
-          s4 = peg$c163;
+        if (input.substr(peg$currPos, 2) === peg$c185) {
+          s4 = peg$c185;
           peg$currPos += 2;
         } else {
           s4 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c164); }
+          if (peg$silentFails === 0) { peg$fail(peg$c186); }
         }
         if (s4 !== peg$FAILED) {
           peg$savedPos = s2;
-          s3 = peg$c165();
+          s3 = peg$c187();
           s2 = s3;
         } else {
           peg$currPos = s2;
@@ -3908,7 +4279,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c166(s1);
+      s1 = peg$c188(s1);
     }
     s0 = s1;
 
@@ -3924,7 +4295,7 @@ function peg$parse(input, options) {
       s1 = peg$parsefieldRefDotOnly();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c167(s1);
+        s1 = peg$c189(s1);
       }
       s0 = s1;
     }
@@ -3936,12 +4307,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c168) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c190) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c169); }
+      if (peg$silentFails === 0) { peg$fail(peg$c191); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsecutArgs();
@@ -3967,7 +4338,7 @@ function peg$parse(input, options) {
                   s10 = peg$parsecutAssignment();
                   if (s10 !== peg$FAILED) {
                     peg$savedPos = s6;
-                    s7 = peg$c170(s2, s4, s10);
+                    s7 = peg$c192(s2, s4, s10);
                     s6 = s7;
                   } else {
                     peg$currPos = s6;
@@ -4003,7 +4374,7 @@ function peg$parse(input, options) {
                     s10 = peg$parsecutAssignment();
                     if (s10 !== peg$FAILED) {
                       peg$savedPos = s6;
-                      s7 = peg$c170(s2, s4, s10);
+                      s7 = peg$c192(s2, s4, s10);
                       s6 = s7;
                     } else {
                       peg$currPos = s6;
@@ -4024,7 +4395,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c171(s2, s4, s5);
+              s1 = peg$c193(s2, s4, s5);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -4054,12 +4425,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c172) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c194) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c173); }
+      if (peg$silentFails === 0) { peg$fail(peg$c195); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4067,7 +4438,7 @@ function peg$parse(input, options) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c174(s3);
+          s1 = peg$c196(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -4083,16 +4454,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c172) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c194) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c173); }
+        if (peg$silentFails === 0) { peg$fail(peg$c195); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c175();
+        s1 = peg$c197();
       }
       s0 = s1;
     }
@@ -4104,12 +4475,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c176) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c198) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c177); }
+      if (peg$silentFails === 0) { peg$fail(peg$c199); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4117,7 +4488,7 @@ function peg$parse(input, options) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c178(s3);
+          s1 = peg$c200(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -4133,16 +4504,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c176) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c198) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c177); }
+        if (peg$silentFails === 0) { peg$fail(peg$c199); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c179();
+        s1 = peg$c201();
       }
       s0 = s1;
     }
@@ -4154,12 +4525,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c180) {
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c202) {
       s1 = input.substr(peg$currPos, 6);
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c181); }
+      if (peg$silentFails === 0) { peg$fail(peg$c203); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4189,26 +4560,26 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c182) {
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c204) {
       s1 = input.substr(peg$currPos, 4);
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c183); }
+      if (peg$silentFails === 0) { peg$fail(peg$c205); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
       if (s2 !== peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c163) {
-          s3 = peg$c163;
+        if (input.substr(peg$currPos, 2) === peg$c185) {
+          s3 = peg$c185;
           peg$currPos += 2;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c164); }
+          if (peg$silentFails === 0) { peg$fail(peg$c186); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c184();
+          s1 = peg$c206();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -4224,16 +4595,16 @@ function peg$parse(input, options) {
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c182) {
+      if (input.substr(peg$currPos, 4).toLowerCase() === peg$c204) {
         s1 = input.substr(peg$currPos, 4);
         peg$currPos += 4;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c183); }
+        if (peg$silentFails === 0) { peg$fail(peg$c205); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c185();
+        s1 = peg$c207();
       }
       s0 = s1;
     }
@@ -4245,12 +4616,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c186) {
+    if (input.substr(peg$currPos, 3).toLowerCase() === peg$c208) {
       s1 = input.substr(peg$currPos, 3);
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c187); }
+      if (peg$silentFails === 0) { peg$fail(peg$c209); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4331,7 +4702,7 @@ function peg$parse(input, options) {
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c188(s3, s4);
+            s1 = peg$c210(s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -4357,12 +4728,12 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c189) {
+    if (input.substr(peg$currPos, 6).toLowerCase() === peg$c211) {
       s1 = input.substr(peg$currPos, 6);
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c190); }
+      if (peg$silentFails === 0) { peg$fail(peg$c212); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parse_();
@@ -4443,7 +4814,7 @@ function peg$parse(input, options) {
           }
           if (s4 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c191(s3, s4);
+            s1 = peg$c213(s3, s4);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -4465,6 +4836,241 @@ function peg$parse(input, options) {
     return s0;
   }
 
+  function peg$parsejoin() {
+    var s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10;
+
+    s0 = peg$currPos;
+    if (input.substr(peg$currPos, 4).toLowerCase() === peg$c214) {
+      s1 = input.substr(peg$currPos, 4);
+      peg$currPos += 4;
+    } else {
+      s1 = peg$FAILED;
+      if (peg$silentFails === 0) { peg$fail(peg$c215); }
+    }
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parsejoinArgs();
+      if (s2 !== peg$FAILED) {
+        s3 = peg$parse_();
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parsejoinKey();
+          if (s4 !== peg$FAILED) {
+            s5 = [];
+            s6 = peg$currPos;
+            s7 = peg$parse__();
+            if (s7 !== peg$FAILED) {
+              if (input.charCodeAt(peg$currPos) === 44) {
+                s8 = peg$c60;
+                peg$currPos++;
+              } else {
+                s8 = peg$FAILED;
+                if (peg$silentFails === 0) { peg$fail(peg$c61); }
+              }
+              if (s8 !== peg$FAILED) {
+                s9 = peg$parse__();
+                if (s9 !== peg$FAILED) {
+                  s10 = peg$parsejoinKey();
+                  if (s10 !== peg$FAILED) {
+                    peg$savedPos = s6;
+                    s7 = peg$c216(s2, s4, s10);
+                    s6 = s7;
+                  } else {
+                    peg$currPos = s6;
+                    s6 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s6;
+                  s6 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s6;
+                s6 = peg$FAILED;
+              }
+            } else {
+              peg$currPos = s6;
+              s6 = peg$FAILED;
+            }
+            while (s6 !== peg$FAILED) {
+              s5.push(s6);
+              s6 = peg$currPos;
+              s7 = peg$parse__();
+              if (s7 !== peg$FAILED) {
+                if (input.charCodeAt(peg$currPos) === 44) {
+                  s8 = peg$c60;
+                  peg$currPos++;
+                } else {
+                  s8 = peg$FAILED;
+                  if (peg$silentFails === 0) { peg$fail(peg$c61); }
+                }
+                if (s8 !== peg$FAILED) {
+                  s9 = peg$parse__();
+                  if (s9 !== peg$FAILED) {
+                    s10 = peg$parsejoinKey();
+                    if (s10 !== peg$FAILED) {
+                      peg$savedPos = s6;
+                      s7 = peg$c216(s2, s4, s10);
+                      s6 = s7;
+                    } else {
+                      peg$currPos = s6;
+                      s6 = peg$FAILED;
+                    }
+                  } else {
+                    peg$currPos = s6;
+                    s6 = peg$FAILED;
+                  }
+                } else {
+                  peg$currPos = s6;
+                  s6 = peg$FAILED;
+                }
+              } else {
+                peg$currPos = s6;
+                s6 = peg$FAILED;
+              }
+            }
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c217(s2, s4, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+
+    return s0;
+  }
+
+  function peg$parsejoinArgs() {
+    var s0, s1, s2, s3, s4;
+
+    s0 = peg$currPos;
+    s1 = [];
+    s2 = peg$currPos;
+    s3 = peg$parse_();
+    if (s3 !== peg$FAILED) {
+      if (input.substr(peg$currPos, 5) === peg$c218) {
+        s4 = peg$c218;
+        peg$currPos += 5;
+      } else {
+        s4 = peg$FAILED;
+        if (peg$silentFails === 0) { peg$fail(peg$c219); }
+      }
+      if (s4 !== peg$FAILED) {
+        peg$savedPos = s2;
+        s3 = peg$c220();
+        s2 = s3;
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s2;
+      s2 = peg$FAILED;
+    }
+    while (s2 !== peg$FAILED) {
+      s1.push(s2);
+      s2 = peg$currPos;
+      s3 = peg$parse_();
+      if (s3 !== peg$FAILED) {
+        if (input.substr(peg$currPos, 5) === peg$c218) {
+          s4 = peg$c218;
+          peg$currPos += 5;
+        } else {
+          s4 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c219); }
+        }
+        if (s4 !== peg$FAILED) {
+          peg$savedPos = s2;
+          s3 = peg$c220();
+          s2 = s3;
+        } else {
+          peg$currPos = s2;
+          s2 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s2;
+        s2 = peg$FAILED;
+      }
+    }
+    if (s1 !== peg$FAILED) {
+      peg$savedPos = s0;
+      s1 = peg$c188(s1);
+    }
+    s0 = s1;
+
+    return s0;
+  }
+
+  function peg$parsejoinKey() {
+    var s0, s1, s2, s3, s4, s5;
+
+    s0 = peg$currPos;
+    s1 = peg$parsefieldExpr();
+    if (s1 !== peg$FAILED) {
+      s2 = peg$parse__();
+      if (s2 !== peg$FAILED) {
+        if (input.charCodeAt(peg$currPos) === 61) {
+          s3 = peg$c157;
+          peg$currPos++;
+        } else {
+          s3 = peg$FAILED;
+          if (peg$silentFails === 0) { peg$fail(peg$c158); }
+        }
+        if (s3 !== peg$FAILED) {
+          s4 = peg$parse__();
+          if (s4 !== peg$FAILED) {
+            s5 = peg$parsefieldExpr();
+            if (s5 !== peg$FAILED) {
+              peg$savedPos = s0;
+              s1 = peg$c221(s1, s5);
+              s0 = s1;
+            } else {
+              peg$currPos = s0;
+              s0 = peg$FAILED;
+            }
+          } else {
+            peg$currPos = s0;
+            s0 = peg$FAILED;
+          }
+        } else {
+          peg$currPos = s0;
+          s0 = peg$FAILED;
+        }
+      } else {
+        peg$currPos = s0;
+        s0 = peg$FAILED;
+      }
+    } else {
+      peg$currPos = s0;
+      s0 = peg$FAILED;
+    }
+    if (s0 === peg$FAILED) {
+      s0 = peg$currPos;
+      s1 = peg$parsefieldExpr();
+      if (s1 !== peg$FAILED) {
+        peg$savedPos = s0;
+        s1 = peg$c222(s1);
+      }
+      s0 = s1;
+    }
+
+    return s0;
+  }
+
   function peg$parseExpressionAssignment() {
     var s0, s1, s2, s3, s4, s5;
 
@@ -4474,11 +5080,11 @@ function peg$parse(input, options) {
       s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c135;
+          s3 = peg$c157;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c136); }
+          if (peg$silentFails === 0) { peg$fail(peg$c158); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse__();
@@ -4486,7 +5092,7 @@ function peg$parse(input, options) {
             s5 = peg$parseConditionalExpression();
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c192(s1, s5);
+              s1 = peg$c223(s1, s5);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -4521,11 +5127,11 @@ function peg$parse(input, options) {
       s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
-          s3 = peg$c135;
+          s3 = peg$c157;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c136); }
+          if (peg$silentFails === 0) { peg$fail(peg$c158); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse__();
@@ -4533,7 +5139,7 @@ function peg$parse(input, options) {
             s5 = peg$parsefieldRefDotOnly();
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c193(s1, s5);
+              s1 = peg$c224(s1, s5);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -4649,7 +5255,7 @@ function peg$parse(input, options) {
     s1 = peg$parsefieldName();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c194(s1);
+      s1 = peg$c225(s1);
     }
     s0 = s1;
 
@@ -4673,11 +5279,11 @@ function peg$parse(input, options) {
       s2 = peg$parse__();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 63) {
-          s3 = peg$c195;
+          s3 = peg$c226;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c196); }
+          if (peg$silentFails === 0) { peg$fail(peg$c227); }
         }
         if (s3 !== peg$FAILED) {
           s4 = peg$parse__();
@@ -4687,11 +5293,11 @@ function peg$parse(input, options) {
               s6 = peg$parse__();
               if (s6 !== peg$FAILED) {
                 if (input.charCodeAt(peg$currPos) === 58) {
-                  s7 = peg$c197;
+                  s7 = peg$c228;
                   peg$currPos++;
                 } else {
                   s7 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c198); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c229); }
                 }
                 if (s7 !== peg$FAILED) {
                   s8 = peg$parse__();
@@ -4699,7 +5305,7 @@ function peg$parse(input, options) {
                     s9 = peg$parseConditionalExpression();
                     if (s9 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c199(s1, s5, s9);
+                      s1 = peg$c230(s1, s5, s9);
                       s0 = s1;
                     } else {
                       peg$currPos = s0;
@@ -4761,7 +5367,7 @@ function peg$parse(input, options) {
             s7 = peg$parseLogicalANDExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c200(s1, s5, s7);
+              s4 = peg$c231(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4791,7 +5397,7 @@ function peg$parse(input, options) {
               s7 = peg$parseLogicalANDExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c200(s1, s5, s7);
+                s4 = peg$c231(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4812,7 +5418,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4843,7 +5449,7 @@ function peg$parse(input, options) {
             s7 = peg$parseEqualityCompareExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c200(s1, s5, s7);
+              s4 = peg$c231(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4873,7 +5479,7 @@ function peg$parse(input, options) {
               s7 = peg$parseEqualityCompareExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c200(s1, s5, s7);
+                s4 = peg$c231(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4894,7 +5500,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4925,7 +5531,7 @@ function peg$parse(input, options) {
             s7 = peg$parseRelativeExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c202(s1, s5, s7);
+              s4 = peg$c233(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -4955,7 +5561,7 @@ function peg$parse(input, options) {
               s7 = peg$parseRelativeExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c202(s1, s5, s7);
+                s4 = peg$c233(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -4976,7 +5582,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -4994,36 +5600,36 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c203) {
-      s1 = peg$c203;
+    if (input.substr(peg$currPos, 2) === peg$c234) {
+      s1 = peg$c234;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c204); }
+      if (peg$silentFails === 0) { peg$fail(peg$c235); }
     }
     if (s1 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c205) {
-        s1 = peg$c205;
+      if (input.substr(peg$currPos, 2) === peg$c236) {
+        s1 = peg$c236;
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c206); }
+        if (peg$silentFails === 0) { peg$fail(peg$c237); }
       }
       if (s1 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 61) {
-          s1 = peg$c135;
+          s1 = peg$c157;
           peg$currPos++;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c136); }
+          if (peg$silentFails === 0) { peg$fail(peg$c158); }
         }
         if (s1 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c207) {
-            s1 = peg$c207;
+          if (input.substr(peg$currPos, 2) === peg$c238) {
+            s1 = peg$c238;
             peg$currPos += 2;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c208); }
+            if (peg$silentFails === 0) { peg$fail(peg$c239); }
           }
         }
       }
@@ -5048,7 +5654,7 @@ function peg$parse(input, options) {
         peg$currPos += 2;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c209); }
+        if (peg$silentFails === 0) { peg$fail(peg$c240); }
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -5077,7 +5683,7 @@ function peg$parse(input, options) {
             s7 = peg$parseAdditiveExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c200(s1, s5, s7);
+              s4 = peg$c231(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5107,7 +5713,7 @@ function peg$parse(input, options) {
               s7 = peg$parseAdditiveExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c200(s1, s5, s7);
+                s4 = peg$c231(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5128,7 +5734,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5146,36 +5752,36 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 2) === peg$c210) {
-      s1 = peg$c210;
+    if (input.substr(peg$currPos, 2) === peg$c241) {
+      s1 = peg$c241;
       peg$currPos += 2;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c211); }
+      if (peg$silentFails === 0) { peg$fail(peg$c242); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 60) {
-        s1 = peg$c212;
+        s1 = peg$c243;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c213); }
+        if (peg$silentFails === 0) { peg$fail(peg$c244); }
       }
       if (s1 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c214) {
-          s1 = peg$c214;
+        if (input.substr(peg$currPos, 2) === peg$c245) {
+          s1 = peg$c245;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c215); }
+          if (peg$silentFails === 0) { peg$fail(peg$c246); }
         }
         if (s1 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 62) {
-            s1 = peg$c216;
+            s1 = peg$c247;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c217); }
+            if (peg$silentFails === 0) { peg$fail(peg$c248); }
           }
         }
       }
@@ -5206,7 +5812,7 @@ function peg$parse(input, options) {
             s7 = peg$parseMultiplicativeExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c200(s1, s5, s7);
+              s4 = peg$c231(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5236,7 +5842,7 @@ function peg$parse(input, options) {
               s7 = peg$parseMultiplicativeExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c200(s1, s5, s7);
+                s4 = peg$c231(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5257,7 +5863,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5276,11 +5882,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 43) {
-      s1 = peg$c218;
+      s1 = peg$c249;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c219); }
+      if (peg$silentFails === 0) { peg$fail(peg$c250); }
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 45) {
@@ -5317,7 +5923,7 @@ function peg$parse(input, options) {
             s7 = peg$parseNotExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c200(s1, s5, s7);
+              s4 = peg$c231(s1, s5, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5347,7 +5953,7 @@ function peg$parse(input, options) {
               s7 = peg$parseNotExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c200(s1, s5, s7);
+                s4 = peg$c231(s1, s5, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5368,7 +5974,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c201(s1, s2);
+        s1 = peg$c232(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5395,11 +6001,11 @@ function peg$parse(input, options) {
     }
     if (s1 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s1 = peg$c220;
+        s1 = peg$c251;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c221); }
+        if (peg$silentFails === 0) { peg$fail(peg$c252); }
       }
     }
     if (s1 !== peg$FAILED) {
@@ -5428,7 +6034,7 @@ function peg$parse(input, options) {
         s3 = peg$parseNotExpression();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c222(s3);
+          s1 = peg$c253(s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -5459,11 +6065,11 @@ function peg$parse(input, options) {
       s3 = peg$parse__();
       if (s3 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 58) {
-          s4 = peg$c197;
+          s4 = peg$c228;
           peg$currPos++;
         } else {
           s4 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c198); }
+          if (peg$silentFails === 0) { peg$fail(peg$c229); }
         }
         if (s4 !== peg$FAILED) {
           s5 = peg$parse__();
@@ -5471,7 +6077,7 @@ function peg$parse(input, options) {
             s6 = peg$parseZngType();
             if (s6 !== peg$FAILED) {
               peg$savedPos = s2;
-              s3 = peg$c223(s1, s6);
+              s3 = peg$c254(s1, s6);
               s2 = s3;
             } else {
               peg$currPos = s2;
@@ -5494,7 +6100,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c224(s1, s2);
+        s1 = peg$c255(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5512,124 +6118,142 @@ function peg$parse(input, options) {
     var s0, s1;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c225) {
-      s1 = peg$c225;
+    if (input.substr(peg$currPos, 4) === peg$c256) {
+      s1 = peg$c256;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c226); }
+      if (peg$silentFails === 0) { peg$fail(peg$c257); }
     }
     if (s1 === peg$FAILED) {
-      if (input.substr(peg$currPos, 4) === peg$c227) {
-        s1 = peg$c227;
-        peg$currPos += 4;
+      if (input.substr(peg$currPos, 5) === peg$c258) {
+        s1 = peg$c258;
+        peg$currPos += 5;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c228); }
+        if (peg$silentFails === 0) { peg$fail(peg$c259); }
       }
       if (s1 === peg$FAILED) {
-        if (input.substr(peg$currPos, 5) === peg$c229) {
-          s1 = peg$c229;
-          peg$currPos += 5;
+        if (input.substr(peg$currPos, 4) === peg$c260) {
+          s1 = peg$c260;
+          peg$currPos += 4;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c230); }
+          if (peg$silentFails === 0) { peg$fail(peg$c261); }
         }
         if (s1 === peg$FAILED) {
-          if (input.substr(peg$currPos, 6) === peg$c231) {
-            s1 = peg$c231;
-            peg$currPos += 6;
+          if (input.substr(peg$currPos, 5) === peg$c262) {
+            s1 = peg$c262;
+            peg$currPos += 5;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c232); }
+            if (peg$silentFails === 0) { peg$fail(peg$c263); }
           }
           if (s1 === peg$FAILED) {
-            if (input.substr(peg$currPos, 5) === peg$c233) {
-              s1 = peg$c233;
-              peg$currPos += 5;
+            if (input.substr(peg$currPos, 6) === peg$c264) {
+              s1 = peg$c264;
+              peg$currPos += 6;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c234); }
+              if (peg$silentFails === 0) { peg$fail(peg$c265); }
             }
             if (s1 === peg$FAILED) {
-              if (input.substr(peg$currPos, 6) === peg$c235) {
-                s1 = peg$c235;
-                peg$currPos += 6;
+              if (input.substr(peg$currPos, 5) === peg$c266) {
+                s1 = peg$c266;
+                peg$currPos += 5;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c236); }
+                if (peg$silentFails === 0) { peg$fail(peg$c267); }
               }
               if (s1 === peg$FAILED) {
-                if (input.substr(peg$currPos, 5) === peg$c237) {
-                  s1 = peg$c237;
-                  peg$currPos += 5;
+                if (input.substr(peg$currPos, 6) === peg$c268) {
+                  s1 = peg$c268;
+                  peg$currPos += 6;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c238); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c269); }
                 }
                 if (s1 === peg$FAILED) {
-                  if (input.substr(peg$currPos, 6) === peg$c239) {
-                    s1 = peg$c239;
-                    peg$currPos += 6;
+                  if (input.substr(peg$currPos, 5) === peg$c270) {
+                    s1 = peg$c270;
+                    peg$currPos += 5;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c240); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c271); }
                   }
                   if (s1 === peg$FAILED) {
-                    if (input.substr(peg$currPos, 7) === peg$c241) {
-                      s1 = peg$c241;
-                      peg$currPos += 7;
+                    if (input.substr(peg$currPos, 6) === peg$c272) {
+                      s1 = peg$c272;
+                      peg$currPos += 6;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c242); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c273); }
                     }
                     if (s1 === peg$FAILED) {
-                      if (input.substr(peg$currPos, 6) === peg$c243) {
-                        s1 = peg$c243;
-                        peg$currPos += 6;
+                      if (input.substr(peg$currPos, 7) === peg$c274) {
+                        s1 = peg$c274;
+                        peg$currPos += 7;
                       } else {
                         s1 = peg$FAILED;
-                        if (peg$silentFails === 0) { peg$fail(peg$c244); }
+                        if (peg$silentFails === 0) { peg$fail(peg$c275); }
                       }
                       if (s1 === peg$FAILED) {
-                        if (input.substr(peg$currPos, 7) === peg$c245) {
-                          s1 = peg$c245;
-                          peg$currPos += 7;
+                        if (input.substr(peg$currPos, 6) === peg$c276) {
+                          s1 = peg$c276;
+                          peg$currPos += 6;
                         } else {
                           s1 = peg$FAILED;
-                          if (peg$silentFails === 0) { peg$fail(peg$c246); }
+                          if (peg$silentFails === 0) { peg$fail(peg$c277); }
                         }
                         if (s1 === peg$FAILED) {
-                          if (input.substr(peg$currPos, 2) === peg$c247) {
-                            s1 = peg$c247;
-                            peg$currPos += 2;
+                          if (input.substr(peg$currPos, 7) === peg$c278) {
+                            s1 = peg$c278;
+                            peg$currPos += 7;
                           } else {
                             s1 = peg$FAILED;
-                            if (peg$silentFails === 0) { peg$fail(peg$c248); }
+                            if (peg$silentFails === 0) { peg$fail(peg$c279); }
                           }
                           if (s1 === peg$FAILED) {
-                            if (input.substr(peg$currPos, 3) === peg$c249) {
-                              s1 = peg$c249;
-                              peg$currPos += 3;
+                            if (input.substr(peg$currPos, 4) === peg$c280) {
+                              s1 = peg$c280;
+                              peg$currPos += 4;
                             } else {
                               s1 = peg$FAILED;
-                              if (peg$silentFails === 0) { peg$fail(peg$c250); }
+                              if (peg$silentFails === 0) { peg$fail(peg$c281); }
                             }
                             if (s1 === peg$FAILED) {
-                              if (input.substr(peg$currPos, 4) === peg$c251) {
-                                s1 = peg$c251;
-                                peg$currPos += 4;
+                              if (input.substr(peg$currPos, 2) === peg$c282) {
+                                s1 = peg$c282;
+                                peg$currPos += 2;
                               } else {
                                 s1 = peg$FAILED;
-                                if (peg$silentFails === 0) { peg$fail(peg$c252); }
+                                if (peg$silentFails === 0) { peg$fail(peg$c283); }
                               }
                               if (s1 === peg$FAILED) {
-                                if (input.substr(peg$currPos, 8) === peg$c253) {
-                                  s1 = peg$c253;
-                                  peg$currPos += 8;
+                                if (input.substr(peg$currPos, 3) === peg$c284) {
+                                  s1 = peg$c284;
+                                  peg$currPos += 3;
                                 } else {
                                   s1 = peg$FAILED;
-                                  if (peg$silentFails === 0) { peg$fail(peg$c254); }
+                                  if (peg$silentFails === 0) { peg$fail(peg$c285); }
+                                }
+                                if (s1 === peg$FAILED) {
+                                  if (input.substr(peg$currPos, 4) === peg$c286) {
+                                    s1 = peg$c286;
+                                    peg$currPos += 4;
+                                  } else {
+                                    s1 = peg$FAILED;
+                                    if (peg$silentFails === 0) { peg$fail(peg$c287); }
+                                  }
+                                  if (s1 === peg$FAILED) {
+                                    if (input.substr(peg$currPos, 8) === peg$c288) {
+                                      s1 = peg$c288;
+                                      peg$currPos += 8;
+                                    } else {
+                                      s1 = peg$FAILED;
+                                      if (peg$silentFails === 0) { peg$fail(peg$c289); }
+                                    }
+                                  }
                                 }
                               }
                             }
@@ -5681,7 +6305,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c255(s1, s4);
+              s1 = peg$c290(s1, s4);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -5741,12 +6365,12 @@ function peg$parse(input, options) {
   function peg$parseFunctionNameStart() {
     var s0;
 
-    if (peg$c256.test(input.charAt(peg$currPos))) {
+    if (peg$c291.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c257); }
+      if (peg$silentFails === 0) { peg$fail(peg$c292); }
     }
 
     return s0;
@@ -5757,12 +6381,12 @@ function peg$parse(input, options) {
 
     s0 = peg$parseFunctionNameStart();
     if (s0 === peg$FAILED) {
-      if (peg$c258.test(input.charAt(peg$currPos))) {
+      if (peg$c293.test(input.charAt(peg$currPos))) {
         s0 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c259); }
+        if (peg$silentFails === 0) { peg$fail(peg$c294); }
       }
     }
 
@@ -5792,7 +6416,7 @@ function peg$parse(input, options) {
             s7 = peg$parseConditionalExpression();
             if (s7 !== peg$FAILED) {
               peg$savedPos = s3;
-              s4 = peg$c260(s1, s7);
+              s4 = peg$c295(s1, s7);
               s3 = s4;
             } else {
               peg$currPos = s3;
@@ -5828,7 +6452,7 @@ function peg$parse(input, options) {
               s7 = peg$parseConditionalExpression();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c260(s1, s7);
+                s4 = peg$c295(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5849,7 +6473,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c261(s1, s2);
+        s1 = peg$c296(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -5864,7 +6488,7 @@ function peg$parse(input, options) {
       s1 = peg$parse__();
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c262();
+        s1 = peg$c297();
       }
       s0 = s1;
     }
@@ -5905,7 +6529,7 @@ function peg$parse(input, options) {
                 }
                 if (s9 !== peg$FAILED) {
                   peg$savedPos = s3;
-                  s4 = peg$c263(s1, s7);
+                  s4 = peg$c298(s1, s7);
                   s3 = s4;
                 } else {
                   peg$currPos = s3;
@@ -5948,7 +6572,7 @@ function peg$parse(input, options) {
               s7 = peg$parsefieldName();
               if (s7 !== peg$FAILED) {
                 peg$savedPos = s3;
-                s4 = peg$c264(s1, s7);
+                s4 = peg$c299(s1, s7);
                 s3 = s4;
               } else {
                 peg$currPos = s3;
@@ -5995,7 +6619,7 @@ function peg$parse(input, options) {
                   }
                   if (s9 !== peg$FAILED) {
                     peg$savedPos = s3;
-                    s4 = peg$c263(s1, s7);
+                    s4 = peg$c298(s1, s7);
                     s3 = s4;
                   } else {
                     peg$currPos = s3;
@@ -6038,7 +6662,7 @@ function peg$parse(input, options) {
                 s7 = peg$parsefieldName();
                 if (s7 !== peg$FAILED) {
                   peg$savedPos = s3;
-                  s4 = peg$c264(s1, s7);
+                  s4 = peg$c299(s1, s7);
                   s3 = s4;
                 } else {
                   peg$currPos = s3;
@@ -6060,7 +6684,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c265(s1, s2);
+        s1 = peg$c300(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6093,7 +6717,7 @@ function peg$parse(input, options) {
                 peg$currPos += 3;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c266); }
+                if (peg$silentFails === 0) { peg$fail(peg$c301); }
               }
               if (s3 !== peg$FAILED) {
                 s4 = peg$parse_();
@@ -6138,44 +6762,44 @@ function peg$parse(input, options) {
   function peg$parsesec_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c267) {
-      s0 = peg$c267;
+    if (input.substr(peg$currPos, 7) === peg$c302) {
+      s0 = peg$c302;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c268); }
+      if (peg$silentFails === 0) { peg$fail(peg$c303); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c269) {
-        s0 = peg$c269;
+      if (input.substr(peg$currPos, 6) === peg$c304) {
+        s0 = peg$c304;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c270); }
+        if (peg$silentFails === 0) { peg$fail(peg$c305); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c271) {
-          s0 = peg$c271;
+        if (input.substr(peg$currPos, 4) === peg$c306) {
+          s0 = peg$c306;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c272); }
+          if (peg$silentFails === 0) { peg$fail(peg$c307); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 3) === peg$c273) {
-            s0 = peg$c273;
+          if (input.substr(peg$currPos, 3) === peg$c308) {
+            s0 = peg$c308;
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c274); }
+            if (peg$silentFails === 0) { peg$fail(peg$c309); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 115) {
-              s0 = peg$c275;
+              s0 = peg$c310;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c276); }
+              if (peg$silentFails === 0) { peg$fail(peg$c311); }
             }
           }
         }
@@ -6188,44 +6812,44 @@ function peg$parse(input, options) {
   function peg$parsemin_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 7) === peg$c277) {
-      s0 = peg$c277;
+    if (input.substr(peg$currPos, 7) === peg$c312) {
+      s0 = peg$c312;
       peg$currPos += 7;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c278); }
+      if (peg$silentFails === 0) { peg$fail(peg$c313); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 6) === peg$c279) {
-        s0 = peg$c279;
+      if (input.substr(peg$currPos, 6) === peg$c314) {
+        s0 = peg$c314;
         peg$currPos += 6;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c280); }
+        if (peg$silentFails === 0) { peg$fail(peg$c315); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 4) === peg$c281) {
-          s0 = peg$c281;
+        if (input.substr(peg$currPos, 4) === peg$c316) {
+          s0 = peg$c316;
           peg$currPos += 4;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c282); }
+          if (peg$silentFails === 0) { peg$fail(peg$c317); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 3) === peg$c116) {
-            s0 = peg$c116;
+          if (input.substr(peg$currPos, 3) === peg$c121) {
+            s0 = peg$c121;
             peg$currPos += 3;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c283); }
+            if (peg$silentFails === 0) { peg$fail(peg$c318); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 109) {
-              s0 = peg$c284;
+              s0 = peg$c319;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c285); }
+              if (peg$silentFails === 0) { peg$fail(peg$c320); }
             }
           }
         }
@@ -6238,44 +6862,44 @@ function peg$parse(input, options) {
   function peg$parsehour_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c286) {
-      s0 = peg$c286;
+    if (input.substr(peg$currPos, 5) === peg$c321) {
+      s0 = peg$c321;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c287); }
+      if (peg$silentFails === 0) { peg$fail(peg$c322); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c288) {
-        s0 = peg$c288;
+      if (input.substr(peg$currPos, 3) === peg$c323) {
+        s0 = peg$c323;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c289); }
+        if (peg$silentFails === 0) { peg$fail(peg$c324); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 2) === peg$c290) {
-          s0 = peg$c290;
+        if (input.substr(peg$currPos, 2) === peg$c325) {
+          s0 = peg$c325;
           peg$currPos += 2;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c291); }
+          if (peg$silentFails === 0) { peg$fail(peg$c326); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 104) {
-            s0 = peg$c292;
+            s0 = peg$c327;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c293); }
+            if (peg$silentFails === 0) { peg$fail(peg$c328); }
           }
           if (s0 === peg$FAILED) {
-            if (input.substr(peg$currPos, 4) === peg$c294) {
-              s0 = peg$c294;
+            if (input.substr(peg$currPos, 4) === peg$c329) {
+              s0 = peg$c329;
               peg$currPos += 4;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c295); }
+              if (peg$silentFails === 0) { peg$fail(peg$c330); }
             }
           }
         }
@@ -6288,28 +6912,28 @@ function peg$parse(input, options) {
   function peg$parseday_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 4) === peg$c296) {
-      s0 = peg$c296;
+    if (input.substr(peg$currPos, 4) === peg$c331) {
+      s0 = peg$c331;
       peg$currPos += 4;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c297); }
+      if (peg$silentFails === 0) { peg$fail(peg$c332); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 3) === peg$c298) {
-        s0 = peg$c298;
+      if (input.substr(peg$currPos, 3) === peg$c333) {
+        s0 = peg$c333;
         peg$currPos += 3;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c299); }
+        if (peg$silentFails === 0) { peg$fail(peg$c334); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 100) {
-          s0 = peg$c300;
+          s0 = peg$c335;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c301); }
+          if (peg$silentFails === 0) { peg$fail(peg$c336); }
         }
       }
     }
@@ -6320,44 +6944,44 @@ function peg$parse(input, options) {
   function peg$parseweek_abbrev() {
     var s0;
 
-    if (input.substr(peg$currPos, 5) === peg$c302) {
-      s0 = peg$c302;
+    if (input.substr(peg$currPos, 5) === peg$c337) {
+      s0 = peg$c337;
       peg$currPos += 5;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c303); }
+      if (peg$silentFails === 0) { peg$fail(peg$c338); }
     }
     if (s0 === peg$FAILED) {
-      if (input.substr(peg$currPos, 4) === peg$c304) {
-        s0 = peg$c304;
+      if (input.substr(peg$currPos, 4) === peg$c339) {
+        s0 = peg$c339;
         peg$currPos += 4;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c305); }
+        if (peg$silentFails === 0) { peg$fail(peg$c340); }
       }
       if (s0 === peg$FAILED) {
-        if (input.substr(peg$currPos, 3) === peg$c306) {
-          s0 = peg$c306;
+        if (input.substr(peg$currPos, 3) === peg$c341) {
+          s0 = peg$c341;
           peg$currPos += 3;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c307); }
+          if (peg$silentFails === 0) { peg$fail(peg$c342); }
         }
         if (s0 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c308) {
-            s0 = peg$c308;
+          if (input.substr(peg$currPos, 2) === peg$c343) {
+            s0 = peg$c343;
             peg$currPos += 2;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c309); }
+            if (peg$silentFails === 0) { peg$fail(peg$c344); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 119) {
-              s0 = peg$c310;
+              s0 = peg$c345;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c311); }
+              if (peg$silentFails === 0) { peg$fail(peg$c346); }
             }
           }
         }
@@ -6371,16 +6995,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c269) {
-      s1 = peg$c269;
+    if (input.substr(peg$currPos, 6) === peg$c304) {
+      s1 = peg$c304;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c270); }
+      if (peg$silentFails === 0) { peg$fail(peg$c305); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c312();
+      s1 = peg$c347();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6395,7 +7019,7 @@ function peg$parse(input, options) {
           s3 = peg$parsesec_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c313(s1);
+            s1 = peg$c348(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6418,16 +7042,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 6) === peg$c279) {
-      s1 = peg$c279;
+    if (input.substr(peg$currPos, 6) === peg$c314) {
+      s1 = peg$c314;
       peg$currPos += 6;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c280); }
+      if (peg$silentFails === 0) { peg$fail(peg$c315); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c314();
+      s1 = peg$c349();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6442,7 +7066,7 @@ function peg$parse(input, options) {
           s3 = peg$parsemin_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c315(s1);
+            s1 = peg$c350(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6465,16 +7089,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 4) === peg$c294) {
-      s1 = peg$c294;
+    if (input.substr(peg$currPos, 4) === peg$c329) {
+      s1 = peg$c329;
       peg$currPos += 4;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c295); }
+      if (peg$silentFails === 0) { peg$fail(peg$c330); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c316();
+      s1 = peg$c351();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6489,7 +7113,7 @@ function peg$parse(input, options) {
           s3 = peg$parsehour_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c317(s1);
+            s1 = peg$c352(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6512,16 +7136,16 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 3) === peg$c298) {
-      s1 = peg$c298;
+    if (input.substr(peg$currPos, 3) === peg$c333) {
+      s1 = peg$c333;
       peg$currPos += 3;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c299); }
+      if (peg$silentFails === 0) { peg$fail(peg$c334); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c318();
+      s1 = peg$c353();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -6536,7 +7160,7 @@ function peg$parse(input, options) {
           s3 = peg$parseday_abbrev();
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c319(s1);
+            s1 = peg$c354(s1);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -6569,7 +7193,7 @@ function peg$parse(input, options) {
         s3 = peg$parseweek_abbrev();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c320(s1);
+          s1 = peg$c355(s1);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6656,7 +7280,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c321(s1);
+      s1 = peg$c356(s1);
     }
     s0 = s1;
 
@@ -6668,11 +7292,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 58) {
-      s1 = peg$c197;
+      s1 = peg$c228;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c198); }
+      if (peg$silentFails === 0) { peg$fail(peg$c229); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesuint();
@@ -6710,7 +7334,7 @@ function peg$parse(input, options) {
       s2 = peg$parseip6tail();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c322(s1, s2);
+        s1 = peg$c357(s1, s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6731,12 +7355,12 @@ function peg$parse(input, options) {
           s3 = peg$parseh_append();
         }
         if (s2 !== peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c323) {
-            s3 = peg$c323;
+          if (input.substr(peg$currPos, 2) === peg$c358) {
+            s3 = peg$c358;
             peg$currPos += 2;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c324); }
+            if (peg$silentFails === 0) { peg$fail(peg$c359); }
           }
           if (s3 !== peg$FAILED) {
             s4 = [];
@@ -6749,7 +7373,7 @@ function peg$parse(input, options) {
               s5 = peg$parseip6tail();
               if (s5 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c325(s1, s2, s4, s5);
+                s1 = peg$c360(s1, s2, s4, s5);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6773,12 +7397,12 @@ function peg$parse(input, options) {
       }
       if (s0 === peg$FAILED) {
         s0 = peg$currPos;
-        if (input.substr(peg$currPos, 2) === peg$c323) {
-          s1 = peg$c323;
+        if (input.substr(peg$currPos, 2) === peg$c358) {
+          s1 = peg$c358;
           peg$currPos += 2;
         } else {
           s1 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c324); }
+          if (peg$silentFails === 0) { peg$fail(peg$c359); }
         }
         if (s1 !== peg$FAILED) {
           s2 = [];
@@ -6791,7 +7415,7 @@ function peg$parse(input, options) {
             s3 = peg$parseip6tail();
             if (s3 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c326(s2, s3);
+              s1 = peg$c361(s2, s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -6816,16 +7440,16 @@ function peg$parse(input, options) {
               s3 = peg$parseh_append();
             }
             if (s2 !== peg$FAILED) {
-              if (input.substr(peg$currPos, 2) === peg$c323) {
-                s3 = peg$c323;
+              if (input.substr(peg$currPos, 2) === peg$c358) {
+                s3 = peg$c358;
                 peg$currPos += 2;
               } else {
                 s3 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c324); }
+                if (peg$silentFails === 0) { peg$fail(peg$c359); }
               }
               if (s3 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c327(s1, s2);
+                s1 = peg$c362(s1, s2);
                 s0 = s1;
               } else {
                 peg$currPos = s0;
@@ -6841,16 +7465,16 @@ function peg$parse(input, options) {
           }
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
-            if (input.substr(peg$currPos, 2) === peg$c323) {
-              s1 = peg$c323;
+            if (input.substr(peg$currPos, 2) === peg$c358) {
+              s1 = peg$c358;
               peg$currPos += 2;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c324); }
+              if (peg$silentFails === 0) { peg$fail(peg$c359); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c328();
+              s1 = peg$c363();
             }
             s0 = s1;
           }
@@ -6877,17 +7501,17 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 58) {
-      s1 = peg$c197;
+      s1 = peg$c228;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c198); }
+      if (peg$silentFails === 0) { peg$fail(peg$c229); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseh16();
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c329(s2);
+        s1 = peg$c364(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6908,15 +7532,15 @@ function peg$parse(input, options) {
     s1 = peg$parseh16();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 58) {
-        s2 = peg$c197;
+        s2 = peg$c228;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c198); }
+        if (peg$silentFails === 0) { peg$fail(peg$c229); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c330(s1);
+        s1 = peg$c365(s1);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -6937,17 +7561,17 @@ function peg$parse(input, options) {
     s1 = peg$parseaddr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c220;
+        s2 = peg$c251;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c221); }
+        if (peg$silentFails === 0) { peg$fail(peg$c252); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c331(s1, s3);
+          s1 = peg$c366(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -6972,17 +7596,17 @@ function peg$parse(input, options) {
     s1 = peg$parseip6addr();
     if (s1 !== peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 47) {
-        s2 = peg$c220;
+        s2 = peg$c251;
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c221); }
+        if (peg$silentFails === 0) { peg$fail(peg$c252); }
       }
       if (s2 !== peg$FAILED) {
         s3 = peg$parseunsignedInteger();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c332(s1, s3);
+          s1 = peg$c367(s1, s3);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7007,7 +7631,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesuint();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c333(s1);
+      s1 = peg$c368(s1);
     }
     s0 = s1;
 
@@ -7056,7 +7680,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesinteger();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c333(s1);
+      s1 = peg$c368(s1);
     }
     s0 = s1;
 
@@ -7067,12 +7691,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (peg$c334.test(input.charAt(peg$currPos))) {
+    if (peg$c369.test(input.charAt(peg$currPos))) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c335); }
+      if (peg$silentFails === 0) { peg$fail(peg$c370); }
     }
     if (s1 === peg$FAILED) {
       s1 = null;
@@ -7102,7 +7726,7 @@ function peg$parse(input, options) {
     s1 = peg$parsesdouble();
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c336(s1);
+      s1 = peg$c371(s1);
     }
     s0 = s1;
 
@@ -7160,7 +7784,7 @@ function peg$parse(input, options) {
             }
             if (s5 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c337();
+              s1 = peg$c372();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7220,7 +7844,7 @@ function peg$parse(input, options) {
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c337();
+              s1 = peg$c372();
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -7247,20 +7871,20 @@ function peg$parse(input, options) {
     var s0, s1, s2, s3;
 
     if (input.charCodeAt(peg$currPos) === 48) {
-      s0 = peg$c338;
+      s0 = peg$c373;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c339); }
+      if (peg$silentFails === 0) { peg$fail(peg$c374); }
     }
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
-      if (peg$c340.test(input.charAt(peg$currPos))) {
+      if (peg$c375.test(input.charAt(peg$currPos))) {
         s1 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c341); }
+        if (peg$silentFails === 0) { peg$fail(peg$c376); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
@@ -7315,12 +7939,12 @@ function peg$parse(input, options) {
     var s0, s1, s2;
 
     s0 = peg$currPos;
-    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c342) {
+    if (input.substr(peg$currPos, 1).toLowerCase() === peg$c377) {
       s1 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c343); }
+      if (peg$silentFails === 0) { peg$fail(peg$c378); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsesinteger();
@@ -7355,7 +7979,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c344(s1);
+      s1 = peg$c379(s1);
     }
     s0 = s1;
 
@@ -7365,12 +7989,12 @@ function peg$parse(input, options) {
   function peg$parsehexdigit() {
     var s0;
 
-    if (peg$c345.test(input.charAt(peg$currPos))) {
+    if (peg$c380.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c346); }
+      if (peg$silentFails === 0) { peg$fail(peg$c381); }
     }
 
     return s0;
@@ -7392,7 +8016,7 @@ function peg$parse(input, options) {
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c347(s1);
+      s1 = peg$c382(s1);
     }
     s0 = s1;
 
@@ -7404,11 +8028,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 92) {
-      s1 = peg$c348;
+      s1 = peg$c383;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c349); }
+      if (peg$silentFails === 0) { peg$fail(peg$c384); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parseescapeSequence();
@@ -7431,12 +8055,12 @@ function peg$parse(input, options) {
       s0 = peg$currPos;
       s1 = peg$currPos;
       peg$silentFails++;
-      if (peg$c350.test(input.charAt(peg$currPos))) {
+      if (peg$c385.test(input.charAt(peg$currPos))) {
         s2 = input.charAt(peg$currPos);
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c351); }
+        if (peg$silentFails === 0) { peg$fail(peg$c386); }
       }
       if (s2 === peg$FAILED) {
         s2 = peg$parsews();
@@ -7454,7 +8078,7 @@ function peg$parse(input, options) {
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c352); }
+          if (peg$silentFails === 0) { peg$fail(peg$c387); }
         }
         if (s2 !== peg$FAILED) {
           peg$savedPos = s0;
@@ -7478,11 +8102,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s1 = peg$c353;
+      s1 = peg$c388;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c354); }
+      if (peg$silentFails === 0) { peg$fail(peg$c389); }
     }
     if (s1 !== peg$FAILED) {
       s2 = [];
@@ -7493,15 +8117,15 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 34) {
-          s3 = peg$c353;
+          s3 = peg$c388;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c354); }
+          if (peg$silentFails === 0) { peg$fail(peg$c389); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c355(s2);
+          s1 = peg$c390(s2);
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7518,11 +8142,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 39) {
-        s1 = peg$c356;
+        s1 = peg$c391;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c357); }
+        if (peg$silentFails === 0) { peg$fail(peg$c392); }
       }
       if (s1 !== peg$FAILED) {
         s2 = [];
@@ -7533,15 +8157,15 @@ function peg$parse(input, options) {
         }
         if (s2 !== peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 39) {
-            s3 = peg$c356;
+            s3 = peg$c391;
             peg$currPos++;
           } else {
             s3 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c357); }
+            if (peg$silentFails === 0) { peg$fail(peg$c392); }
           }
           if (s3 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c355(s2);
+            s1 = peg$c390(s2);
             s0 = s1;
           } else {
             peg$currPos = s0;
@@ -7567,11 +8191,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 34) {
-      s2 = peg$c353;
+      s2 = peg$c388;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c354); }
+      if (peg$silentFails === 0) { peg$fail(peg$c389); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7589,7 +8213,7 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c352); }
+        if (peg$silentFails === 0) { peg$fail(peg$c387); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -7606,11 +8230,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c348;
+        s1 = peg$c383;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c349); }
+        if (peg$silentFails === 0) { peg$fail(peg$c384); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7638,11 +8262,11 @@ function peg$parse(input, options) {
     s1 = peg$currPos;
     peg$silentFails++;
     if (input.charCodeAt(peg$currPos) === 39) {
-      s2 = peg$c356;
+      s2 = peg$c391;
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c357); }
+      if (peg$silentFails === 0) { peg$fail(peg$c392); }
     }
     if (s2 === peg$FAILED) {
       s2 = peg$parseescapedChar();
@@ -7660,7 +8284,7 @@ function peg$parse(input, options) {
         peg$currPos++;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c352); }
+        if (peg$silentFails === 0) { peg$fail(peg$c387); }
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
@@ -7677,11 +8301,11 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 92) {
-        s1 = peg$c348;
+        s1 = peg$c383;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c349); }
+        if (peg$silentFails === 0) { peg$fail(peg$c384); }
       }
       if (s1 !== peg$FAILED) {
         s2 = peg$parseescapeSequence();
@@ -7707,11 +8331,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 120) {
-      s1 = peg$c358;
+      s1 = peg$c393;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c359); }
+      if (peg$silentFails === 0) { peg$fail(peg$c394); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsehexdigit();
@@ -7719,7 +8343,7 @@ function peg$parse(input, options) {
         s3 = peg$parsehexdigit();
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
-          s1 = peg$c360();
+          s1 = peg$c395();
           s0 = s1;
         } else {
           peg$currPos = s0;
@@ -7747,110 +8371,110 @@ function peg$parse(input, options) {
     var s0, s1;
 
     if (input.charCodeAt(peg$currPos) === 39) {
-      s0 = peg$c356;
+      s0 = peg$c391;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c357); }
+      if (peg$silentFails === 0) { peg$fail(peg$c392); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 34) {
-        s0 = peg$c353;
+        s0 = peg$c388;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c354); }
+        if (peg$silentFails === 0) { peg$fail(peg$c389); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 92) {
-          s0 = peg$c348;
+          s0 = peg$c383;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c349); }
+          if (peg$silentFails === 0) { peg$fail(peg$c384); }
         }
         if (s0 === peg$FAILED) {
           s0 = peg$currPos;
           if (input.charCodeAt(peg$currPos) === 98) {
-            s1 = peg$c361;
+            s1 = peg$c396;
             peg$currPos++;
           } else {
             s1 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c362); }
+            if (peg$silentFails === 0) { peg$fail(peg$c397); }
           }
           if (s1 !== peg$FAILED) {
             peg$savedPos = s0;
-            s1 = peg$c363();
+            s1 = peg$c398();
           }
           s0 = s1;
           if (s0 === peg$FAILED) {
             s0 = peg$currPos;
             if (input.charCodeAt(peg$currPos) === 102) {
-              s1 = peg$c364;
+              s1 = peg$c399;
               peg$currPos++;
             } else {
               s1 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c365); }
+              if (peg$silentFails === 0) { peg$fail(peg$c400); }
             }
             if (s1 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c366();
+              s1 = peg$c401();
             }
             s0 = s1;
             if (s0 === peg$FAILED) {
               s0 = peg$currPos;
               if (input.charCodeAt(peg$currPos) === 110) {
-                s1 = peg$c367;
+                s1 = peg$c402;
                 peg$currPos++;
               } else {
                 s1 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c368); }
+                if (peg$silentFails === 0) { peg$fail(peg$c403); }
               }
               if (s1 !== peg$FAILED) {
                 peg$savedPos = s0;
-                s1 = peg$c369();
+                s1 = peg$c404();
               }
               s0 = s1;
               if (s0 === peg$FAILED) {
                 s0 = peg$currPos;
                 if (input.charCodeAt(peg$currPos) === 114) {
-                  s1 = peg$c370;
+                  s1 = peg$c405;
                   peg$currPos++;
                 } else {
                   s1 = peg$FAILED;
-                  if (peg$silentFails === 0) { peg$fail(peg$c371); }
+                  if (peg$silentFails === 0) { peg$fail(peg$c406); }
                 }
                 if (s1 !== peg$FAILED) {
                   peg$savedPos = s0;
-                  s1 = peg$c372();
+                  s1 = peg$c407();
                 }
                 s0 = s1;
                 if (s0 === peg$FAILED) {
                   s0 = peg$currPos;
                   if (input.charCodeAt(peg$currPos) === 116) {
-                    s1 = peg$c373;
+                    s1 = peg$c408;
                     peg$currPos++;
                   } else {
                     s1 = peg$FAILED;
-                    if (peg$silentFails === 0) { peg$fail(peg$c374); }
+                    if (peg$silentFails === 0) { peg$fail(peg$c409); }
                   }
                   if (s1 !== peg$FAILED) {
                     peg$savedPos = s0;
-                    s1 = peg$c375();
+                    s1 = peg$c410();
                   }
                   s0 = s1;
                   if (s0 === peg$FAILED) {
                     s0 = peg$currPos;
                     if (input.charCodeAt(peg$currPos) === 118) {
-                      s1 = peg$c376;
+                      s1 = peg$c411;
                       peg$currPos++;
                     } else {
                       s1 = peg$FAILED;
-                      if (peg$silentFails === 0) { peg$fail(peg$c377); }
+                      if (peg$silentFails === 0) { peg$fail(peg$c412); }
                     }
                     if (s1 !== peg$FAILED) {
                       peg$savedPos = s0;
-                      s1 = peg$c378();
+                      s1 = peg$c413();
                     }
                     s0 = s1;
                   }
@@ -7870,15 +8494,15 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 61) {
-      s1 = peg$c135;
+      s1 = peg$c157;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c136); }
+      if (peg$silentFails === 0) { peg$fail(peg$c158); }
     }
     if (s1 !== peg$FAILED) {
       peg$savedPos = s0;
-      s1 = peg$c379();
+      s1 = peg$c414();
     }
     s0 = s1;
     if (s0 === peg$FAILED) {
@@ -7892,7 +8516,7 @@ function peg$parse(input, options) {
       }
       if (s1 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c380();
+        s1 = peg$c415();
       }
       s0 = s1;
     }
@@ -7905,11 +8529,11 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 117) {
-      s1 = peg$c381;
+      s1 = peg$c416;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c382); }
+      if (peg$silentFails === 0) { peg$fail(peg$c417); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$currPos;
@@ -7941,7 +8565,7 @@ function peg$parse(input, options) {
       }
       if (s2 !== peg$FAILED) {
         peg$savedPos = s0;
-        s1 = peg$c383(s2);
+        s1 = peg$c418(s2);
         s0 = s1;
       } else {
         peg$currPos = s0;
@@ -7954,19 +8578,19 @@ function peg$parse(input, options) {
     if (s0 === peg$FAILED) {
       s0 = peg$currPos;
       if (input.charCodeAt(peg$currPos) === 117) {
-        s1 = peg$c381;
+        s1 = peg$c416;
         peg$currPos++;
       } else {
         s1 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c382); }
+        if (peg$silentFails === 0) { peg$fail(peg$c417); }
       }
       if (s1 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 123) {
-          s2 = peg$c384;
+          s2 = peg$c419;
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c385); }
+          if (peg$silentFails === 0) { peg$fail(peg$c420); }
         }
         if (s2 !== peg$FAILED) {
           s3 = peg$currPos;
@@ -8025,15 +8649,15 @@ function peg$parse(input, options) {
           }
           if (s3 !== peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 125) {
-              s4 = peg$c386;
+              s4 = peg$c421;
               peg$currPos++;
             } else {
               s4 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c387); }
+              if (peg$silentFails === 0) { peg$fail(peg$c422); }
             }
             if (s4 !== peg$FAILED) {
               peg$savedPos = s0;
-              s1 = peg$c383(s3);
+              s1 = peg$c418(s3);
               s0 = s1;
             } else {
               peg$currPos = s0;
@@ -8061,21 +8685,21 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     if (input.charCodeAt(peg$currPos) === 47) {
-      s1 = peg$c220;
+      s1 = peg$c251;
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c221); }
+      if (peg$silentFails === 0) { peg$fail(peg$c252); }
     }
     if (s1 !== peg$FAILED) {
       s2 = peg$parsereBody();
       if (s2 !== peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 47) {
-          s3 = peg$c220;
+          s3 = peg$c251;
           peg$currPos++;
         } else {
           s3 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c221); }
+          if (peg$silentFails === 0) { peg$fail(peg$c252); }
         }
         if (s3 !== peg$FAILED) {
           peg$savedPos = s0;
@@ -8102,39 +8726,39 @@ function peg$parse(input, options) {
 
     s0 = peg$currPos;
     s1 = [];
-    if (peg$c388.test(input.charAt(peg$currPos))) {
+    if (peg$c423.test(input.charAt(peg$currPos))) {
       s2 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s2 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c389); }
+      if (peg$silentFails === 0) { peg$fail(peg$c424); }
     }
     if (s2 === peg$FAILED) {
-      if (input.substr(peg$currPos, 2) === peg$c390) {
-        s2 = peg$c390;
+      if (input.substr(peg$currPos, 2) === peg$c425) {
+        s2 = peg$c425;
         peg$currPos += 2;
       } else {
         s2 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c391); }
+        if (peg$silentFails === 0) { peg$fail(peg$c426); }
       }
     }
     if (s2 !== peg$FAILED) {
       while (s2 !== peg$FAILED) {
         s1.push(s2);
-        if (peg$c388.test(input.charAt(peg$currPos))) {
+        if (peg$c423.test(input.charAt(peg$currPos))) {
           s2 = input.charAt(peg$currPos);
           peg$currPos++;
         } else {
           s2 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c389); }
+          if (peg$silentFails === 0) { peg$fail(peg$c424); }
         }
         if (s2 === peg$FAILED) {
-          if (input.substr(peg$currPos, 2) === peg$c390) {
-            s2 = peg$c390;
+          if (input.substr(peg$currPos, 2) === peg$c425) {
+            s2 = peg$c425;
             peg$currPos += 2;
           } else {
             s2 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c391); }
+            if (peg$silentFails === 0) { peg$fail(peg$c426); }
           }
         }
       }
@@ -8153,12 +8777,12 @@ function peg$parse(input, options) {
   function peg$parseescapedChar() {
     var s0;
 
-    if (peg$c392.test(input.charAt(peg$currPos))) {
+    if (peg$c427.test(input.charAt(peg$currPos))) {
       s0 = input.charAt(peg$currPos);
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c393); }
+      if (peg$silentFails === 0) { peg$fail(peg$c428); }
     }
 
     return s0;
@@ -8168,51 +8792,51 @@ function peg$parse(input, options) {
     var s0;
 
     if (input.charCodeAt(peg$currPos) === 9) {
-      s0 = peg$c394;
+      s0 = peg$c429;
       peg$currPos++;
     } else {
       s0 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c395); }
+      if (peg$silentFails === 0) { peg$fail(peg$c430); }
     }
     if (s0 === peg$FAILED) {
       if (input.charCodeAt(peg$currPos) === 11) {
-        s0 = peg$c396;
+        s0 = peg$c431;
         peg$currPos++;
       } else {
         s0 = peg$FAILED;
-        if (peg$silentFails === 0) { peg$fail(peg$c397); }
+        if (peg$silentFails === 0) { peg$fail(peg$c432); }
       }
       if (s0 === peg$FAILED) {
         if (input.charCodeAt(peg$currPos) === 12) {
-          s0 = peg$c398;
+          s0 = peg$c433;
           peg$currPos++;
         } else {
           s0 = peg$FAILED;
-          if (peg$silentFails === 0) { peg$fail(peg$c399); }
+          if (peg$silentFails === 0) { peg$fail(peg$c434); }
         }
         if (s0 === peg$FAILED) {
           if (input.charCodeAt(peg$currPos) === 32) {
-            s0 = peg$c400;
+            s0 = peg$c435;
             peg$currPos++;
           } else {
             s0 = peg$FAILED;
-            if (peg$silentFails === 0) { peg$fail(peg$c401); }
+            if (peg$silentFails === 0) { peg$fail(peg$c436); }
           }
           if (s0 === peg$FAILED) {
             if (input.charCodeAt(peg$currPos) === 160) {
-              s0 = peg$c402;
+              s0 = peg$c437;
               peg$currPos++;
             } else {
               s0 = peg$FAILED;
-              if (peg$silentFails === 0) { peg$fail(peg$c403); }
+              if (peg$silentFails === 0) { peg$fail(peg$c438); }
             }
             if (s0 === peg$FAILED) {
               if (input.charCodeAt(peg$currPos) === 65279) {
-                s0 = peg$c404;
+                s0 = peg$c439;
                 peg$currPos++;
               } else {
                 s0 = peg$FAILED;
-                if (peg$silentFails === 0) { peg$fail(peg$c405); }
+                if (peg$silentFails === 0) { peg$fail(peg$c440); }
               }
             }
           }
@@ -8240,7 +8864,7 @@ function peg$parse(input, options) {
     peg$silentFails--;
     if (s0 === peg$FAILED) {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c406); }
+      if (peg$silentFails === 0) { peg$fail(peg$c441); }
     }
 
     return s0;
@@ -8269,7 +8893,7 @@ function peg$parse(input, options) {
       peg$currPos++;
     } else {
       s1 = peg$FAILED;
-      if (peg$silentFails === 0) { peg$fail(peg$c352); }
+      if (peg$silentFails === 0) { peg$fail(peg$c387); }
     }
     peg$silentFails--;
     if (s1 === peg$FAILED) {